@@ -0,0 +1,94 @@
+package api
+
+import (
+	"employees/internal/address"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// prepareAddresses normalizes addresses submitted by a client and
+// validates them. Ownership and the review flag are set by the server.
+func prepareAddresses(addrs []models.Address) error {
+	for i := range addrs {
+		addrs[i].ID = 0
+		addrs[i].EmployeeID = 0
+		addrs[i].NeedsReview = false
+		address.Normalize(&addrs[i])
+	}
+	return address.ValidateAll(addrs)
+}
+
+// handleAddresses lists (GET), replaces (PUT, a JSON array) or removes
+// (DELETE with ?type=) the addresses of employee ?id=.
+func (s *Server) handleAddresses(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		addrs, err := s.db.ListAddresses(id)
+		if err != nil {
+			s.logger.Error("Failed to list addresses", zap.Error(err))
+			http.Error(w, "Failed to list addresses", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, addrs)
+	case "PUT":
+		addrs := []models.Address{}
+		if err := json.NewDecoder(r.Body).Decode(&addrs); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := prepareAddresses(addrs); err != nil {
+			s.logger.Error("Invalid employee address", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := s.db.SetAddresses(id, addrs); err != nil {
+			s.logger.Error("Address update failed", zap.Error(err))
+			http.Error(w, "Failed to update addresses", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Addresses updated", zap.Int("employeeId", id), zap.Int("count", len(addrs)))
+		s.writeJSON(w, http.StatusOK, addrs)
+	case "DELETE":
+		addrType := models.AddressType(r.URL.Query().Get("type"))
+		if addrType == "" {
+			http.Error(w, "type is required", http.StatusBadRequest)
+			s.logger.Error("type is required")
+			return
+		}
+		if err := s.db.DeleteAddress(id, addrType); err != nil {
+			s.logger.Error("Address deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete address", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Address deleted", zap.Int("employeeId", id), zap.String("type", string(addrType)))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleAddressesNeedingReview lists addresses carried over from free text
+// that could not be parsed and need correcting by hand.
+func (s *Server) handleAddressesNeedingReview(w http.ResponseWriter, r *http.Request) {
+	addrs, err := s.db.ListAddressesNeedingReview()
+	if err != nil {
+		s.logger.Error("Failed to list addresses", zap.Error(err))
+		http.Error(w, "Failed to list addresses", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, addrs)
+}
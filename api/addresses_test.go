@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSetAddresses(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       []models.Address
+	}{
+		{
+			name: "Home And Mailing",
+			body: `[{"type":"home","line1":" 1 Main St ","city":"Springfield","region":"IL","postalCode":"62704","country":"us"},
+				{"type":"mailing","line1":"PO Box 7","city":"Berlin","postalCode":"10115","country":"DE","needsReview":true}]`,
+			wantStatus: http.StatusOK,
+			want: []models.Address{
+				{Type: models.AddressTypeHome, Line1: "1 Main St", City: "Springfield", Region: "IL", PostalCode: "62704", Country: "US"},
+				{Type: models.AddressTypeMailing, Line1: "PO Box 7", City: "Berlin", PostalCode: "10115", Country: "DE"},
+			},
+		},
+		{
+			name:       "Invalid Postal Code",
+			body:       `[{"type":"home","line1":"Hauptstr. 1","city":"Berlin","postalCode":"1011","country":"DE"}]`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Duplicate Type",
+			body:       `[{"type":"home","line1":"a","city":"b","postalCode":"10115","country":"DE"},{"type":"home","line1":"a","city":"b","postalCode":"10115","country":"DE"}]`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Clear All",
+			body:       `[]`,
+			wantStatus: http.StatusOK,
+			want:       []models.Address{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7}, nil)
+			if tt.wantStatus == http.StatusOK {
+				mockDB.On("SetAddresses", 7, tt.want).Return(nil)
+			}
+
+			req := httptest.NewRequest("PUT", "/employee/addresses?id=7", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleAddresses(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestHandleDeleteAddress(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7}, nil)
+	mockDB.On("DeleteAddress", 7, models.AddressTypeMailing).Return(nil)
+
+	rr := httptest.NewRecorder()
+	server.handleAddresses(rr, httptest.NewRequest("DELETE", "/employee/addresses?id=7&type=mailing", nil))
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleCreateEmployeeValidatesAddresses(t *testing.T) {
+	server, _ := setupTestServer(t)
+	body := `{"firstName":"Ana","lastName":"Lima","email":"ana@example.com",
+		"addresses":[{"type":"home","line1":"familia","city":"x","country":"familia"}]}`
+
+	rr := httptest.NewRecorder()
+	server.handleEmployee(rr, httptest.NewRequest("POST", "/employee", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "country")
+}
+
+func TestHandleListEmployeesByCountry(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListEmployees", models.EmployeeFilter{Country: "DE"}).
+			Return([]models.Employee{{ID: 1, Addresses: []models.Address{{Country: "DE"}}}}, nil)
+
+		rr := httptest.NewRecorder()
+		server.handleListEmployees(rr, httptest.NewRequest("GET", "/employees?country=de", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var emps []models.Employee
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&emps))
+		assert.Equal(t, "DE", emps[0].Addresses[0].Country)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.handleListEmployees(rr, httptest.NewRequest("GET", "/employees?country=Germany", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockDB.AssertNotCalled(t, "ListEmployees", mock.Anything)
+	})
+}
@@ -0,0 +1,355 @@
+package api
+
+import (
+	"employees/internal/asset"
+	"employees/internal/db"
+	"employees/internal/models"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) handleAssets(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var a models.Asset
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		a.ID = 0
+		a.EmployeeID = nil
+		if err := asset.Validate(&a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid asset", zap.Error(err))
+			return
+		}
+		if err := s.db.CreateAsset(&a); err != nil {
+			s.logger.Error("Asset creation failed", zap.Error(err))
+			http.Error(w, "Failed to create asset", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Asset created", zap.Any("asset", a))
+		s.writeJSON(w, http.StatusCreated, a)
+	case "GET":
+		if r.URL.Query().Get("id") != "" {
+			a, ok := s.asset(w, r)
+			if !ok {
+				return
+			}
+			s.writeJSON(w, http.StatusOK, a)
+			return
+		}
+		filter, ok := s.assetFilter(w, r)
+		if !ok {
+			return
+		}
+		assets, err := s.db.ListAssets(filter)
+		if err != nil {
+			s.logger.Error("Failed to list assets", zap.Error(err))
+			http.Error(w, "Failed to list assets", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, assets)
+	case "PUT":
+		existing, ok := s.asset(w, r)
+		if !ok {
+			return
+		}
+		var a models.Asset
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		a.ID = existing.ID
+		a.EmployeeID = nil
+		a.CreatedAt = existing.CreatedAt
+		if err := asset.Validate(&a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid asset", zap.Error(err))
+			return
+		}
+		if err := s.db.UpdateAsset(&a); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Check the asset in before changing it", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to update asset", http.StatusBadRequest)
+			}
+			s.logger.Error("Asset update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Asset updated", zap.Any("asset", a))
+		s.writeJSON(w, http.StatusOK, a)
+	}
+}
+
+// asset loads the asset named by ?id=, writing a 404 when there is none.
+func (s *Server) asset(w http.ResponseWriter, r *http.Request) (*models.Asset, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	a, err := s.db.GetAsset(id)
+	if err != nil {
+		http.Error(w, "Asset not found", http.StatusNotFound)
+		s.logger.Error("Asset not found", zap.Error(err))
+		return nil, false
+	}
+	return a, true
+}
+
+// assetFilter reads ?type=, ?status= and ?employeeId=.
+func (s *Server) assetFilter(w http.ResponseWriter, r *http.Request) (models.AssetFilter, bool) {
+	filter := models.AssetFilter{
+		Type:   strings.ToLower(r.URL.Query().Get("type")),
+		Status: models.AssetStatus(r.URL.Query().Get("status")),
+	}
+	if r.URL.Query().Get("employeeId") != "" {
+		id, ok := s.queryID(w, r, "employeeId")
+		if !ok {
+			return filter, false
+		}
+		filter.EmployeeID = &id
+	}
+	return filter, true
+}
+
+// handleCheckOutAsset hands available asset ?id= to the employee in the
+// body.
+func (s *Server) handleCheckOutAsset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	var body struct {
+		EmployeeID int    `json:"employeeId"`
+		Note       string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	emp, err := s.db.GetEmployee(strconv.Itoa(body.EmployeeID))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if emp.Status == models.EmployeeStatusTerminated {
+		http.Error(w, "Assets cannot be checked out to terminated employees", http.StatusConflict)
+		s.logger.Error("Check-out to terminated employee", zap.Int("employeeId", emp.ID))
+		return
+	}
+
+	a := models.AssetAssignment{
+		AssetID:      id,
+		EmployeeID:   emp.ID,
+		CheckedOutAt: time.Now(),
+		CheckedOutBy: adminID(r),
+		CheckOutNote: strings.TrimSpace(body.Note),
+	}
+	if err := s.db.CheckOutAsset(&a); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Asset is not available", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to check out asset", http.StatusInternalServerError)
+		}
+		s.logger.Error("Asset check-out failed", zap.Error(err))
+		return
+	}
+	s.logger.Info("Asset checked out", zap.Int("assetId", id), zap.Int("employeeId", emp.ID))
+	s.writeJSON(w, http.StatusCreated, a)
+}
+
+// handleCheckInAsset takes asset ?id= back, putting it into the status in
+// the body: available by default, or in_repair, retired or lost.
+func (s *Server) handleCheckInAsset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	var body struct {
+		Status models.AssetStatus `json:"status"`
+		Note   string             `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	status, err := asset.ReturnStatus(body.Status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid return status", zap.Error(err))
+		return
+	}
+
+	a, err := s.db.CheckInAsset(id, status, adminID(r), strings.TrimSpace(body.Note))
+	if err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Asset is not checked out", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to check in asset", http.StatusInternalServerError)
+		}
+		s.logger.Error("Asset check-in failed", zap.Error(err))
+		return
+	}
+	s.logger.Info("Asset checked in", zap.Int("assetId", id), zap.Int("employeeId", a.EmployeeID),
+		zap.String("status", string(status)))
+	s.writeJSON(w, http.StatusOK, a)
+}
+
+// handleAssetHistory lists the check-outs of ?assetId= or of everything
+// ?employeeId= has held.
+func (s *Server) handleAssetHistory(w http.ResponseWriter, r *http.Request) {
+	var filter models.AssetAssignmentFilter
+	for name, field := range map[string]**int{
+		"assetId":    &filter.AssetID,
+		"employeeId": &filter.EmployeeID,
+	} {
+		if r.URL.Query().Get(name) == "" {
+			continue
+		}
+		id, ok := s.queryID(w, r, name)
+		if !ok {
+			return
+		}
+		*field = &id
+	}
+	history, err := s.db.ListAssetAssignments(filter)
+	if err != nil {
+		s.logger.Error("Failed to list asset history", zap.Error(err))
+		http.Error(w, "Failed to list asset history", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, history)
+}
+
+// handleOutstandingAssets lists assets still held by terminated employees.
+func (s *Server) handleOutstandingAssets(w http.ResponseWriter, r *http.Request) {
+	assets, err := s.db.ListAssets(models.AssetFilter{
+		HolderStatuses: []models.EmployeeStatus{models.EmployeeStatusTerminated},
+	})
+	if err != nil {
+		s.logger.Error("Failed to list outstanding assets", zap.Error(err))
+		http.Error(w, "Failed to list outstanding assets", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, assets)
+}
+
+// collectAssets is a termination hook that lists the assets the employee
+// still holds and adds a checklist for their manager to get them back by
+// the leaving date.
+func (s *Server) collectAssets(emp models.Employee, tr models.StatusTransition) error {
+	assets, err := s.db.ListAssets(models.AssetFilter{EmployeeID: &emp.ID})
+	if err != nil || len(assets) == 0 {
+		return err
+	}
+
+	list := models.Checklist{
+		EmployeeID: emp.ID,
+		Name:       "Asset return",
+		Kind:       models.ChecklistKindOffboarding,
+		StartDate:  tr.EffectiveDate,
+	}
+	for _, a := range assets {
+		s.logger.Warn("Asset outstanding", zap.Int("employeeId", emp.ID), zap.Int("assetId", a.ID),
+			zap.String("type", a.Type), zap.String("serialNumber", a.SerialNumber))
+		list.Tasks = append(list.Tasks, models.ChecklistTask{
+			EmployeeID:      emp.ID,
+			Title:           fmt.Sprintf("Return %s %s", a.Type, a.SerialNumber),
+			OwnerEmployeeID: emp.ManagerID,
+			DueDate:         tr.EffectiveDate,
+		})
+	}
+	return s.db.CreateChecklist(&list)
+}
+
+var assetColumns = []string{"id", "type", "serialNumber", "model", "purchaseDate", "status", "employeeId", "notes"}
+
+var assetHistoryColumns = []string{"id", "assetId", "employeeId", "checkedOutAt", "checkedOutBy",
+	"checkedInAt", "checkedInBy", "returnedAsStatus", "checkOutNote", "checkInNote"}
+
+// handleExportAssets writes the asset register as CSV for audits, taking
+// the same filters as the list. With ?history=true it writes every
+// check-out instead.
+func (s *Server) handleExportAssets(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var header []string
+	var rows [][]string
+	if r.URL.Query().Get("history") == "true" {
+		history, err := s.db.ListAssetAssignments(models.AssetAssignmentFilter{})
+		if err != nil {
+			s.logger.Error("Failed to list asset history", zap.Error(err))
+			http.Error(w, "Failed to export assets", http.StatusInternalServerError)
+			return
+		}
+		header = assetHistoryColumns
+		for _, a := range history {
+			checkedInAt := ""
+			if a.CheckedInAt != nil {
+				checkedInAt = a.CheckedInAt.UTC().Format(time.RFC3339)
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(a.ID), strconv.Itoa(a.AssetID), strconv.Itoa(a.EmployeeID),
+				a.CheckedOutAt.UTC().Format(time.RFC3339), formatOptionalID(a.CheckedOutBy),
+				checkedInAt, formatOptionalID(a.CheckedInBy), a.ReturnedAsStatus, a.CheckOutNote, a.CheckInNote,
+			})
+		}
+	} else {
+		filter, ok := s.assetFilter(w, r)
+		if !ok {
+			return
+		}
+		assets, err := s.db.ListAssets(filter)
+		if err != nil {
+			s.logger.Error("Failed to list assets", zap.Error(err))
+			http.Error(w, "Failed to export assets", http.StatusInternalServerError)
+			return
+		}
+		header = assetColumns
+		for _, a := range assets {
+			purchased := ""
+			if a.PurchaseDate != nil {
+				purchased = a.PurchaseDate.String()
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(a.ID), a.Type, a.SerialNumber, a.Model, purchased, string(a.Status),
+				formatOptionalID(a.EmployeeID), a.Notes,
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="assets.csv"`)
+	out := csv.NewWriter(w)
+	out.Write(header)
+	out.WriteAll(rows)
+	if err := out.Error(); err != nil {
+		s.logger.Error("Failed to write asset export", zap.Error(err))
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCheckOutAsset(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Available",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusActive}, nil)
+				m.On("CheckOutAsset", mock.MatchedBy(func(a *models.AssetAssignment) bool {
+					return a.AssetID == 9 && a.EmployeeID == 3 && a.CheckOutNote == "New starter"
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Already Assigned",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusActive}, nil)
+				m.On("CheckOutAsset", mock.AnythingOfType("*models.AssetAssignment")).Return(db.ErrConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Terminated Employee",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusTerminated}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/assets/checkout?id=9", bytes.NewBufferString(`{"employeeId":3,"note":" New starter "}`))
+			rr := httptest.NewRecorder()
+
+			server.handleCheckOutAsset(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleCheckInAsset(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("CheckInAsset", 9, models.AssetStatusInRepair, (*int)(nil), "Cracked screen").
+		Return(&models.AssetAssignment{ID: 1, AssetID: 9, EmployeeID: 3}, nil)
+
+	req := httptest.NewRequest("POST", "/assets/checkin?id=9", bytes.NewBufferString(`{"status":"in_repair","note":"Cracked screen"}`))
+	rr := httptest.NewRecorder()
+
+	server.handleCheckInAsset(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestCollectAssets(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	leaving := models.NewDate(2025, time.June, 30)
+	mockDB.On("ListAssets", models.AssetFilter{EmployeeID: intPtr(3)}).Return([]models.Asset{
+		{ID: 9, Type: "laptop", SerialNumber: "C02XK1", EmployeeID: intPtr(3)},
+		{ID: 12, Type: "badge", SerialNumber: "B-17", EmployeeID: intPtr(3)},
+	}, nil)
+	mockDB.On("CreateChecklist", mock.MatchedBy(func(l *models.Checklist) bool {
+		return len(l.Tasks) == 2 && l.Tasks[0].Title == "Return laptop C02XK1" &&
+			*l.Tasks[0].OwnerEmployeeID == 1 && l.Tasks[1].DueDate == leaving
+	})).Return(nil)
+
+	err := server.collectAssets(models.Employee{ID: 3, ManagerID: intPtr(1)},
+		models.StatusTransition{To: models.EmployeeStatusTerminated, EffectiveDate: leaving})
+
+	require.NoError(t, err)
+}
+
+func TestHandleExportAssets(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	bought := models.NewDate(2024, time.March, 1)
+	mockDB.On("ListAssets", models.AssetFilter{Type: "laptop"}).Return([]models.Asset{
+		{ID: 9, Type: "laptop", SerialNumber: "C02XK1", PurchaseDate: &bought, Status: models.AssetStatusAssigned, EmployeeID: intPtr(3)},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/assets/export?type=Laptop", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleExportAssets(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, assetColumns, records[0])
+	assert.Equal(t, []string{"9", "laptop", "C02XK1", "", "2024-03-01", "assigned", "3", ""}, records[1])
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"employees/internal/checklist"
+	"employees/internal/lifecycle"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// startChecklists returns a transition hook that instantiates the
+// employee's checklists of kind as of the transition's effective date.
+// Onboarding only starts for candidates being hired, as everyone created
+// past that stage already got their onboarding checklists on create.
+func (s *Server) startChecklists(kind models.ChecklistKind) lifecycle.Hook {
+	return func(emp models.Employee, tr models.StatusTransition) error {
+		if kind == models.ChecklistKindOnboarding && tr.From != models.EmployeeStatusCandidate {
+			return nil
+		}
+		lists, err := s.db.StartChecklists(&emp, kind, tr.EffectiveDate)
+		if err != nil {
+			return err
+		}
+		s.logger.Info("Checklists started", zap.Int("employeeId", emp.ID),
+			zap.String("kind", string(kind)), zap.Int("count", len(lists)))
+		return nil
+	}
+}
+
+func (s *Server) handleChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		var tpl models.ChecklistTemplate
+		if err := json.NewDecoder(r.Body).Decode(&tpl); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := checklist.Validate(tpl); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid checklist template", zap.Error(err))
+			return
+		}
+		if tpl.DepartmentID != nil {
+			if _, err := s.db.GetDepartment(*tpl.DepartmentID); err != nil {
+				http.Error(w, errDepartmentNotFound.Error(), http.StatusBadRequest)
+				s.logger.Error("Department not found", zap.Error(err))
+				return
+			}
+		}
+
+		if r.Method == "POST" {
+			tpl.ID = 0
+			if err := s.db.CreateChecklistTemplate(&tpl); err != nil {
+				s.logger.Error("Checklist template creation failed", zap.Error(err))
+				http.Error(w, "Failed to create checklist template", http.StatusInternalServerError)
+				return
+			}
+			s.logger.Info("Checklist template created", zap.Any("template", tpl))
+			s.writeJSON(w, http.StatusCreated, tpl)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		tpl.ID = id
+		if err := s.db.UpdateChecklistTemplate(&tpl); err != nil {
+			http.Error(w, "Checklist template not found", http.StatusNotFound)
+			s.logger.Error("Checklist template update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Checklist template updated", zap.Any("template", tpl))
+		s.writeJSON(w, http.StatusOK, tpl)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			templates, err := s.db.ListChecklistTemplates(models.ChecklistKind(r.URL.Query().Get("kind")))
+			if err != nil {
+				s.logger.Error("Failed to list checklist templates", zap.Error(err))
+				http.Error(w, "Failed to list checklist templates", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, templates)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		tpl, err := s.db.GetChecklistTemplate(id)
+		if err != nil {
+			http.Error(w, "Checklist template not found", http.StatusNotFound)
+			s.logger.Error("Checklist template not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, tpl)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteChecklistTemplate(id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Checklist template deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Checklist template deleted", zap.Int("templateId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleChecklists lists an employee's checklists with their tasks. POST
+// instantiates ?templateId= for the employee by hand, starting on ?start=
+// (default today).
+func (s *Server) handleChecklists(w http.ResponseWriter, r *http.Request) {
+	employeeID, ok := s.queryID(w, r, "employeeId")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		lists, err := s.db.ListChecklists(employeeID)
+		if err != nil {
+			s.logger.Error("Failed to list checklists", zap.Error(err))
+			http.Error(w, "Failed to list checklists", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, lists)
+	case "POST":
+		templateID, ok := s.queryID(w, r, "templateId")
+		if !ok {
+			return
+		}
+		start, ok := s.queryDate(w, r, "start")
+		if !ok {
+			return
+		}
+		emp, err := s.db.GetEmployee(strconv.Itoa(employeeID))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Employee not found", zap.Error(err))
+			return
+		}
+		tpl, err := s.db.GetChecklistTemplate(templateID)
+		if err != nil {
+			http.Error(w, "Checklist template not found", http.StatusNotFound)
+			s.logger.Error("Checklist template not found", zap.Error(err))
+			return
+		}
+
+		list := checklist.Instantiate(*tpl, *emp, start)
+		if err := s.db.CreateChecklist(&list); err != nil {
+			s.logger.Error("Checklist creation failed", zap.Error(err))
+			http.Error(w, "Failed to create checklist", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Checklist created", zap.Int("employeeId", employeeID), zap.Int("checklistId", list.ID))
+		s.writeJSON(w, http.StatusCreated, list)
+	}
+}
+
+// handleChecklistTasks lists tasks by owner or employee. ?open=true leaves
+// out completed tasks; ?overdue=true reports open tasks due before ?asOf=
+// (default today).
+func (s *Server) handleChecklistTasks(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var filter models.ChecklistTaskFilter
+	for name, field := range map[string]**int{
+		"ownerAdminId":    &filter.OwnerAdminID,
+		"ownerEmployeeId": &filter.OwnerEmployeeID,
+		"employeeId":      &filter.EmployeeID,
+	} {
+		if q.Get(name) == "" {
+			continue
+		}
+		id, ok := s.queryID(w, r, name)
+		if !ok {
+			return
+		}
+		*field = &id
+	}
+	filter.Open = q.Get("open") == "true"
+	if q.Get("overdue") == "true" {
+		asOf, ok := s.queryDate(w, r, "asOf")
+		if !ok {
+			return
+		}
+		filter.OverdueOn = &asOf
+	}
+
+	tasks, err := s.db.ListChecklistTasks(filter)
+	if err != nil {
+		s.logger.Error("Failed to list checklist tasks", zap.Error(err))
+		http.Error(w, "Failed to list checklist tasks", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, tasks)
+}
+
+// handleChecklistTask marks a task done or not done and reassigns its
+// owner or due date. Only the fields present in the body change.
+func (s *Server) handleChecklistTask(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var update struct {
+		Completed       *bool        `json:"completed"`
+		OwnerAdminID    *int         `json:"ownerAdminId"`
+		OwnerEmployeeID *int         `json:"ownerEmployeeId"`
+		DueDate         *models.Date `json:"dueDate"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	task, err := s.db.GetChecklistTask(id)
+	if err != nil {
+		http.Error(w, "Checklist task not found", http.StatusNotFound)
+		s.logger.Error("Checklist task not found", zap.Error(err))
+		return
+	}
+
+	if update.OwnerEmployeeID != nil {
+		if _, err := s.db.GetEmployee(strconv.Itoa(*update.OwnerEmployeeID)); err != nil {
+			http.Error(w, "Owner employee not found", http.StatusBadRequest)
+			s.logger.Error("Owner employee not found", zap.Error(err))
+			return
+		}
+		task.OwnerEmployeeID, task.OwnerAdminID = update.OwnerEmployeeID, nil
+	}
+	if update.OwnerAdminID != nil {
+		task.OwnerAdminID, task.OwnerEmployeeID = update.OwnerAdminID, nil
+	}
+	if update.DueDate != nil {
+		task.DueDate = *update.DueDate
+	}
+	if update.Completed != nil {
+		switch {
+		case *update.Completed && task.CompletedAt == nil:
+			now := time.Now()
+			task.CompletedAt = &now
+			task.CompletedByAdminID = adminID(r)
+		case !*update.Completed:
+			task.CompletedAt, task.CompletedByAdminID = nil, nil
+		}
+	}
+
+	if err := s.db.UpdateChecklistTask(task); err != nil {
+		s.logger.Error("Checklist task update failed", zap.Error(err))
+		http.Error(w, "Failed to update checklist task", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Checklist task updated", zap.Any("task", task))
+	s.writeJSON(w, http.StatusOK, task)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHiringCandidateStartsOnboarding(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusCandidate}, nil)
+	mockDB.On("GetPendingStatusTransition", 3).Return(nil, nil)
+	mockDB.On("RecordStatusTransition", mock.AnythingOfType("*models.StatusTransition")).Return(nil)
+	mockDB.On("StartChecklists", mock.MatchedBy(func(e *models.Employee) bool {
+		return e.ID == 3 && e.Status == models.EmployeeStatusOnboarding
+	}), models.ChecklistKindOnboarding, models.NewDate(2025, time.June, 2)).
+		Return([]models.Checklist{{ID: 1}}, nil)
+
+	req := httptest.NewRequest("POST", "/employee/status?id=3",
+		bytes.NewBufferString(`{"to":"onboarding","reason":"Offer accepted","effectiveDate":"2025-06-02"}`))
+	rr := httptest.NewRecorder()
+
+	server.handleEmployeeStatus(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandleChecklistTemplates(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"name":"Engineering starters","kind":"onboarding","departmentId":2,
+				"tasks":[{"title":"Order laptop","ownerAdminId":4,"dueDays":-5},{"title":"Buddy intro","ownerRole":"manager","dueDays":1}]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetDepartment", 2).Return(&models.Department{ID: 2}, nil)
+				m.On("CreateChecklistTemplate", mock.AnythingOfType("*models.ChecklistTemplate")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "No Tasks",
+			body:       `{"name":"Empty","kind":"offboarding","tasks":[]}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/checklist/templates", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleChecklistTemplates(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleOverdueChecklistTasks(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	asOf := models.NewDate(2025, time.June, 10)
+	mockDB.On("ListChecklistTasks", models.ChecklistTaskFilter{OwnerAdminID: intPtr(4), OverdueOn: &asOf}).
+		Return([]models.ChecklistTask{{ID: 1, Title: "Order laptop", DueDate: models.NewDate(2025, time.June, 1)}}, nil)
+
+	req := httptest.NewRequest("GET", "/checklist/tasks?ownerAdminId=4&overdue=true&asOf=2025-06-10", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleChecklistTasks(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []models.ChecklistTask
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	assert.Len(t, got, 1)
+}
+
+func TestHandleCompleteChecklistTask(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetChecklistTask", 1).Return(&models.ChecklistTask{ID: 1, Title: "Order laptop"}, nil)
+	mockDB.On("UpdateChecklistTask", mock.MatchedBy(func(task *models.ChecklistTask) bool {
+		return task.CompletedAt != nil
+	})).Return(nil)
+
+	req := httptest.NewRequest("PUT", "/checklist/task?id=1", bytes.NewBufferString(`{"completed":true}`))
+	rr := httptest.NewRecorder()
+
+	server.handleChecklistTask(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"employees/internal/compensation"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// employeeWithCompensation is the employee response for callers allowed to
+// see pay. models.Employee itself never carries compensation, so every other
+// employee response and export leaves it out.
+type employeeWithCompensation struct {
+	*models.Employee
+	Compensation *models.Compensation `json:"compensation"`
+}
+
+// hasPermission reports whether the admin behind the request's token has
+// been granted permission.
+func (s *Server) hasPermission(r *http.Request, permission string) bool {
+	id := adminID(r)
+	if id == nil {
+		return false
+	}
+	admin, err := s.db.GetAdminByID(*id)
+	if err != nil {
+		s.logger.Error("Failed to get admin", zap.Error(err))
+		return false
+	}
+	return admin.HasPermission(permission)
+}
+
+// requirePermission writes a 403 and returns false unless the caller has
+// permission.
+func (s *Server) requirePermission(w http.ResponseWriter, r *http.Request, permission string) bool {
+	if !s.hasPermission(r, permission) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		s.logger.Error("Permission denied", zap.String("permission", permission), zap.Any("adminId", adminID(r)))
+		return false
+	}
+	return true
+}
+
+// mayGrant reports whether the caller holds every permission in granted.
+// Admins can only hand out permissions they have themselves, except for
+// superadmins, who can hand out any.
+func (s *Server) mayGrant(r *http.Request, granted []string) bool {
+	if len(granted) == 0 {
+		return true
+	}
+	if s.hasPermission(r, models.PermissionSuperadmin) {
+		return true
+	}
+	for _, p := range granted {
+		if !s.hasPermission(r, p) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) handleCompensation(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		s.handleAddCompensation(w, r)
+	case "GET":
+		s.handleGetCompensation(w, r)
+	}
+}
+
+// handleGetCompensation returns the employee's pay history, or with ?asOf=
+// only the record in effect on that day.
+func (s *Server) handleGetCompensation(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Get("asOf") != "" {
+		date, ok := s.queryDate(w, r, "asOf")
+		if !ok {
+			return
+		}
+		comp, err := s.db.GetCompensationAsOf(id, date)
+		if err != nil {
+			http.Error(w, "No compensation record as of "+date.String(), http.StatusNotFound)
+			s.logger.Error("Compensation not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, comp)
+		return
+	}
+
+	history, err := s.db.GetCompensationHistory(id)
+	if err != nil {
+		s.logger.Error("Failed to get compensation history", zap.Error(err))
+		http.Error(w, "Failed to get compensation", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Compensation retrieved", zap.Int("employeeId", id), zap.Int("count", len(history)))
+	s.writeJSON(w, http.StatusOK, history)
+}
+
+// handleAddCompensation records a pay change starting on effectiveFrom,
+// which may lie in the past or the future.
+func (s *Server) handleAddCompensation(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var comp models.Compensation
+	if err := json.NewDecoder(r.Body).Decode(&comp); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if comp.EffectiveFrom.IsZero() {
+		http.Error(w, "effectiveFrom is required", http.StatusBadRequest)
+		s.logger.Error("effectiveFrom is required")
+		return
+	}
+	if err := compensation.Validate(comp); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid compensation", zap.Error(err))
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	comp.ID = 0
+	comp.EmployeeID = id
+	if err := s.db.AddCompensation(&comp); err != nil {
+		s.logger.Error("Compensation creation failed", zap.Error(err))
+		http.Error(w, "Failed to add compensation", http.StatusInternalServerError)
+		return
+	}
+
+	// Amounts stay out of the logs, which are readable without the
+	// compensation permission.
+	s.logger.Info("Compensation added", zap.Int("employeeId", id), zap.Int("compensationId", comp.ID))
+	s.writeJSON(w, http.StatusCreated, comp)
+}
+
+func (s *Server) handleBonuses(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		var bonus models.Bonus
+		if err := json.NewDecoder(r.Body).Decode(&bonus); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if bonus.AwardedOn.IsZero() {
+			http.Error(w, "awardedOn is required", http.StatusBadRequest)
+			s.logger.Error("awardedOn is required")
+			return
+		}
+		if err := compensation.ValidateBonus(bonus); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid bonus", zap.Error(err))
+			return
+		}
+		if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Employee not found", zap.Error(err))
+			return
+		}
+
+		bonus.ID = 0
+		bonus.EmployeeID = id
+		if err := s.db.AddBonus(&bonus); err != nil {
+			s.logger.Error("Bonus creation failed", zap.Error(err))
+			http.Error(w, "Failed to add bonus", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Bonus added", zap.Int("employeeId", id), zap.Int("bonusId", bonus.ID))
+		s.writeJSON(w, http.StatusCreated, bonus)
+	case "GET":
+		bonuses, err := s.db.ListBonuses(id)
+		if err != nil {
+			s.logger.Error("Failed to list bonuses", zap.Error(err))
+			http.Error(w, "Failed to list bonuses", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, bonuses)
+	}
+}
@@ -0,0 +1,294 @@
+package api
+
+import (
+	"bytes"
+	"employees/api/auth"
+	"employees/api/middlewares"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func authorize(t *testing.T, req *http.Request, adminID uint32) {
+	token, err := auth.CreateToken(adminID)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+}
+
+func TestHandleAddCompensation(t *testing.T) {
+	hr := &models.Admin{ID: 1, Permissions: []string{models.PermissionCompensation}}
+	clerk := &models.Admin{ID: 2}
+
+	tests := []struct {
+		name       string
+		admin      *models.Admin
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:  "Success",
+			admin: hr,
+			body:  `{"basePay":650000,"currency":"EUR","payFrequency":"monthly","effectiveFrom":"2025-01-01"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("AddCompensation", mock.MatchedBy(func(c *models.Compensation) bool {
+					return c.EmployeeID == 3 && c.BasePay == 650000
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Without Permission",
+			admin:      clerk,
+			body:       `{"basePay":650000,"currency":"EUR","payFrequency":"monthly","effectiveFrom":"2025-01-01"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Invalid Currency",
+			admin:      hr,
+			body:       `{"basePay":650000,"currency":"euro","payFrequency":"monthly","effectiveFrom":"2025-01-01"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", tt.admin.ID).Return(tt.admin, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/employee/compensation?id=3", bytes.NewBufferString(tt.body))
+			authorize(t, req, uint32(tt.admin.ID))
+			rr := httptest.NewRecorder()
+
+			server.handleCompensation(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestGetEmployeeCompensationVisibility(t *testing.T) {
+	emp := &models.Employee{ID: 3, FirstName: "Ada"}
+	comp := &models.Compensation{ID: 8, EmployeeID: 3, BasePay: 650000, Currency: "EUR",
+		PayFrequency: models.PayFrequencyMonthly, EffectiveFrom: models.NewDate(2025, time.January, 1)}
+
+	t.Run("Plain Response Omits Compensation", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetEmployee", "3").Return(emp, nil)
+
+		req := httptest.NewRequest("GET", "/employee?id=3", nil)
+		rr := httptest.NewRecorder()
+
+		server.handleGetEmployee(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "compensation")
+		assert.NotContains(t, rr.Body.String(), "650000")
+	})
+
+	t.Run("Included With Permission", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetEmployee", "3").Return(emp, nil)
+		mockDB.On("GetAdminByID", 1).Return(&models.Admin{ID: 1, Permissions: []string{models.PermissionCompensation}}, nil)
+		mockDB.On("GetCompensationAsOf", 3, mock.Anything).Return(comp, nil)
+
+		req := httptest.NewRequest("GET", "/employee?id=3&include=compensation", nil)
+		authorize(t, req, 1)
+		rr := httptest.NewRecorder()
+
+		server.handleGetEmployee(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got struct {
+			ID           int                  `json:"id"`
+			Compensation *models.Compensation `json:"compensation"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+		assert.Equal(t, 3, got.ID)
+		require.NotNil(t, got.Compensation)
+		assert.Equal(t, int64(650000), got.Compensation.BasePay)
+	})
+
+	t.Run("Forbidden Without Permission", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetEmployee", "3").Return(emp, nil)
+		mockDB.On("GetAdminByID", 2).Return(nil, errors.New("not found"))
+
+		req := httptest.NewRequest("GET", "/employee?id=3&include=compensation", nil)
+		authorize(t, req, 2)
+		rr := httptest.NewRecorder()
+
+		server.handleGetEmployee(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "Ada")
+	})
+}
+
+func TestUpdateAdminPermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		caller     *models.Admin
+		wantStatus int
+	}{
+		{"Granted By Holder", &models.Admin{ID: 1, Permissions: []string{models.PermissionCompensation}}, http.StatusOK},
+		{"Self Escalation", &models.Admin{ID: 2}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByEmail", "clerk@example.com").Return(&models.Admin{ID: 2, Email: "clerk@example.com"}, nil)
+			mockDB.On("GetAdminByID", tt.caller.ID).Return(tt.caller, nil)
+			if tt.wantStatus == http.StatusOK {
+				mockDB.On("UpdateAdmin", mock.MatchedBy(func(a *models.Admin) bool {
+					return a.HasPermission(models.PermissionCompensation)
+				})).Return(nil)
+			}
+
+			req := httptest.NewRequest("PUT", "/admin?email=clerk@example.com", bytes.NewBufferString(`{"permissions":["compensation"]}`))
+			authorize(t, req, uint32(tt.caller.ID))
+			rr := httptest.NewRecorder()
+
+			server.handleUpdateAdmin(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestUpdateAdminPassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		caller     *models.Admin
+		wantStatus int
+	}{
+		{"Own Password", &models.Admin{ID: 2}, http.StatusOK},
+		{"Another Admin's Password", &models.Admin{ID: 3, Permissions: []string{models.PermissionCompensation}}, http.StatusForbidden},
+		{"By Superadmin", &models.Admin{ID: 1, Permissions: []string{models.PermissionSuperadmin}}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByEmail", "clerk@example.com").Return(&models.Admin{ID: 2, Email: "clerk@example.com"}, nil)
+			mockDB.On("GetAdminByID", tt.caller.ID).Return(tt.caller, nil).Maybe()
+			if tt.wantStatus == http.StatusOK {
+				mockDB.On("UpdateAdmin", mock.MatchedBy(func(a *models.Admin) bool {
+					return models.VerifyPassword(a.Password, "s3cret-enough") == nil
+				})).Return(nil)
+			}
+
+			req := httptest.NewRequest("PUT", "/admin?email=clerk@example.com", bytes.NewBufferString(`{"password":"s3cret-enough"}`))
+			authorize(t, req, uint32(tt.caller.ID))
+			rr := httptest.NewRecorder()
+
+			server.handleUpdateAdmin(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestSeedSuperadmin(t *testing.T) {
+	t.Run("Fresh Install", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetAdminByEmail", "root@example.com").Return(nil, errors.New("record not found"))
+		mockDB.On("CreateAdmin", mock.MatchedBy(func(a *models.Admin) bool {
+			return a.HasPermission(models.PermissionSuperadmin) && models.VerifyPassword(a.Password, "changeme") == nil
+		})).Return(nil)
+
+		require.NoError(t, server.SeedSuperadmin("root@example.com", "changeme"))
+	})
+
+	t.Run("Existing Admin Keeps Password", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetAdminByEmail", "root@example.com").
+			Return(&models.Admin{ID: 1, Email: "root@example.com", Password: "hash"}, nil)
+		mockDB.On("UpdateAdmin", mock.MatchedBy(func(a *models.Admin) bool {
+			return a.HasPermission(models.PermissionSuperadmin) && a.Password == "hash"
+		})).Return(nil)
+
+		require.NoError(t, server.SeedSuperadmin("root@example.com", "ignored"))
+	})
+
+	t.Run("Not Configured", func(t *testing.T) {
+		server, _ := setupTestServer(t)
+		require.NoError(t, server.SeedSuperadmin("", ""))
+	})
+}
+
+// TestGrantPermissionEndToEnd seeds the first superadmin, has it grant the
+// compensation permission through /admin and checks the grantee can then
+// reach a compensation endpoint it was refused before.
+func TestGrantPermissionEndToEnd(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	admins := map[int]*models.Admin{2: {ID: 2, Email: "clerk@example.com"}}
+	byEmail := func(email string) (*models.Admin, error) {
+		for _, a := range admins {
+			if a.Email == email {
+				copied := *a
+				return &copied, nil
+			}
+		}
+		return nil, errors.New("record not found")
+	}
+	mockDB.On("GetAdminByEmail", mock.Anything).Return(
+		func(email string) *models.Admin { a, _ := byEmail(email); return a },
+		func(email string) error { _, err := byEmail(email); return err })
+	mockDB.On("GetAdminByID", mock.Anything).Return(
+		func(id int) *models.Admin { return admins[id] },
+		func(id int) error {
+			if admins[id] == nil {
+				return errors.New("record not found")
+			}
+			return nil
+		})
+	mockDB.On("CreateAdmin", mock.Anything).Run(func(args mock.Arguments) {
+		a := args.Get(0).(*models.Admin)
+		a.ID = 1
+		admins[a.ID] = a
+	}).Return(nil)
+	mockDB.On("UpdateAdmin", mock.Anything).Run(func(args mock.Arguments) {
+		a := args.Get(0).(*models.Admin)
+		admins[a.ID] = a
+	}).Return(nil)
+	mockDB.On("GetCompensationHistory", 3).Return([]models.Compensation{}, nil)
+
+	getCompensation := func() int {
+		req := httptest.NewRequest("GET", "/employee/compensation?id=3", nil)
+		authorize(t, req, 2)
+		rr := httptest.NewRecorder()
+		middlewares.SetMiddlewareAuthentication(server.handleCompensation)(rr, req)
+		return rr.Code
+	}
+	require.Equal(t, http.StatusForbidden, getCompensation())
+
+	require.NoError(t, server.SeedSuperadmin("root@example.com", "changeme"))
+
+	req := httptest.NewRequest("PUT", "/admin?email=clerk@example.com", bytes.NewBufferString(`{"permissions":["compensation"]}`))
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+	middlewares.SetMiddlewareAuthentication(server.handleAdmin)(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	assert.Equal(t, http.StatusOK, getCompensation())
+
+	anonymous := httptest.NewRequest("PUT", "/admin?email=clerk@example.com", bytes.NewBufferString(`{"password":"hijacked"}`))
+	rr = httptest.NewRecorder()
+	middlewares.SetMiddlewareAuthentication(server.handleAdmin)(rr, anonymous)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,308 @@
+package api
+
+import (
+	"employees/internal/contract"
+	"employees/internal/db"
+	"employees/internal/lifecycle"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// contractAlertDays is how far ahead of a contract's notice deadline, or
+// the end of a probation period, an alert goes out.
+const contractAlertDays = 30
+
+func (s *Server) handleContracts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		var c models.Contract
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		c.ID = 0
+		c.EmployeeID = id
+		c.EndAlertedOn, c.ProbationAlertedOn, c.EndedAt = nil, nil, nil
+		if !s.validContract(w, &c) {
+			return
+		}
+		if err := s.db.CreateContract(&c); err != nil {
+			s.logger.Error("Contract creation failed", zap.Error(err))
+			http.Error(w, "Failed to create contract", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Contract created", zap.Any("contract", c))
+		s.writeJSON(w, http.StatusCreated, c)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		contracts, err := s.db.ListContracts(id)
+		if err != nil {
+			s.logger.Error("Failed to list contracts", zap.Error(err))
+			http.Error(w, "Failed to list contracts", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, contracts)
+	case "PUT":
+		s.handleUpdateContract(w, r)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "contractId")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteContract(id); err != nil {
+			s.logger.Error("Contract deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete contract", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Contract deleted", zap.Int("contractId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleUpdateContract(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "contractId")
+	if !ok {
+		return
+	}
+	existing, err := s.db.GetContract(id)
+	if err != nil {
+		http.Error(w, "Contract not found", http.StatusNotFound)
+		s.logger.Error("Contract not found", zap.Error(err))
+		return
+	}
+
+	var c models.Contract
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	c.ID = existing.ID
+	c.EmployeeID = existing.EmployeeID
+	c.CreatedAt = existing.CreatedAt
+	c.EndedAt = existing.EndedAt
+	// An extension or a new notice period moves the deadline and needs a
+	// fresh alert, as does a change to the probation period.
+	c.EndAlertedOn, c.ProbationAlertedOn = nil, nil
+	if sameDate(c.EndDate, existing.EndDate) && c.NoticePeriodDays == existing.NoticePeriodDays {
+		c.EndAlertedOn = existing.EndAlertedOn
+	}
+	if sameDate(c.ProbationEndsOn, existing.ProbationEndsOn) {
+		c.ProbationAlertedOn = existing.ProbationAlertedOn
+	}
+	if !s.validContract(w, &c) {
+		return
+	}
+
+	if err := s.db.UpdateContract(&c); err != nil {
+		s.logger.Error("Contract update failed", zap.Error(err))
+		http.Error(w, "Failed to update contract", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Contract updated", zap.Any("contract", c))
+	s.writeJSON(w, http.StatusOK, c)
+}
+
+// validContract validates c and checks that it does not overlap another of
+// the employee's contracts. A contract that has already ended when it is
+// recorded is history, so endContracts leaves it alone. It writes the error
+// response itself.
+func (s *Server) validContract(w http.ResponseWriter, c *models.Contract) bool {
+	if err := contract.Validate(c); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid contract", zap.Error(err))
+		return false
+	}
+	if _, err := s.db.GetEmployee(strconv.Itoa(c.EmployeeID)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return false
+	}
+	contracts, err := s.db.ListContracts(c.EmployeeID)
+	if err != nil {
+		s.logger.Error("Failed to list contracts", zap.Error(err))
+		http.Error(w, "Failed to save contract", http.StatusInternalServerError)
+		return false
+	}
+	if other := contract.Overlapping(contracts, *c); other != nil {
+		http.Error(w, "Contract overlaps contract "+strconv.Itoa(other.ID), http.StatusConflict)
+		s.logger.Error("Contracts overlap", zap.Int("contractId", other.ID))
+		return false
+	}
+
+	now := time.Now()
+	if c.EndedAt == nil && c.EndDate != nil && c.EndDate.Before(models.DateOf(now).Time) {
+		c.EndedAt = &now
+	}
+	return true
+}
+
+// withinDays reads ?within=, a number of days defaulting to
+// contractAlertDays. It writes a 400 response and returns false when it is
+// malformed.
+func (s *Server) withinDays(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("within")
+	if value == "" {
+		return contractAlertDays, true
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		http.Error(w, "within must be a number of days", http.StatusBadRequest)
+		s.logger.Error("Invalid within", zap.String("within", value))
+		return 0, false
+	}
+	return days, true
+}
+
+// handleEndingContracts lists contracts whose notice deadline falls within
+// ?within= days (default 30) and that have not been renewed, including any
+// whose deadline or end has already passed.
+func (s *Server) handleEndingContracts(w http.ResponseWriter, r *http.Request) {
+	within, ok := s.withinDays(w, r)
+	if !ok {
+		return
+	}
+	contracts, err := s.db.ListEndingContracts(models.DateOf(time.Now()).AddDays(within))
+	if err != nil {
+		s.logger.Error("Failed to list ending contracts", zap.Error(err))
+		http.Error(w, "Failed to list ending contracts", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, contracts)
+}
+
+// handleEndingProbations lists contracts whose probation period ends
+// within ?within= days (default 30).
+func (s *Server) handleEndingProbations(w http.ResponseWriter, r *http.Request) {
+	within, ok := s.withinDays(w, r)
+	if !ok {
+		return
+	}
+	today := models.DateOf(time.Now())
+	contracts, err := s.db.ListEndingProbations(today, today.AddDays(within))
+	if err != nil {
+		s.logger.Error("Failed to list ending probations", zap.Error(err))
+		http.Error(w, "Failed to list ending probations", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, contracts)
+}
+
+// alertContracts logs a warning once for each contract that comes within
+// contractAlertDays of its notice deadline, and once for each probation
+// period that comes within contractAlertDays of ending.
+func (s *Server) alertContracts(now time.Time) error {
+	today := models.DateOf(now)
+	contracts, err := s.db.ListEndingContracts(today.AddDays(contractAlertDays))
+	if err != nil {
+		return err
+	}
+	var alerted []int
+	for _, c := range contracts {
+		if c.EndAlertedOn != nil {
+			continue
+		}
+		s.logger.Warn("Contract ending",
+			zap.Int("employeeId", c.EmployeeID),
+			zap.Int("contractId", c.ID),
+			zap.String("type", string(c.Type)),
+			zap.Stringer("noticeBy", c.NoticeBy()),
+			zap.Stringer("endDate", c.EndDate))
+		alerted = append(alerted, c.ID)
+	}
+	if err := s.db.MarkContractEndsAlerted(alerted, today); err != nil {
+		return err
+	}
+
+	probations, err := s.db.ListEndingProbations(today, today.AddDays(contractAlertDays))
+	if err != nil {
+		return err
+	}
+	alerted = nil
+	for _, c := range probations {
+		if c.ProbationAlertedOn != nil {
+			continue
+		}
+		s.logger.Warn("Probation ending",
+			zap.Int("employeeId", c.EmployeeID),
+			zap.Int("contractId", c.ID),
+			zap.Stringer("probationEndsOn", c.ProbationEndsOn))
+		alerted = append(alerted, c.ID)
+	}
+	return s.db.MarkProbationsAlerted(alerted, today)
+}
+
+// endContracts terminates employees whose last contract has ended, from
+// the day after its end date, and runs the termination hooks. An employee
+// with a status change already scheduled is left until it has applied.
+func (s *Server) endContracts(now time.Time) error {
+	today := models.DateOf(now)
+	contracts, err := s.db.ListEndingContracts(today)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range contracts {
+		if !c.EndDate.Before(today.Time) {
+			continue
+		}
+		emp, err := s.db.GetEmployee(strconv.Itoa(c.EmployeeID))
+		if err != nil {
+			s.logger.Error("Employee not found", zap.Int("employeeId", c.EmployeeID), zap.Error(err))
+			continue
+		}
+
+		if err := lifecycle.Transition(emp.Status, models.EmployeeStatusTerminated); err != nil {
+			s.logger.Error("Contract ended but employee cannot be terminated", zap.Int("contractId", c.ID), zap.Error(err))
+		} else {
+			pending, err := s.db.GetPendingStatusTransition(emp.ID)
+			if err != nil {
+				return err
+			}
+			if pending != nil {
+				s.logger.Warn("Contract ended with a status change pending", zap.Int("contractId", c.ID),
+					zap.Any("transition", pending))
+				continue
+			}
+
+			tr := models.StatusTransition{
+				EmployeeID:    emp.ID,
+				From:          emp.Status,
+				To:            models.EmployeeStatusTerminated,
+				Reason:        "Contract ended on " + c.EndDate.String(),
+				EffectiveDate: c.EndDate.AddDays(1),
+				AppliedAt:     &now,
+			}
+			err = s.db.RecordStatusTransition(&tr)
+			if errors.Is(err, db.ErrConflict) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			emp.Status = tr.To
+			s.runHooks(*emp, tr)
+			s.logger.Info("Employee terminated at end of contract", zap.Int("contractId", c.ID),
+				zap.Any("transition", tr))
+		}
+
+		if err := s.db.MarkContractEnded(c.ID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCreateContract(t *testing.T) {
+	previousEnd := models.NewDate(2024, time.December, 31)
+	previous := models.Contract{ID: 1, EmployeeID: 3, Type: models.ContractTypeFixedTerm,
+		StartDate: models.NewDate(2024, time.January, 1), EndDate: &previousEnd}
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Renewal",
+			body: `{"type":"fixed_term","startDate":"2025-01-01","endDate":"2099-12-31","noticePeriodDays":30,"endedAt":"2025-01-01T00:00:00Z"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListContracts", 3).Return([]models.Contract{previous}, nil)
+				m.On("CreateContract", mock.MatchedBy(func(c *models.Contract) bool {
+					return c.EmployeeID == 3 && c.EndedAt == nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Recorded After Ending",
+			body: `{"type":"contractor","startDate":"2023-01-01","endDate":"2023-06-30"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListContracts", 3).Return([]models.Contract{previous}, nil)
+				m.On("CreateContract", mock.MatchedBy(func(c *models.Contract) bool {
+					return c.EndedAt != nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Overlapping",
+			body: `{"type":"permanent","startDate":"2024-12-01"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListContracts", 3).Return([]models.Contract{previous}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Fixed Term Without End",
+			body:       `{"type":"fixed_term","startDate":"2025-01-01"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/employee/contracts?id=3", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleContracts(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestAlertContracts(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	now := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	today := models.DateOf(now)
+	ends := today.AddDays(40)
+	probation := today.AddDays(5)
+	mockDB.On("ListEndingContracts", today.AddDays(contractAlertDays)).Return([]models.Contract{
+		{ID: 1, EmployeeID: 3, Type: models.ContractTypeFixedTerm, EndDate: &ends, NoticePeriodDays: 14},
+		{ID: 2, EmployeeID: 4, Type: models.ContractTypeContractor, EndDate: &ends, EndAlertedOn: &today},
+	}, nil)
+	mockDB.On("MarkContractEndsAlerted", []int{1}, today).Return(nil)
+	mockDB.On("ListEndingProbations", today, today.AddDays(contractAlertDays)).Return([]models.Contract{
+		{ID: 5, EmployeeID: 6, ProbationEndsOn: &probation},
+	}, nil)
+	mockDB.On("MarkProbationsAlerted", []int{5}, today).Return(nil)
+
+	require.NoError(t, server.alertContracts(now))
+}
+
+func TestEndContracts(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	now := time.Date(2025, time.July, 1, 9, 0, 0, 0, time.UTC)
+	today := models.DateOf(now)
+	ended := today.AddDays(-1)
+	endsToday := today
+	mockDB.On("ListEndingContracts", today).Return([]models.Contract{
+		{ID: 1, EmployeeID: 3, EndDate: &ended},
+		{ID: 2, EmployeeID: 4, EndDate: &ended},
+		{ID: 3, EmployeeID: 5, EndDate: &endsToday},
+	}, nil)
+
+	mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusActive}, nil)
+	mockDB.On("GetPendingStatusTransition", 3).Return(nil, nil)
+	mockDB.On("RecordStatusTransition", mock.MatchedBy(func(tr *models.StatusTransition) bool {
+		return tr.EmployeeID == 3 && tr.To == models.EmployeeStatusTerminated && tr.EffectiveDate.Equal(today.Time) &&
+			tr.AppliedAt != nil
+	})).Return(nil)
+	mockDB.On("GetDirectReports", 3).Return([]models.Employee{}, nil)
+	mockDB.On("StartChecklists", mock.AnythingOfType("*models.Employee"), models.ChecklistKindOffboarding,
+		mock.Anything).Return([]models.Checklist{}, nil)
+	mockDB.On("ListAssets", models.AssetFilter{EmployeeID: intPtr(3)}).Return([]models.Asset{}, nil)
+	mockDB.On("ListPlannedPositions", models.PlannedPositionFilter{EmployeeID: intPtr(3)}).Return([]models.PlannedPosition{}, nil)
+	mockDB.On("MarkContractEnded", 1, now).Return(nil)
+
+	// Employee 4 already has a status change scheduled, so their contract
+	// is left for a later run.
+	mockDB.On("GetEmployee", "4").Return(&models.Employee{ID: 4, Status: models.EmployeeStatusOnLeave}, nil)
+	mockDB.On("GetPendingStatusTransition", 4).Return(&models.StatusTransition{ID: 9, To: models.EmployeeStatusActive}, nil)
+
+	require.NoError(t, server.endContracts(now))
+}
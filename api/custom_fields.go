@@ -0,0 +1,175 @@
+package api
+
+import (
+	"employees/internal/customfield"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// customFieldParam prefixes the query parameters and CSV columns that hold
+// custom field values, as in ?cf.tshirtSize=M.
+const customFieldParam = "cf."
+
+func (s *Server) handleCustomFields(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var def models.CustomFieldDefinition
+		if err := json.NewDecoder(r.Body).Decode(&def); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		def.ID = 0
+		if err := customfield.ValidateDefinition(def); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid custom field", zap.Error(err))
+			return
+		}
+		if err := s.db.CreateCustomField(&def); err != nil {
+			s.logger.Error("Custom field creation failed", zap.Error(err))
+			http.Error(w, "Failed to create custom field", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Custom field created", zap.Any("customField", def))
+		s.writeJSON(w, http.StatusCreated, def)
+	case "GET":
+		defs, err := s.db.ListCustomFields()
+		if err != nil {
+			s.logger.Error("Failed to list custom fields", zap.Error(err))
+			http.Error(w, "Failed to list custom fields", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, defs)
+	case "PUT":
+		s.handleUpdateCustomField(w, r)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "fieldId")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteCustomField(id); err != nil {
+			http.Error(w, "Custom field not found", http.StatusNotFound)
+			s.logger.Error("Custom field deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Custom field deleted", zap.Int("fieldId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleUpdateCustomField changes a definition's label, rules or options.
+// The key and type are fixed once created because stored values depend on
+// them. Values already stored are checked against new rules the next time
+// the employee is saved.
+func (s *Server) handleUpdateCustomField(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "fieldId")
+	if !ok {
+		return
+	}
+	existing, err := s.db.GetCustomField(id)
+	if err != nil {
+		http.Error(w, "Custom field not found", http.StatusNotFound)
+		s.logger.Error("Custom field not found", zap.Error(err))
+		return
+	}
+
+	var def models.CustomFieldDefinition
+	if err := json.NewDecoder(r.Body).Decode(&def); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if (def.Key != "" && def.Key != existing.Key) || (def.Type != "" && def.Type != existing.Type) {
+		http.Error(w, "key and type cannot be changed", http.StatusBadRequest)
+		s.logger.Error("Custom field key or type changed", zap.Int("fieldId", id))
+		return
+	}
+	def.ID = existing.ID
+	def.Key = existing.Key
+	def.Type = existing.Type
+	def.CreatedAt = existing.CreatedAt
+	if err := customfield.ValidateDefinition(def); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid custom field", zap.Error(err))
+		return
+	}
+
+	if err := s.db.UpdateCustomField(&def); err != nil {
+		s.logger.Error("Custom field update failed", zap.Error(err))
+		http.Error(w, "Failed to update custom field", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Custom field updated", zap.Any("customField", def))
+	s.writeJSON(w, http.StatusOK, def)
+}
+
+// checkCustomFields validates and normalizes the employee's custom field
+// values against the current definitions. Errors wrapping
+// customfield.ErrInvalid are the client's fault.
+func (s *Server) checkCustomFields(emp *models.Employee) error {
+	defs, err := s.db.ListCustomFields()
+	if err != nil {
+		return err
+	}
+	values, err := customfield.Validate(defs, emp.CustomFields)
+	if err != nil {
+		return err
+	}
+	emp.CustomFields = values
+	return nil
+}
+
+// writeCustomFieldError answers a failed checkCustomFields.
+func (s *Server) writeCustomFieldError(w http.ResponseWriter, err error) {
+	if errors.Is(err, customfield.ErrInvalid) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid custom fields", zap.Error(err))
+		return
+	}
+	s.logger.Error("Failed to list custom fields", zap.Error(err))
+	http.Error(w, "Failed to list custom fields", http.StatusInternalServerError)
+}
+
+// customFieldFilter reads ?cf.<key>=value parameters into a filter on
+// custom field values. It returns nil when there are none.
+func (s *Server) customFieldFilter(r *http.Request) (map[string]any, error) {
+	var params []string
+	for name := range r.URL.Query() {
+		if strings.HasPrefix(name, customFieldParam) {
+			params = append(params, name)
+		}
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	defs, err := s.db.ListCustomFields()
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]models.CustomFieldDefinition, len(defs))
+	for _, def := range defs {
+		byKey[def.Key] = def
+	}
+
+	filter := make(map[string]any, len(params))
+	for _, name := range params {
+		key := strings.TrimPrefix(name, customFieldParam)
+		def, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", customfield.ErrInvalid, key)
+		}
+		value, err := customfield.Parse(def, r.URL.Query().Get(name))
+		if err != nil {
+			return nil, err
+		}
+		filter[key] = value
+	}
+	return filter, nil
+}
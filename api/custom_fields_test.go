@@ -0,0 +1,254 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testCustomFields = []models.CustomFieldDefinition{
+	{ID: 1, Key: "tshirtSize", Type: models.CustomFieldEnum, Options: []string{"S", "M", "L"}},
+	{ID: 2, Key: "badgeNumber", Type: models.CustomFieldNumber, Required: true},
+}
+
+func TestHandleCustomFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create Enum",
+			method: "POST",
+			url:    "/custom-fields",
+			body:   `{"key":"tshirtSize","label":"T-shirt size","type":"enum","options":["S","M","L"]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("CreateCustomField", mock.AnythingOfType("*models.CustomFieldDefinition")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Create Invalid Key",
+			method:     "POST",
+			url:        "/custom-fields",
+			body:       `{"key":"T-shirt size","type":"string"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Update Options",
+			method: "PUT",
+			url:    "/custom-fields?fieldId=1",
+			body:   `{"label":"Shirt","options":["S","M","L","XL"]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCustomField", 1).Return(&testCustomFields[0], nil)
+				m.On("UpdateCustomField", mock.MatchedBy(func(d *models.CustomFieldDefinition) bool {
+					return d.Key == "tshirtSize" && d.Type == models.CustomFieldEnum && len(d.Options) == 4
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Update Type",
+			method: "PUT",
+			url:    "/custom-fields?fieldId=1",
+			body:   `{"type":"string"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCustomField", 1).Return(&testCustomFields[0], nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, tt.url, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleCustomFields(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestCreateEmployeeCustomFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     string
+		wantStatus int
+	}{
+		{name: "Valid", fields: `{"tshirtSize":"M","badgeNumber":1234}`, wantStatus: http.StatusCreated},
+		{name: "Missing Required", fields: `{"tshirtSize":"M"}`, wantStatus: http.StatusBadRequest},
+		{name: "Unknown Field", fields: `{"badgeNumber":1,"shoeSize":42}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+			if tt.wantStatus == http.StatusCreated {
+				mockDB.On("CreateEmployee", mock.MatchedBy(func(e *models.Employee) bool {
+					return e.CustomFields["badgeNumber"] == 1234.0 && e.CustomFields["tshirtSize"] == "M"
+				})).Return(nil)
+			}
+
+			body := `{"firstName":"Ana","lastName":"Lima","email":"ana@example.com","customFields":` + tt.fields + `}`
+			rr := httptest.NewRecorder()
+			server.handleCreateEmployee(rr, httptest.NewRequest("POST", "/employee", bytes.NewBufferString(body)))
+
+			assert.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestListEmployeesByCustomField(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+	mockDB.On("ListEmployees", models.EmployeeFilter{CustomFields: map[string]any{"badgeNumber": 1234.0}}).
+		Return([]models.Employee{{ID: 1, CustomFields: map[string]any{"badgeNumber": 1234.0}}}, nil)
+
+	rr := httptest.NewRecorder()
+	server.handleListEmployees(rr, httptest.NewRequest("GET", "/employees?cf.badgeNumber=1234", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	server.handleListEmployees(rr, httptest.NewRequest("GET", "/employees?cf.shoeSize=42", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestExportEmployees(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+	mockDB.On("ListEmployees", models.EmployeeFilter{}).Return([]models.Employee{
+		{ID: 1, FirstName: "Ana", LastName: "Lima", Email: "ana@example.com", ManagerID: intPtr(2),
+			Status: models.EmployeeStatusActive, CustomFields: map[string]any{"badgeNumber": 1234.0}},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	server.handleExportEmployees(rr, httptest.NewRequest("GET", "/employees/export", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	rows, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "firstName", "lastName", "email", "jobTitle", "departmentId",
+		"managerId", "locationId", "status", "contractType", "cf.tshirtSize", "cf.badgeNumber"}, rows[0])
+	assert.Equal(t, []string{"1", "Ana", "Lima", "ana@example.com", "", "", "2", "", "active", "", "", "1234"}, rows[1])
+}
+
+func TestImportEmployees(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+		mockDB.On("FindEmployeeEmails", []string{"ana@example.com", "bo@example.com"}).Return([]string{}, nil)
+		var created []models.Employee
+		mockDB.On("CreateEmployees", mock.AnythingOfType("[]models.Employee")).Return(nil).
+			Run(func(args mock.Arguments) {
+				emps := args.Get(0).([]models.Employee)
+				for i := range emps {
+					emps[i].ID = i + 10
+				}
+				created = emps
+			})
+
+		body := "firstName,lastName,email,cf.tshirtSize,cf.badgeNumber\n" +
+			"Ana,Lima,ana@example.com,M,1234\n" +
+			"Bo,Berg,bo@example.com,,77\n"
+		rr := httptest.NewRecorder()
+		server.handleImportEmployees(rr, httptest.NewRequest("POST", "/employees/import", strings.NewReader(body)))
+
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var resp struct {
+			Created []int `json:"created"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, []int{10, 11}, resp.Created)
+		assert.Equal(t, map[string]any{"tshirtSize": "M", "badgeNumber": 1234.0}, created[0].CustomFields)
+		assert.Equal(t, models.EmployeeStatusActive, created[1].Status)
+	})
+
+	t.Run("Invalid Rows", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+		mockDB.On("FindEmployeeEmails", []string{"bo@example.com"}).Return([]string{}, nil)
+
+		body := "firstName,lastName,email,cf.badgeNumber\n" +
+			"Ana,Lima,ana@example.com,lots\n" +
+			"Bo,Berg,bo@example.com,77\n" +
+			"Bo,Again,BO@example.com,78\n"
+		rr := httptest.NewRecorder()
+		server.handleImportEmployees(rr, httptest.NewRequest("POST", "/employees/import", strings.NewReader(body)))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var resp struct {
+			Errors []importError `json:"errors"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.Len(t, resp.Errors, 2)
+		assert.Equal(t, 2, resp.Errors[0].Line)
+		assert.Equal(t, 4, resp.Errors[1].Line)
+		mockDB.AssertNotCalled(t, "CreateEmployees", mock.Anything)
+	})
+
+	t.Run("Email Already In Use", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+		mockDB.On("FindEmployeeEmails", []string{"ana@example.com", "Bo@example.com"}).Return([]string{"bo@example.com"}, nil)
+
+		body := "firstName,lastName,email,cf.badgeNumber\n" +
+			"Ana,Lima,ana@example.com,1\n" +
+			"Bo,Berg,Bo@example.com,2\n"
+		rr := httptest.NewRecorder()
+		server.handleImportEmployees(rr, httptest.NewRequest("POST", "/employees/import", strings.NewReader(body)))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var resp struct {
+			Errors []importError `json:"errors"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, []importError{{Line: 3, Error: "email is already used by an employee"}}, resp.Errors)
+		mockDB.AssertNotCalled(t, "CreateEmployees", mock.Anything)
+	})
+
+	t.Run("Insert Fails", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+		mockDB.On("FindEmployeeEmails", []string{"ana@example.com"}).Return([]string{}, nil)
+		mockDB.On("CreateEmployees", mock.Anything).Return(errors.New("connection reset"))
+
+		rr := httptest.NewRecorder()
+		server.handleImportEmployees(rr, httptest.NewRequest("POST", "/employees/import",
+			strings.NewReader("firstName,lastName,email,cf.badgeNumber\nAna,Lima,ana@example.com,1\n")))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "created")
+	})
+
+	t.Run("Unknown Column", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("ListCustomFields").Return(testCustomFields, nil)
+
+		rr := httptest.NewRecorder()
+		server.handleImportEmployees(rr, httptest.NewRequest("POST", "/employees/import",
+			strings.NewReader("firstName,lastName,email,cf.shoeSize\n")))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,328 @@
+package api
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"employees/internal/blob"
+	"employees/internal/models"
+	"employees/internal/signedurl"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	maxDocumentSize    = 10 << 20
+	defaultDocumentTTL = 15 * time.Minute
+	maxDocumentTTL     = 24 * time.Hour
+)
+
+// documentTypes are the sniffed content types accepted for upload.
+var documentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+var documentCategories = map[models.DocumentCategory]bool{
+	models.DocumentCategoryContract:    true,
+	models.DocumentCategoryID:          true,
+	models.DocumentCategoryCertificate: true,
+	models.DocumentCategoryReceipt:     true,
+	models.DocumentCategoryOther:       true,
+}
+
+func documentResource(id int) string {
+	return "document/" + strconv.Itoa(id)
+}
+
+func (s *Server) handleDocuments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleUploadDocument(w, r)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		docs, err := s.db.ListDocuments(id, models.DocumentCategory(r.URL.Query().Get("category")))
+		if err != nil {
+			s.logger.Error("Failed to list documents", zap.Error(err))
+			http.Error(w, "Failed to list documents", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, docs)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "documentId")
+		if !ok {
+			return
+		}
+		doc, err := s.db.GetDocument(id)
+		if err != nil {
+			http.Error(w, "Document not found", http.StatusNotFound)
+			s.logger.Error("Document not found", zap.Error(err))
+			return
+		}
+		if err := s.db.DeleteDocument(id); err != nil {
+			s.logger.Error("Document deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete document", http.StatusInternalServerError)
+			return
+		}
+		// The record is gone, so a leftover blob is only wasted space.
+		if err := s.blobs.Delete(doc.StorageKey); err != nil {
+			s.logger.Error("Failed to delete document contents", zap.Int("documentId", id), zap.Error(err))
+		}
+		s.logger.Info("Document deleted", zap.Int("documentId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleUploadDocument stores the "file" part of a multipart form for the
+// employee, with "category" and an optional "expiresOn" date. The content
+// type is sniffed from the file rather than trusted from the client.
+func (s *Server) handleUploadDocument(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentSize+1<<20)
+	if err := r.ParseMultipartForm(maxDocumentSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "Document is too large", http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		}
+		s.logger.Error("Invalid document upload", zap.Error(err))
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	doc := models.Document{
+		EmployeeID:        id,
+		Category:          models.DocumentCategory(r.FormValue("category")),
+		UploadedByAdminID: adminID(r),
+	}
+	if !documentCategories[doc.Category] {
+		http.Error(w, "category must be contract, id, certificate or other", http.StatusBadRequest)
+		s.logger.Error("Invalid document category", zap.String("category", string(doc.Category)))
+		return
+	}
+	if expires := r.FormValue("expiresOn"); expires != "" {
+		date, err := models.ParseDate(expires)
+		if err != nil {
+			http.Error(w, "Invalid expiresOn date", http.StatusBadRequest)
+			s.logger.Error("Invalid expiresOn date", zap.Error(err))
+			return
+		}
+		doc.ExpiresOn = &date
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		s.logger.Error("file is required", zap.Error(err))
+		return
+	}
+	defer file.Close()
+	if header.Size > maxDocumentSize {
+		http.Error(w, "Document is too large", http.StatusRequestEntityTooLarge)
+		s.logger.Error("Document is too large", zap.Int64("size", header.Size))
+		return
+	}
+	doc.FileName = filepath.Base(filepath.Clean("/" + header.Filename))
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		s.logger.Error("Failed to read document", zap.Error(err))
+		http.Error(w, "Failed to read document", http.StatusBadRequest)
+		return
+	}
+	head = head[:n]
+	doc.ContentType = http.DetectContentType(head)
+	if !documentTypes[doc.ContentType] {
+		http.Error(w, "Unsupported document type "+doc.ContentType, http.StatusUnsupportedMediaType)
+		s.logger.Error("Unsupported document type", zap.String("contentType", doc.ContentType))
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		s.logger.Error("Failed to generate storage key", zap.Error(err))
+		http.Error(w, "Failed to store document", http.StatusInternalServerError)
+		return
+	}
+	doc.StorageKey = fmt.Sprintf("employees/%d/%s", id, hex.EncodeToString(key))
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	contents := io.TeeReader(io.MultiReader(bytes.NewReader(head), file), io.MultiWriter(hash, counter))
+	if err := s.blobs.Put(doc.StorageKey, contents); err != nil {
+		s.logger.Error("Failed to store document", zap.Error(err))
+		http.Error(w, "Failed to store document", http.StatusInternalServerError)
+		return
+	}
+	doc.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	doc.Size = counter.n
+
+	if err := s.db.CreateDocument(&doc); err != nil {
+		s.logger.Error("Document creation failed", zap.Error(err))
+		if err := s.blobs.Delete(doc.StorageKey); err != nil {
+			s.logger.Error("Failed to delete orphaned document contents", zap.Error(err))
+		}
+		http.Error(w, "Failed to store document", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Document uploaded", zap.Any("document", doc))
+	s.writeJSON(w, http.StatusCreated, doc)
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// serveDocument streams the document's contents as an attachment.
+func (s *Server) serveDocument(w http.ResponseWriter, doc *models.Document) {
+	contents, err := s.blobs.Get(doc.StorageKey)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, blob.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, "Document contents unavailable", status)
+		s.logger.Error("Failed to read document contents", zap.Int("documentId", doc.ID), zap.Error(err))
+		return
+	}
+	defer contents.Close()
+
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(doc.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": doc.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", `"`+doc.SHA256+`"`)
+	if _, err := io.Copy(w, contents); err != nil {
+		s.logger.Error("Failed to send document", zap.Int("documentId", doc.ID), zap.Error(err))
+	}
+}
+
+func (s *Server) handleDownloadDocument(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "documentId")
+	if !ok {
+		return
+	}
+	doc, err := s.db.GetDocument(id)
+	if err != nil {
+		http.Error(w, "Document not found", http.StatusNotFound)
+		s.logger.Error("Document not found", zap.Error(err))
+		return
+	}
+	s.serveDocument(w, doc)
+}
+
+// handleDocumentURL returns a link that downloads the document without a
+// login token until it expires after ?ttl= (default 15m, at most 24h).
+func (s *Server) handleDocumentURL(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "documentId")
+	if !ok {
+		return
+	}
+
+	ttl := defaultDocumentTTL
+	if value := r.URL.Query().Get("ttl"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 || parsed > maxDocumentTTL {
+			http.Error(w, "ttl must be a duration up to 24h", http.StatusBadRequest)
+			s.logger.Error("Invalid ttl", zap.String("ttl", value))
+			return
+		}
+		ttl = parsed
+	}
+
+	if _, err := s.db.GetDocument(id); err != nil {
+		http.Error(w, "Document not found", http.StatusNotFound)
+		s.logger.Error("Document not found", zap.Error(err))
+		return
+	}
+
+	expires := time.Now().Add(ttl)
+	params := s.signer.Sign(documentResource(id), expires)
+	params.Set("documentId", strconv.Itoa(id))
+
+	s.logger.Info("Document link issued", zap.Int("documentId", id), zap.Any("adminId", adminID(r)))
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"url":       "/documents/signed?" + params.Encode(),
+		"expiresAt": expires.UTC().Truncate(time.Second),
+	})
+}
+
+// handleSignedDocument serves a document to anyone holding a valid,
+// unexpired link from handleDocumentURL.
+func (s *Server) handleSignedDocument(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "documentId")
+	if !ok {
+		return
+	}
+	if err := s.signer.Verify(documentResource(id), r.URL.Query(), time.Now()); err != nil {
+		status := http.StatusForbidden
+		if errors.Is(err, signedurl.ErrExpired) {
+			status = http.StatusGone
+		}
+		http.Error(w, err.Error(), status)
+		s.logger.Error("Rejected document link", zap.Int("documentId", id), zap.Error(err))
+		return
+	}
+
+	doc, err := s.db.GetDocument(id)
+	if err != nil {
+		http.Error(w, "Document not found", http.StatusNotFound)
+		s.logger.Error("Document not found", zap.Error(err))
+		return
+	}
+	s.serveDocument(w, doc)
+}
+
+// handleExpiringDocuments lists documents expiring within ?within= days
+// (default 30), including any already expired.
+func (s *Server) handleExpiringDocuments(w http.ResponseWriter, r *http.Request) {
+	within := 30
+	if value := r.URL.Query().Get("within"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 0 {
+			http.Error(w, "within must be a number of days", http.StatusBadRequest)
+			s.logger.Error("Invalid within", zap.String("within", value))
+			return
+		}
+		within = days
+	}
+
+	docs, err := s.db.ListExpiringDocuments(models.DateOf(time.Now()).AddDays(within))
+	if err != nil {
+		s.logger.Error("Failed to list expiring documents", zap.Error(err))
+		http.Error(w, "Failed to list expiring documents", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, docs)
+}
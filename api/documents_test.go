@@ -0,0 +1,155 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"employees/internal/blob"
+	"employees/internal/models"
+	"encoding/hex"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testPDF = []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n%%EOF\n")
+
+func multipartUpload(t *testing.T, fields map[string]string, fileName string, contents []byte) (*bytes.Buffer, string) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for k, v := range fields {
+		require.NoError(t, mw.WriteField(k, v))
+	}
+	fw, err := mw.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = fw.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+	return &body, mw.FormDataContentType()
+}
+
+func TestHandleUploadDocument(t *testing.T) {
+	sum := sha256.Sum256(testPDF)
+
+	tests := []struct {
+		name       string
+		fields     map[string]string
+		contents   []byte
+		wantStatus int
+	}{
+		{
+			name:       "PDF Contract",
+			fields:     map[string]string{"category": "contract", "expiresOn": "2026-12-31"},
+			contents:   testPDF,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Executable Rejected",
+			fields:     map[string]string{"category": "id"},
+			contents:   []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff"),
+			wantStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "Unknown Category",
+			fields:     map[string]string{"category": "selfie"},
+			contents:   testPDF,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Too Large",
+			fields:     map[string]string{"category": "other"},
+			contents:   append(append([]byte{}, testPDF...), make([]byte, maxDocumentSize)...),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			server.blobs = blob.NewLocalStore(t.TempDir())
+			var stored *models.Document
+			if tt.wantStatus == http.StatusCreated {
+				mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7}, nil)
+				mockDB.On("CreateDocument", mock.AnythingOfType("*models.Document")).Return(nil).
+					Run(func(args mock.Arguments) { stored = args.Get(0).(*models.Document) })
+			}
+
+			body, contentType := multipartUpload(t, tt.fields, "../../contract.pdf", tt.contents)
+			req := httptest.NewRequest("POST", "/employee/documents?id=7", body)
+			req.Header.Set("Content-Type", contentType)
+			rr := httptest.NewRecorder()
+
+			server.handleDocuments(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var doc models.Document
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&doc))
+			assert.Equal(t, "contract.pdf", doc.FileName)
+			assert.Equal(t, "application/pdf", doc.ContentType)
+			assert.Equal(t, int64(len(testPDF)), doc.Size)
+			assert.Equal(t, hex.EncodeToString(sum[:]), doc.SHA256)
+			assert.Equal(t, models.NewDate(2026, time.December, 31), *doc.ExpiresOn)
+
+			contents, err := server.blobs.Get(stored.StorageKey)
+			require.NoError(t, err)
+			contents.Close()
+		})
+	}
+}
+
+func TestSignedDocumentLink(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	server.blobs = blob.NewLocalStore(t.TempDir())
+	require.NoError(t, server.blobs.Put("employees/7/abc", bytes.NewReader(testPDF)))
+	doc := &models.Document{ID: 5, EmployeeID: 7, FileName: "contract.pdf", ContentType: "application/pdf",
+		Size: int64(len(testPDF)), StorageKey: "employees/7/abc"}
+	mockDB.On("GetDocument", 5).Return(doc, nil)
+
+	req := httptest.NewRequest("POST", "/employee/documents/url?documentId=5&ttl=5m", nil)
+	rr := httptest.NewRecorder()
+	server.handleDocumentURL(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var link struct {
+		URL string `json:"url"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&link))
+
+	t.Run("Valid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		server.handleSignedDocument(rr, httptest.NewRequest("GET", link.URL, nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, testPDF, rr.Body.Bytes())
+		assert.Equal(t, "application/pdf", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Header().Get("Content-Disposition"), `filename=contract.pdf`)
+	})
+
+	t.Run("Other Document", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		tampered := bytes.Replace([]byte(link.URL), []byte("documentId=5"), []byte("documentId=6"), 1)
+		server.handleSignedDocument(rr, httptest.NewRequest("GET", string(tampered), nil))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		params := server.signer.Sign(documentResource(5), time.Now().Add(-time.Minute))
+		params.Set("documentId", strconv.Itoa(5))
+		rr := httptest.NewRecorder()
+		server.handleSignedDocument(rr, httptest.NewRequest("GET", "/documents/signed?"+params.Encode(), nil))
+
+		assert.Equal(t, http.StatusGone, rr.Code)
+	})
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"employees/internal/db"
+	"employees/internal/emergency"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// handleEmergencyContacts manages an employee's emergency contacts. They
+// hold personal data about people outside the company, so every method
+// needs the HR permission and logs carry IDs only.
+func (s *Server) handleEmergencyContacts(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionHR) {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		s.handleCreateEmergencyContact(w, r)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		contacts, err := s.db.ListEmergencyContacts(id)
+		if err != nil {
+			s.logger.Error("Failed to list emergency contacts", zap.Error(err))
+			http.Error(w, "Failed to list emergency contacts", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Emergency contacts read", zap.Int("employeeId", id), zap.Any("adminId", adminID(r)))
+		s.writeJSON(w, http.StatusOK, contacts)
+	case "PUT":
+		s.handleUpdateEmergencyContact(w, r)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "contactId")
+		if !ok {
+			return
+		}
+		if _, err := s.db.GetEmergencyContact(id); err != nil {
+			http.Error(w, "Emergency contact not found", http.StatusNotFound)
+			s.logger.Error("Emergency contact not found", zap.Error(err))
+			return
+		}
+		if err := s.db.DeleteEmergencyContact(id); err != nil {
+			s.logger.Error("Emergency contact deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete emergency contact", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Emergency contact deleted", zap.Int("contactId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleCreateEmergencyContact(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var contact models.EmergencyContact
+	if err := json.NewDecoder(r.Body).Decode(&contact); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	contact.ID = 0
+	contact.EmployeeID = id
+	if err := emergency.Validate(&contact); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid emergency contact", zap.Int("employeeId", id))
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	if err := s.db.CreateEmergencyContact(&contact); err != nil {
+		s.logger.Error("Emergency contact creation failed", zap.Error(err))
+		http.Error(w, "Failed to create emergency contact", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Emergency contact created", zap.Int("employeeId", id), zap.Int("contactId", contact.ID))
+	s.writeJSON(w, http.StatusCreated, contact)
+}
+
+func (s *Server) handleUpdateEmergencyContact(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "contactId")
+	if !ok {
+		return
+	}
+
+	existing, err := s.db.GetEmergencyContact(id)
+	if err != nil {
+		http.Error(w, "Emergency contact not found", http.StatusNotFound)
+		s.logger.Error("Emergency contact not found", zap.Error(err))
+		return
+	}
+
+	var contact models.EmergencyContact
+	if err := json.NewDecoder(r.Body).Decode(&contact); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	contact.ID = existing.ID
+	contact.EmployeeID = existing.EmployeeID
+	contact.CreatedAt = existing.CreatedAt
+	if contact.Priority == 0 {
+		contact.Priority = existing.Priority
+	}
+	if err := emergency.Validate(&contact); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid emergency contact", zap.Int("contactId", id))
+		return
+	}
+
+	if err := s.db.UpdateEmergencyContact(&contact); err != nil {
+		s.logger.Error("Emergency contact update failed", zap.Error(err))
+		http.Error(w, "Failed to update emergency contact", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Emergency contact updated", zap.Int("contactId", id))
+	s.writeJSON(w, http.StatusOK, contact)
+}
+
+// handleReorderEmergencyContacts takes a JSON array of all the employee's
+// contact IDs, first to call first.
+func (s *Server) handleReorderEmergencyContacts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionHR) {
+		return
+	}
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var ids []int
+	if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.ReorderEmergencyContacts(id, ids); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Order must list each of the employee's emergency contacts once", http.StatusConflict)
+			s.logger.Error("Emergency contact order mismatch", zap.Int("employeeId", id), zap.Ints("contactIds", ids))
+			return
+		}
+		s.logger.Error("Emergency contact reorder failed", zap.Error(err))
+		http.Error(w, "Failed to reorder emergency contacts", http.StatusInternalServerError)
+		return
+	}
+
+	contacts, err := s.db.ListEmergencyContacts(id)
+	if err != nil {
+		s.logger.Error("Failed to list emergency contacts", zap.Error(err))
+		http.Error(w, "Failed to list emergency contacts", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Emergency contacts reordered", zap.Int("employeeId", id))
+	s.writeJSON(w, http.StatusOK, contacts)
+}
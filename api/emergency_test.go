@@ -0,0 +1,163 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleEmergencyContacts(t *testing.T) {
+	hr := &models.Admin{ID: 1, Permissions: []string{models.PermissionHR}}
+	clerk := &models.Admin{ID: 2}
+
+	tests := []struct {
+		name       string
+		admin      *models.Admin
+		method     string
+		url        string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create",
+			admin:  hr,
+			method: "POST",
+			url:    "/employee/emergency-contacts?id=3",
+			body:   `{"name":"Ana Lima","relationship":"spouse","phoneNumbers":["+55 11 91234-5678"]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("CreateEmergencyContact", mock.MatchedBy(func(c *models.EmergencyContact) bool {
+					return c.EmployeeID == 3 && c.PhoneNumbers[0] == "+5511912345678"
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Create Invalid Phone",
+			admin:      hr,
+			method:     "POST",
+			url:        "/employee/emergency-contacts?id=3",
+			body:       `{"name":"Ana Lima","phoneNumbers":["011 91234-5678"]}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "List",
+			admin:  hr,
+			method: "GET",
+			url:    "/employee/emergency-contacts?id=3",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListEmergencyContacts", 3).Return([]models.EmergencyContact{{ID: 1, EmployeeID: 3}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "List Without Permission",
+			admin:      clerk,
+			method:     "GET",
+			url:        "/employee/emergency-contacts?id=3",
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Update Keeps Owner And Priority",
+			admin:  hr,
+			method: "PUT",
+			url:    "/employee/emergency-contacts?contactId=5",
+			body:   `{"employeeId":99,"name":"Ana","phoneNumbers":["+5511912345678"]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmergencyContact", 5).Return(&models.EmergencyContact{ID: 5, EmployeeID: 3, Priority: 2}, nil)
+				m.On("UpdateEmergencyContact", mock.MatchedBy(func(c *models.EmergencyContact) bool {
+					return c.ID == 5 && c.EmployeeID == 3 && c.Priority == 2
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Delete Missing",
+			admin:  hr,
+			method: "DELETE",
+			url:    "/employee/emergency-contacts?contactId=5",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmergencyContact", 5).Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", tt.admin.ID).Return(tt.admin, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, tt.url, bytes.NewBufferString(tt.body))
+			authorize(t, req, uint32(tt.admin.ID))
+			rr := httptest.NewRecorder()
+
+			server.handleEmergencyContacts(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestHandleReorderEmergencyContacts(t *testing.T) {
+	hr := &models.Admin{ID: 1, Permissions: []string{models.PermissionHR}}
+
+	t.Run("Success", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetAdminByID", 1).Return(hr, nil)
+		mockDB.On("ReorderEmergencyContacts", 3, []int{7, 5}).Return(nil)
+		mockDB.On("ListEmergencyContacts", 3).Return([]models.EmergencyContact{
+			{ID: 7, EmployeeID: 3, Priority: 1}, {ID: 5, EmployeeID: 3, Priority: 2},
+		}, nil)
+
+		req := httptest.NewRequest("PUT", "/employee/emergency-contacts/order?id=3", bytes.NewBufferString(`[7,5]`))
+		authorize(t, req, 1)
+		rr := httptest.NewRecorder()
+
+		server.handleReorderEmergencyContacts(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var contacts []models.EmergencyContact
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&contacts))
+		assert.Equal(t, 7, contacts[0].ID)
+	})
+
+	t.Run("Mismatched IDs", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetAdminByID", 1).Return(hr, nil)
+		mockDB.On("ReorderEmergencyContacts", 3, []int{7}).Return(db.ErrConflict)
+
+		req := httptest.NewRequest("PUT", "/employee/emergency-contacts/order?id=3", bytes.NewBufferString(`[7]`))
+		authorize(t, req, 1)
+		rr := httptest.NewRecorder()
+
+		server.handleReorderEmergencyContacts(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Wrong Method", func(t *testing.T) {
+		server, _ := setupTestServer(t)
+
+		req := httptest.NewRequest("POST", "/employee/emergency-contacts/order?id=3", bytes.NewBufferString(`[7,5]`))
+		authorize(t, req, 1)
+		rr := httptest.NewRecorder()
+
+		server.handleReorderEmergencyContacts(rr, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"employees/internal/customfield"
+	"employees/internal/lifecycle"
+	"employees/internal/models"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const maxImportSize = 10 << 20
+
+// employeeColumns are the fixed CSV columns, followed by one cf.<key>
+// column per custom field.
+var employeeColumns = []string{
+	"id", "firstName", "lastName", "email", "jobTitle", "departmentId",
+	"managerId", "locationId", "status", "contractType",
+}
+
+type importError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+func formatOptionalID(id *int) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.Itoa(*id)
+}
+
+func parseOptionalID(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, ok := validateId(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid ID %q", value)
+	}
+	return &id, nil
+}
+
+// handleExportEmployees writes the employees matching the list filters as
+// CSV, custom fields included.
+func (s *Server) handleExportEmployees(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	filter, ok := s.employeeFilter(w, r)
+	if !ok {
+		return
+	}
+
+	defs, err := s.db.ListCustomFields()
+	if err != nil {
+		s.logger.Error("Failed to list custom fields", zap.Error(err))
+		http.Error(w, "Failed to export employees", http.StatusInternalServerError)
+		return
+	}
+	emps, err := s.db.ListEmployees(filter)
+	if err != nil {
+		s.logger.Error("Failed to list employees", zap.Error(err))
+		http.Error(w, "Failed to export employees", http.StatusInternalServerError)
+		return
+	}
+
+	header := append([]string{}, employeeColumns...)
+	for _, def := range defs {
+		header = append(header, customFieldParam+def.Key)
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="employees.csv"`)
+	out := csv.NewWriter(w)
+	out.Write(header)
+	for _, emp := range emps {
+		row := []string{
+			strconv.Itoa(emp.ID), emp.FirstName, emp.LastName, emp.Email, emp.JobTitle,
+			formatOptionalID(emp.DepartmentID), formatOptionalID(emp.ManagerID), formatOptionalID(emp.LocationID),
+			string(emp.Status), emp.ContractType,
+		}
+		for _, def := range defs {
+			row = append(row, customfield.Format(emp.CustomFields[def.Key]))
+		}
+		out.Write(row)
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		s.logger.Error("Failed to write employee export", zap.Error(err))
+		return
+	}
+	s.logger.Info("Employees exported", zap.Int("count", len(emps)))
+}
+
+// handleImportEmployees creates an employee for each row of a CSV body in
+// the export format; the id column, if present, is ignored. Every row is
+// validated first, including its email against existing employees, and
+// the employees are created together, so nothing is created unless all of
+// them pass.
+func (s *Server) handleImportEmployees(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	in := csv.NewReader(http.MaxBytesReader(w, r.Body, maxImportSize))
+	header, err := in.Read()
+	if err != nil {
+		http.Error(w, "Invalid CSV", http.StatusBadRequest)
+		s.logger.Error("Invalid employee import", zap.Error(err))
+		return
+	}
+
+	defs, err := s.db.ListCustomFields()
+	if err != nil {
+		s.logger.Error("Failed to list custom fields", zap.Error(err))
+		http.Error(w, "Failed to import employees", http.StatusInternalServerError)
+		return
+	}
+	known := make(map[string]bool, len(employeeColumns)+len(defs))
+	for _, name := range employeeColumns {
+		known[name] = true
+	}
+	for _, def := range defs {
+		known[customFieldParam+def.Key] = true
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			http.Error(w, "Unknown column "+name, http.StatusBadRequest)
+			s.logger.Error("Unknown import column", zap.String("column", name))
+			return
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"firstName", "lastName", "email"} {
+		if _, ok := columns[required]; !ok {
+			http.Error(w, required+" column is required", http.StatusBadRequest)
+			s.logger.Error("Missing import column", zap.String("column", required))
+			return
+		}
+	}
+
+	var emps []models.Employee
+	var problems []importError
+	emails := map[string]int{}
+	for {
+		record, err := in.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			http.Error(w, "Invalid CSV: "+err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid employee import", zap.Error(err))
+			return
+		}
+		line, _ := in.FieldPos(0)
+		emp, err := s.importEmployee(record, columns, defs)
+		if err != nil {
+			problems = append(problems, importError{Line: line, Error: err.Error()})
+			continue
+		}
+		if first, ok := emails[strings.ToLower(emp.Email)]; ok {
+			problems = append(problems, importError{Line: line, Error: fmt.Sprintf("email repeats line %d", first)})
+			continue
+		}
+		emails[strings.ToLower(emp.Email)] = line
+		emps = append(emps, emp)
+	}
+
+	addresses := make([]string, len(emps))
+	for i, emp := range emps {
+		addresses[i] = emp.Email
+	}
+	taken, err := s.db.FindEmployeeEmails(addresses)
+	if err != nil {
+		s.logger.Error("Failed to check employee emails", zap.Error(err))
+		http.Error(w, "Failed to import employees", http.StatusInternalServerError)
+		return
+	}
+	for _, email := range taken {
+		problems = append(problems, importError{Line: emails[email], Error: "email is already used by an employee"})
+	}
+	if len(problems) > 0 {
+		sort.Slice(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+		s.logger.Error("Employee import rejected", zap.Int("invalidRows", len(problems)))
+		s.writeJSON(w, http.StatusBadRequest, map[string]any{"errors": problems})
+		return
+	}
+
+	if err := s.db.CreateEmployees(emps); err != nil {
+		s.logger.Error("Employee import failed", zap.Error(err))
+		http.Error(w, "Failed to import employees", http.StatusInternalServerError)
+		return
+	}
+	created := make([]int, len(emps))
+	for i, emp := range emps {
+		created[i] = emp.ID
+	}
+
+	s.logger.Info("Employees imported", zap.Int("count", len(created)))
+	s.writeJSON(w, http.StatusCreated, map[string]any{"created": created})
+}
+
+// importEmployee builds and validates the employee described by record.
+func (s *Server) importEmployee(record []string, columns map[string]int, defs []models.CustomFieldDefinition) (models.Employee, error) {
+	get := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	emp := models.Employee{
+		FirstName:    get("firstName"),
+		LastName:     get("lastName"),
+		Email:        get("email"),
+		JobTitle:     get("jobTitle"),
+		Status:       models.EmployeeStatus(get("status")),
+		ContractType: get("contractType"),
+	}
+	if emp.FirstName == "" || emp.LastName == "" || emp.Email == "" {
+		return emp, errors.New("firstName, lastName and email are required")
+	}
+	var err error
+	if emp.DepartmentID, err = parseOptionalID(get("departmentId")); err != nil {
+		return emp, err
+	}
+	if emp.ManagerID, err = parseOptionalID(get("managerId")); err != nil {
+		return emp, err
+	}
+	if emp.LocationID, err = parseOptionalID(get("locationId")); err != nil {
+		return emp, err
+	}
+	if err := s.validateReferences(&emp); err != nil {
+		return emp, err
+	}
+	if emp.Status == "" {
+		emp.Status = models.EmployeeStatusActive
+	}
+	if !lifecycle.ValidInitial(emp.Status) {
+		return emp, errors.New("new employees must start as candidate, onboarding or active")
+	}
+
+	values := map[string]any{}
+	for _, def := range defs {
+		text := get(customFieldParam + def.Key)
+		if text == "" {
+			continue
+		}
+		value, err := customfield.Parse(def, text)
+		if err != nil {
+			return emp, err
+		}
+		values[def.Key] = value
+	}
+	if emp.CustomFields, err = customfield.Validate(defs, values); err != nil {
+		return emp, err
+	}
+	return emp, nil
+}
@@ -0,0 +1,416 @@
+package api
+
+import (
+	"employees/internal/compensation"
+	"employees/internal/db"
+	"employees/internal/expense"
+	"employees/internal/models"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) handleExpenseCategories(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		categories, err := s.db.ListExpenseCategories()
+		if err != nil {
+			s.logger.Error("Failed to list expense categories", zap.Error(err))
+			http.Error(w, "Failed to list expense categories", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, categories)
+	case "POST", "PUT":
+		if !s.requirePermission(w, r, models.PermissionFinance) {
+			return
+		}
+		var c models.ExpenseCategory
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := expense.ValidateCategory(&c); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid expense category", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			c.ID = 0
+			if err := s.db.CreateExpenseCategory(&c); err != nil {
+				s.logger.Error("Expense category creation failed", zap.Error(err))
+				http.Error(w, "Failed to create expense category", http.StatusBadRequest)
+				return
+			}
+			s.logger.Info("Expense category created", zap.Any("category", c))
+			s.writeJSON(w, http.StatusCreated, c)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		c.ID = id
+		if err := s.db.UpdateExpenseCategory(&c); err != nil {
+			http.Error(w, "Expense category not found", http.StatusNotFound)
+			s.logger.Error("Expense category update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Expense category updated", zap.Any("category", c))
+		s.writeJSON(w, http.StatusOK, c)
+	}
+}
+
+// expenseCategories returns the expense categories by ID.
+func (s *Server) expenseCategories() (map[int]models.ExpenseCategory, error) {
+	categories, err := s.db.ListExpenseCategories()
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]models.ExpenseCategory, len(categories))
+	for _, c := range categories {
+		byID[c.ID] = c
+	}
+	return byID, nil
+}
+
+// handleExpenseClaims manages expense claims. GET lists them by
+// ?employeeId= and ?status=. Claims are saved as drafts and can be changed
+// until submitted, or again once rejected.
+func (s *Server) handleExpenseClaims(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		if r.URL.Query().Get("id") != "" {
+			claim, ok := s.expenseClaim(w, r)
+			if !ok {
+				return
+			}
+			s.writeJSON(w, http.StatusOK, claim)
+			return
+		}
+		filter := models.ExpenseClaimFilter{Status: models.ExpenseClaimStatus(r.URL.Query().Get("status"))}
+		if r.URL.Query().Get("employeeId") != "" {
+			id, ok := s.queryID(w, r, "employeeId")
+			if !ok {
+				return
+			}
+			filter.EmployeeID = &id
+		}
+		claims, err := s.db.ListExpenseClaims(filter)
+		if err != nil {
+			s.logger.Error("Failed to list expense claims", zap.Error(err))
+			http.Error(w, "Failed to list expense claims", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, claims)
+	case "POST":
+		var claim models.ExpenseClaim
+		if err := json.NewDecoder(r.Body).Decode(&claim); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		claim = models.ExpenseClaim{EmployeeID: claim.EmployeeID, Title: claim.Title, Currency: claim.Currency,
+			Items: claim.Items, Status: models.ExpenseClaimStatusDraft}
+		if !s.validExpenseClaim(w, &claim) {
+			return
+		}
+		if err := s.db.CreateExpenseClaim(&claim); err != nil {
+			s.logger.Error("Expense claim creation failed", zap.Error(err))
+			http.Error(w, "Failed to create expense claim", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Expense claim created", zap.Int("claimId", claim.ID), zap.Int("employeeId", claim.EmployeeID))
+		s.writeJSON(w, http.StatusCreated, claim)
+	case "PUT":
+		existing, ok := s.expenseClaim(w, r)
+		if !ok {
+			return
+		}
+		var claim models.ExpenseClaim
+		if err := json.NewDecoder(r.Body).Decode(&claim); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		claim = models.ExpenseClaim{ID: existing.ID, EmployeeID: existing.EmployeeID, Title: claim.Title,
+			Currency: claim.Currency, Items: claim.Items, CreatedAt: existing.CreatedAt}
+		if !s.validExpenseClaim(w, &claim) {
+			return
+		}
+		if err := s.db.UpdateExpenseClaim(&claim); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Only draft or rejected claims can be changed", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to update expense claim", http.StatusBadRequest)
+			}
+			s.logger.Error("Expense claim update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Expense claim updated", zap.Int("claimId", claim.ID))
+		s.writeJSON(w, http.StatusOK, claim)
+	case "DELETE":
+		claim, ok := s.expenseClaim(w, r)
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteExpenseClaim(claim.ID); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Only draft claims can be deleted", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to delete expense claim", http.StatusInternalServerError)
+			}
+			s.logger.Error("Expense claim deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Expense claim deleted", zap.Int("claimId", claim.ID))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// expenseClaim loads the claim named by ?id=, writing a 404 when there is
+// none.
+func (s *Server) expenseClaim(w http.ResponseWriter, r *http.Request) (*models.ExpenseClaim, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	claim, err := s.db.GetExpenseClaim(id)
+	if err != nil {
+		http.Error(w, "Expense claim not found", http.StatusNotFound)
+		s.logger.Error("Expense claim not found", zap.Error(err))
+		return nil, false
+	}
+	return claim, true
+}
+
+// validExpenseClaim prepares the claim and checks that its employee,
+// categories and receipts exist, writing a 400 response and returning
+// false when not. Items over their limit are marked, but policy is only
+// enforced on submission.
+func (s *Server) validExpenseClaim(w http.ResponseWriter, claim *models.ExpenseClaim) bool {
+	if err := expense.Prepare(claim); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid expense claim", zap.Error(err))
+		return false
+	}
+	if _, err := s.db.GetEmployee(strconv.Itoa(claim.EmployeeID)); err != nil {
+		http.Error(w, "Employee not found", http.StatusBadRequest)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return false
+	}
+	categories, err := s.expenseCategories()
+	if err != nil {
+		s.logger.Error("Failed to list expense categories", zap.Error(err))
+		http.Error(w, "Failed to save expense claim", http.StatusInternalServerError)
+		return false
+	}
+	for i, item := range claim.Items {
+		if _, ok := categories[item.CategoryID]; !ok {
+			http.Error(w, "item "+strconv.Itoa(i)+": expense category not found", http.StatusBadRequest)
+			s.logger.Error("Expense category not found", zap.Int("categoryId", item.CategoryID))
+			return false
+		}
+		if item.ReceiptDocumentID != nil {
+			doc, err := s.db.GetDocument(*item.ReceiptDocumentID)
+			if err != nil || doc.EmployeeID != claim.EmployeeID {
+				http.Error(w, "item "+strconv.Itoa(i)+": receiptDocumentId must be one of the employee's documents", http.StatusBadRequest)
+				s.logger.Error("Invalid receipt", zap.Intp("documentId", item.ReceiptDocumentID))
+				return false
+			}
+		}
+	}
+	expense.Check(claim, categories)
+	return true
+}
+
+// handleExpenseDecision moves claim ?id= along its approval chain. Drafts
+// are submitted once they meet policy; violations are returned with a 409.
+// A manager's approval or rejection names them by approverId, who must be
+// above the employee in the reporting line; the caller who records it is
+// kept as managerAdminId. The final approval, and any rejection without an
+// approverId, needs PermissionFinance.
+func (s *Server) handleExpenseDecision(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	claim, ok := s.expenseClaim(w, r)
+	if !ok {
+		return
+	}
+	var decision struct {
+		Status     models.ExpenseClaimStatus `json:"status"`
+		Note       string                    `json:"note"`
+		ApproverID *int                      `json:"approverId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&decision); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	from := claim.Status
+	if err := expense.Decide(claim, decision.Status); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid expense decision", zap.Error(err))
+		return
+	}
+
+	managerStep := decision.Status == models.ExpenseClaimStatusManagerApproved ||
+		(decision.Status == models.ExpenseClaimStatusRejected && from == models.ExpenseClaimStatusSubmitted && decision.ApproverID != nil)
+	now := time.Now()
+	switch {
+	case decision.Status == models.ExpenseClaimStatusSubmitted:
+		categories, err := s.expenseCategories()
+		if err != nil {
+			s.logger.Error("Failed to list expense categories", zap.Error(err))
+			http.Error(w, "Failed to submit expense claim", http.StatusInternalServerError)
+			return
+		}
+		if violations := expense.Check(claim, categories); len(violations) > 0 {
+			s.logger.Error("Expense claim violates policy", zap.Int("claimId", claim.ID), zap.Any("violations", violations))
+			s.writeJSON(w, http.StatusConflict, map[string]any{
+				"error":      "Expense claim violates policy",
+				"violations": violations,
+			})
+			return
+		}
+		claim.SubmittedAt = &now
+		claim.ManagerApproverID, claim.ManagerApprovedAt, claim.ManagerAdminID = nil, nil, nil
+		claim.FinanceAdminID, claim.ApprovedAt, claim.RejectedAt = nil, nil, nil
+		claim.DecisionNote = ""
+	case managerStep:
+		if decision.ApproverID == nil {
+			http.Error(w, "approverId is required", http.StatusBadRequest)
+			s.logger.Error("approverId is required")
+			return
+		}
+		chain, err := s.db.GetReportingChain(claim.EmployeeID)
+		if err != nil {
+			s.logger.Error("Failed to get reporting chain", zap.Error(err))
+			http.Error(w, "Failed to decide expense claim", http.StatusInternalServerError)
+			return
+		}
+		if !containsEmployee(chain, *decision.ApproverID) {
+			http.Error(w, "Approver is not a manager of the employee", http.StatusForbidden)
+			s.logger.Error("Approver is not a manager of the employee", zap.Int("approverId", *decision.ApproverID))
+			return
+		}
+		claim.ManagerApproverID = decision.ApproverID
+		claim.ManagerAdminID = adminID(r)
+		if decision.Status == models.ExpenseClaimStatusManagerApproved {
+			claim.ManagerApprovedAt = &now
+		}
+	default:
+		if !s.requirePermission(w, r, models.PermissionFinance) {
+			return
+		}
+		claim.FinanceAdminID = adminID(r)
+		if decision.Status == models.ExpenseClaimStatusApproved {
+			claim.ApprovedAt = &now
+		}
+	}
+	if decision.Status == models.ExpenseClaimStatusRejected {
+		claim.RejectedAt = &now
+	}
+	if decision.Status != models.ExpenseClaimStatusSubmitted {
+		claim.DecisionNote = strings.TrimSpace(decision.Note)
+	}
+
+	if err := s.db.DecideExpenseClaim(claim, from); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Expense claim changed concurrently", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to decide expense claim", http.StatusInternalServerError)
+		}
+		s.logger.Error("Expense decision failed", zap.Error(err))
+		return
+	}
+	s.logger.Info("Expense claim decided", zap.Int("claimId", claim.ID), zap.String("from", string(from)),
+		zap.String("to", string(claim.Status)))
+	s.writeJSON(w, http.StatusOK, claim)
+}
+
+var expenseExportColumns = []string{"claimId", "itemId", "employeeId", "employeeName", "approvedOn", "date",
+	"accountCode", "category", "description", "amount", "currency", "exchangeRate", "claimAmount",
+	"claimCurrency", "receiptDocumentId"}
+
+// handleExportExpenses writes one CSV row per item of the claims given
+// final approval from ?from= to ?to=, for import into accounting. Amounts
+// are decimals in their currency.
+func (s *Server) handleExportExpenses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionFinance) {
+		return
+	}
+	from, to, ok := s.dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	claims, err := s.db.ListExpenseClaims(models.ExpenseClaimFilter{Status: models.ExpenseClaimStatusApproved,
+		ApprovedFrom: &from, ApprovedTo: &to})
+	if err != nil {
+		s.logger.Error("Failed to list expense claims", zap.Error(err))
+		http.Error(w, "Failed to export expenses", http.StatusInternalServerError)
+		return
+	}
+	categories, err := s.expenseCategories()
+	if err != nil {
+		s.logger.Error("Failed to list expense categories", zap.Error(err))
+		http.Error(w, "Failed to export expenses", http.StatusInternalServerError)
+		return
+	}
+
+	names := make(map[int]string)
+	var rows [][]string
+	for _, claim := range claims {
+		name, ok := names[claim.EmployeeID]
+		if !ok {
+			emp, err := s.db.GetEmployee(strconv.Itoa(claim.EmployeeID))
+			if err != nil {
+				s.logger.Error("Employee not found", zap.Int("employeeId", claim.EmployeeID), zap.Error(err))
+				http.Error(w, "Failed to export expenses", http.StatusInternalServerError)
+				return
+			}
+			name = emp.FirstName + " " + emp.LastName
+			names[claim.EmployeeID] = name
+		}
+		approvedOn := ""
+		if claim.ApprovedAt != nil {
+			approvedOn = models.DateOf(*claim.ApprovedAt).String()
+		}
+		for _, item := range claim.Items {
+			cat := categories[item.CategoryID]
+			rows = append(rows, []string{
+				strconv.Itoa(claim.ID), strconv.Itoa(item.ID), strconv.Itoa(claim.EmployeeID), name, approvedOn,
+				item.Date.String(), cat.AccountCode, cat.Name, item.Description,
+				compensation.FormatAmount(item.Amount, item.Currency), item.Currency,
+				strconv.FormatFloat(item.ExchangeRate, 'f', -1, 64),
+				compensation.FormatAmount(item.ClaimAmount, claim.Currency), claim.Currency,
+				formatOptionalID(item.ReceiptDocumentID),
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="expenses.csv"`)
+	out := csv.NewWriter(w)
+	out.Write(expenseExportColumns)
+	out.WriteAll(rows)
+	if err := out.Error(); err != nil {
+		s.logger.Error("Failed to write expense export", zap.Error(err))
+	}
+}
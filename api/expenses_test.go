@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var accountant = &models.Admin{ID: 1, Permissions: []string{models.PermissionFinance}}
+
+var expenseCategories = []models.ExpenseCategory{
+	{ID: 1, Name: "Meals", AccountCode: "6410", Limits: map[string]int64{"EUR": 5000}},
+	{ID: 2, Name: "Travel", AccountCode: "6420", ReceiptRequired: true},
+}
+
+func TestHandleExpenseClaims(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		query      string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create",
+			method: "POST",
+			body: `{"employeeId":3,"title":"Berlin","currency":"EUR","status":"approved","items":[` +
+				`{"categoryId":1,"date":"2025-05-06","description":"Dinner","amount":8000,"currency":"EUR"},` +
+				`{"categoryId":2,"date":"2025-05-06","description":"Taxi","amount":3000,"currency":"USD","exchangeRate":0.9,"receiptDocumentId":7}]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListExpenseCategories").Return(expenseCategories, nil)
+				m.On("GetDocument", 7).Return(&models.Document{ID: 7, EmployeeID: 3}, nil)
+				m.On("CreateExpenseClaim", mock.MatchedBy(func(c *models.ExpenseClaim) bool {
+					return c.Status == models.ExpenseClaimStatusDraft && c.Total == 10700 &&
+						c.Items[0].OverLimit && !c.Items[1].OverLimit
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "Receipt Of Another Employee",
+			method: "POST",
+			body: `{"employeeId":3,"title":"Berlin","currency":"EUR","items":[` +
+				`{"categoryId":2,"date":"2025-05-06","description":"Taxi","amount":3000,"currency":"EUR","receiptDocumentId":7}]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListExpenseCategories").Return(expenseCategories, nil)
+				m.On("GetDocument", 7).Return(&models.Document{ID: 7, EmployeeID: 4}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Missing Exchange Rate",
+			method: "POST",
+			body: `{"employeeId":3,"title":"Berlin","currency":"EUR","items":[` +
+				`{"categoryId":1,"date":"2025-05-06","description":"Lunch","amount":3000,"currency":"USD"}]}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Unknown Category",
+			method: "POST",
+			body: `{"employeeId":3,"title":"Berlin","currency":"EUR","items":[` +
+				`{"categoryId":9,"date":"2025-05-06","description":"Lunch","amount":3000,"currency":"EUR"}]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("ListExpenseCategories").Return(expenseCategories, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Delete Submitted",
+			method: "DELETE",
+			query:  "?id=5",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(&models.ExpenseClaim{ID: 5, Status: models.ExpenseClaimStatusSubmitted}, nil)
+				m.On("DeleteExpenseClaim", 5).Return(db.ErrConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, "/expenses"+tt.query, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleExpenseClaims(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleExpenseDecision(t *testing.T) {
+	claim := func(status models.ExpenseClaimStatus) *models.ExpenseClaim {
+		return &models.ExpenseClaim{ID: 5, EmployeeID: 3, Title: "Berlin", Currency: "EUR", Total: 8000, Status: status,
+			Items: []models.ExpenseItem{{ID: 11, CategoryID: 1, Amount: 8000, Currency: "EUR", ClaimAmount: 8000}}}
+	}
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Submit Over Limit",
+			body: `{"status":"submitted"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusDraft), nil)
+				m.On("ListExpenseCategories").Return(expenseCategories, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Manager Approves",
+			body: `{"status":"manager_approved","approverId":2}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusSubmitted), nil)
+				m.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}}, nil)
+				m.On("DecideExpenseClaim", mock.MatchedBy(func(c *models.ExpenseClaim) bool {
+					return c.Status == models.ExpenseClaimStatusManagerApproved && *c.ManagerApproverID == 2 &&
+						c.ManagerApprovedAt != nil && *c.ManagerAdminID == 1
+				}), models.ExpenseClaimStatusSubmitted).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Approver Outside Reporting Line",
+			body: `{"status":"manager_approved","approverId":4}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusSubmitted), nil)
+				m.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}}, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "Manager Rejects",
+			body: `{"status":"rejected","approverId":2,"note":"Not a work trip"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusSubmitted), nil)
+				m.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}}, nil)
+				m.On("DecideExpenseClaim", mock.MatchedBy(func(c *models.ExpenseClaim) bool {
+					return c.Status == models.ExpenseClaimStatusRejected && *c.ManagerApproverID == 2 &&
+						*c.ManagerAdminID == 1 && c.FinanceAdminID == nil && c.RejectedAt != nil
+				}), models.ExpenseClaimStatusSubmitted).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Finance Before Manager",
+			body: `{"status":"approved"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusSubmitted), nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Finance Approves",
+			body: `{"status":"approved","note":" Paid with May payroll "}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusManagerApproved), nil)
+				m.On("GetAdminByID", 1).Return(accountant, nil)
+				m.On("DecideExpenseClaim", mock.MatchedBy(func(c *models.ExpenseClaim) bool {
+					return c.Status == models.ExpenseClaimStatusApproved && *c.FinanceAdminID == 1 &&
+						c.ApprovedAt != nil && c.DecisionNote == "Paid with May payroll"
+				}), models.ExpenseClaimStatusManagerApproved).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Approve Without Finance Permission",
+			body: `{"status":"approved"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetExpenseClaim", 5).Return(claim(models.ExpenseClaimStatusManagerApproved), nil)
+				m.On("GetAdminByID", 1).Return(&models.Admin{ID: 1}, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/expenses/decision?id=5", bytes.NewBufferString(tt.body))
+			authorize(t, req, 1)
+			rr := httptest.NewRecorder()
+
+			server.handleExpenseDecision(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleExportExpenses(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(accountant, nil)
+	from := models.NewDate(2025, time.May, 1)
+	to := models.NewDate(2025, time.May, 31)
+	approved := time.Date(2025, time.May, 20, 10, 0, 0, 0, time.UTC)
+	mockDB.On("ListExpenseClaims", models.ExpenseClaimFilter{Status: models.ExpenseClaimStatusApproved,
+		ApprovedFrom: &from, ApprovedTo: &to}).Return([]models.ExpenseClaim{{
+		ID: 5, EmployeeID: 3, Currency: "EUR", Status: models.ExpenseClaimStatusApproved, ApprovedAt: &approved,
+		Items: []models.ExpenseItem{
+			{ID: 11, CategoryID: 1, Date: models.NewDate(2025, time.May, 6), Description: "Dinner", Amount: 4000,
+				Currency: "EUR", ExchangeRate: 1, ClaimAmount: 4000},
+			{ID: 12, CategoryID: 2, Date: models.NewDate(2025, time.May, 7), Description: "Taxi", Amount: 3000,
+				Currency: "USD", ExchangeRate: 0.9, ClaimAmount: 2700, ReceiptDocumentID: intPtr(7)},
+		},
+	}}, nil)
+	mockDB.On("ListExpenseCategories").Return(expenseCategories, nil)
+	mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, FirstName: "Ada", LastName: "Lovelace"}, nil)
+
+	req := httptest.NewRequest("GET", "/expenses/export?from=2025-05-01&to=2025-05-31", nil)
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+
+	server.handleExportExpenses(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, expenseExportColumns, records[0])
+	assert.Equal(t, []string{"5", "12", "3", "Ada Lovelace", "2025-05-20", "2025-05-07", "6420", "Travel", "Taxi",
+		"30.00", "USD", "0.9", "27.00", "EUR", "7"}, records[2])
+}
+
+func TestHandleExpenseCategoriesRequiresFinance(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 2).Return(&models.Admin{ID: 2}, nil)
+
+	body, _ := json.Marshal(models.ExpenseCategory{Name: "Meals"})
+	req := httptest.NewRequest("POST", "/expense-categories", bytes.NewBuffer(body))
+	authorize(t, req, 2)
+	rr := httptest.NewRecorder()
+
+	server.handleExpenseCategories(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -0,0 +1,347 @@
+package api
+
+import (
+	"employees/internal/db"
+	"employees/internal/headcount"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// handlePlannedPositions manages the headcount plan. GET lists positions
+// by ?departmentId=, ?employeeId= and ?status=. Positions carry budgets, so
+// the plan is limited to finance admins.
+func (s *Server) handlePlannedPositions(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionFinance) {
+		return
+	}
+	switch r.Method {
+	case "GET":
+		if r.URL.Query().Get("id") != "" {
+			pos, ok := s.plannedPosition(w, r)
+			if !ok {
+				return
+			}
+			s.writeJSON(w, http.StatusOK, pos)
+			return
+		}
+		filter := models.PlannedPositionFilter{Status: models.PlannedPositionStatus(r.URL.Query().Get("status"))}
+		for name, field := range map[string]**int{
+			"departmentId": &filter.DepartmentID,
+			"employeeId":   &filter.EmployeeID,
+		} {
+			if r.URL.Query().Get(name) == "" {
+				continue
+			}
+			id, ok := s.queryID(w, r, name)
+			if !ok {
+				return
+			}
+			*field = &id
+		}
+		positions, err := s.db.ListPlannedPositions(filter)
+		if err != nil {
+			s.logger.Error("Failed to list planned positions", zap.Error(err))
+			http.Error(w, "Failed to list planned positions", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, positions)
+	case "POST":
+		var pos models.PlannedPosition
+		if err := json.NewDecoder(r.Body).Decode(&pos); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		pos.ID = 0
+		pos.EmployeeID = nil
+		if !s.validPlannedPosition(w, &pos) {
+			return
+		}
+		if err := s.db.CreatePlannedPosition(&pos); err != nil {
+			s.logger.Error("Planned position creation failed", zap.Error(err))
+			http.Error(w, "Failed to create planned position", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Planned position created", zap.Any("position", pos))
+		s.writeJSON(w, http.StatusCreated, pos)
+	case "PUT":
+		existing, ok := s.plannedPosition(w, r)
+		if !ok {
+			return
+		}
+		var pos models.PlannedPosition
+		if err := json.NewDecoder(r.Body).Decode(&pos); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		pos.ID = existing.ID
+		pos.EmployeeID = existing.EmployeeID
+		pos.CreatedAt = existing.CreatedAt
+
+		// A filled position keeps its holder; it has to be vacated
+		// before it can be frozen.
+		filled := existing.Status == models.PlannedPositionStatusFilled
+		if filled {
+			if pos.Status != "" && pos.Status != models.PlannedPositionStatusFilled {
+				http.Error(w, "Vacate the position before changing its status", http.StatusConflict)
+				s.logger.Error("Status change of filled position", zap.Int("positionId", pos.ID))
+				return
+			}
+			pos.Status = ""
+		}
+		if !s.validPlannedPosition(w, &pos) {
+			return
+		}
+		if filled {
+			pos.Status = models.PlannedPositionStatusFilled
+		}
+
+		if err := s.db.UpdatePlannedPosition(&pos); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Position was filled or vacated concurrently", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to update planned position", http.StatusBadRequest)
+			}
+			s.logger.Error("Planned position update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Planned position updated", zap.Any("position", pos))
+		s.writeJSON(w, http.StatusOK, pos)
+	case "DELETE":
+		pos, ok := s.plannedPosition(w, r)
+		if !ok {
+			return
+		}
+		if err := s.db.DeletePlannedPosition(pos.ID); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Vacate the position before deleting it", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to delete planned position", http.StatusInternalServerError)
+			}
+			s.logger.Error("Planned position deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Planned position deleted", zap.Int("positionId", pos.ID))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// plannedPosition loads the planned position named by ?id=, writing a 404
+// when there is none.
+func (s *Server) plannedPosition(w http.ResponseWriter, r *http.Request) (*models.PlannedPosition, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	pos, err := s.db.GetPlannedPosition(id)
+	if err != nil {
+		http.Error(w, "Planned position not found", http.StatusNotFound)
+		s.logger.Error("Planned position not found", zap.Error(err))
+		return nil, false
+	}
+	return pos, true
+}
+
+// validPlannedPosition validates pos and checks that its department
+// exists, writing a 400 response and returning false when not.
+func (s *Server) validPlannedPosition(w http.ResponseWriter, pos *models.PlannedPosition) bool {
+	if err := headcount.Validate(pos); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid planned position", zap.Error(err))
+		return false
+	}
+	if _, err := s.db.GetDepartment(pos.DepartmentID); err != nil {
+		http.Error(w, errDepartmentNotFound.Error(), http.StatusBadRequest)
+		s.logger.Error("Department not found", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// handleFillPlannedPosition puts the employee in the body into open
+// position ?id=. An employee holds at most one position.
+func (s *Server) handleFillPlannedPosition(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionFinance) {
+		return
+	}
+	pos, ok := s.plannedPosition(w, r)
+	if !ok {
+		return
+	}
+	var body struct {
+		EmployeeID int `json:"employeeId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	emp, err := s.db.GetEmployee(strconv.Itoa(body.EmployeeID))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if emp.Status == models.EmployeeStatusTerminated {
+		http.Error(w, "Terminated employees cannot fill positions", http.StatusConflict)
+		s.logger.Error("Fill with terminated employee", zap.Int("employeeId", emp.ID))
+		return
+	}
+	held, err := s.db.ListPlannedPositions(models.PlannedPositionFilter{EmployeeID: &emp.ID})
+	if err != nil {
+		s.logger.Error("Failed to list planned positions", zap.Error(err))
+		http.Error(w, "Failed to fill planned position", http.StatusInternalServerError)
+		return
+	}
+	if len(held) > 0 {
+		http.Error(w, "Employee already holds position "+strconv.Itoa(held[0].ID), http.StatusConflict)
+		s.logger.Error("Employee already holds a position", zap.Int("employeeId", emp.ID), zap.Int("positionId", held[0].ID))
+		return
+	}
+
+	if err := s.db.FillPlannedPosition(pos.ID, emp.ID); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Position is not open", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to fill planned position", http.StatusInternalServerError)
+		}
+		s.logger.Error("Planned position fill failed", zap.Error(err))
+		return
+	}
+	pos.Status = models.PlannedPositionStatusFilled
+	pos.EmployeeID = &emp.ID
+	s.logger.Info("Planned position filled", zap.Int("positionId", pos.ID), zap.Int("employeeId", emp.ID))
+	s.writeJSON(w, http.StatusOK, pos)
+}
+
+// handleVacatePlannedPosition reopens filled position ?id=.
+func (s *Server) handleVacatePlannedPosition(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionFinance) {
+		return
+	}
+	pos, ok := s.plannedPosition(w, r)
+	if !ok {
+		return
+	}
+	if err := s.db.VacatePlannedPosition(pos.ID); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Position is not filled", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to vacate planned position", http.StatusInternalServerError)
+		}
+		s.logger.Error("Planned position vacate failed", zap.Error(err))
+		return
+	}
+	pos.Status = models.PlannedPositionStatusOpen
+	pos.EmployeeID = nil
+	s.logger.Info("Planned position vacated", zap.Int("positionId", pos.ID))
+	s.writeJSON(w, http.StatusOK, pos)
+}
+
+// vacatePlannedPositions is a termination hook that reopens the leaver's
+// position in the headcount plan.
+func (s *Server) vacatePlannedPositions(emp models.Employee, tr models.StatusTransition) error {
+	held, err := s.db.ListPlannedPositions(models.PlannedPositionFilter{EmployeeID: &emp.ID})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, pos := range held {
+		if err := s.db.VacatePlannedPosition(pos.ID); err != nil && !errors.Is(err, db.ErrConflict) {
+			errs = append(errs, err)
+			continue
+		}
+		s.logger.Info("Planned position vacated", zap.Int("positionId", pos.ID), zap.Int("employeeId", emp.ID))
+	}
+	return errors.Join(errs...)
+}
+
+// handleHeadcountReport compares planned with actual headcount per
+// department from ?from= to ?to=, sampled every ?interval= week or month
+// (the default), optionally for one ?departmentId=. Actual headcount is
+// rebuilt from the employees' position history and status transitions;
+// employees without a position in the range count in their current
+// department.
+func (s *Server) handleHeadcountReport(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionFinance) {
+		return
+	}
+	from, to, ok := s.dateRange(w, r)
+	if !ok {
+		return
+	}
+	dates, err := headcount.Dates(from, to, r.URL.Query().Get("interval"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid headcount report", zap.Error(err))
+		return
+	}
+	var departmentID *int
+	if r.URL.Query().Get("departmentId") != "" {
+		id, ok := s.queryID(w, r, "departmentId")
+		if !ok {
+			return
+		}
+		departmentID = &id
+	}
+
+	planned, err := s.db.ListPlannedPositions(models.PlannedPositionFilter{DepartmentID: departmentID})
+	if err != nil {
+		s.logger.Error("Failed to list planned positions", zap.Error(err))
+		http.Error(w, "Failed to build headcount report", http.StatusInternalServerError)
+		return
+	}
+	all, err := s.db.ListPositionsBetween(from, to)
+	if err != nil {
+		s.logger.Error("Failed to list positions", zap.Error(err))
+		http.Error(w, "Failed to build headcount report", http.StatusInternalServerError)
+		return
+	}
+	emps, err := s.db.ListEmployees(models.EmployeeFilter{})
+	if err != nil {
+		s.logger.Error("Failed to list employees", zap.Error(err))
+		http.Error(w, "Failed to build headcount report", http.StatusInternalServerError)
+		return
+	}
+	all = append(all, headcount.Untracked(all, emps, to)...)
+	var positions []models.Position
+	seen := make(map[int]bool)
+	var employeeIDs []int
+	for _, pos := range all {
+		if departmentID != nil && *pos.DepartmentID != *departmentID {
+			continue
+		}
+		positions = append(positions, pos)
+		if !seen[pos.EmployeeID] {
+			seen[pos.EmployeeID] = true
+			employeeIDs = append(employeeIDs, pos.EmployeeID)
+		}
+	}
+	transitions, err := s.db.ListAppliedStatusTransitions(employeeIDs)
+	if err != nil {
+		s.logger.Error("Failed to list status transitions", zap.Error(err))
+		http.Error(w, "Failed to build headcount report", http.StatusInternalServerError)
+		return
+	}
+	current := make(map[int]models.EmployeeStatus, len(emps))
+	for _, emp := range emps {
+		current[emp.ID] = emp.Status
+	}
+
+	s.writeJSON(w, http.StatusOK, headcount.Report(dates, planned, positions, transitions, current))
+}
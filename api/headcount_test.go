@@ -0,0 +1,196 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var financeAdmin = &models.Admin{ID: 1, Permissions: []string{models.PermissionFinance}}
+
+func TestHandlePlannedPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		admin      *models.Admin
+		method     string
+		query      string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create",
+			admin:  financeAdmin,
+			method: "POST",
+			body:   `{"title":" Data Engineer ","departmentId":2,"budget":12000000,"currency":"EUR","openedOn":"2025-01-01"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetDepartment", 2).Return(&models.Department{ID: 2}, nil)
+				m.On("CreatePlannedPosition", mock.MatchedBy(func(p *models.PlannedPosition) bool {
+					return p.Title == "Data Engineer" && p.Status == models.PlannedPositionStatusOpen
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Create Filled",
+			admin:      financeAdmin,
+			method:     "POST",
+			body:       `{"title":"Data Engineer","departmentId":2,"currency":"EUR","openedOn":"2025-01-01","status":"filled"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Freeze Filled",
+			admin:  financeAdmin,
+			method: "PUT",
+			query:  "?id=6",
+			body:   `{"title":"Data Engineer","departmentId":2,"currency":"EUR","openedOn":"2025-01-01","status":"frozen"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetPlannedPosition", 6).Return(&models.PlannedPosition{ID: 6, Status: models.PlannedPositionStatusFilled,
+					EmployeeID: intPtr(3)}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "Rename Filled",
+			admin:  financeAdmin,
+			method: "PUT",
+			query:  "?id=6",
+			body:   `{"title":"Senior Data Engineer","departmentId":2,"currency":"EUR","openedOn":"2025-01-01"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetPlannedPosition", 6).Return(&models.PlannedPosition{ID: 6, Status: models.PlannedPositionStatusFilled,
+					EmployeeID: intPtr(3)}, nil)
+				m.On("GetDepartment", 2).Return(&models.Department{ID: 2}, nil)
+				m.On("UpdatePlannedPosition", mock.MatchedBy(func(p *models.PlannedPosition) bool {
+					return p.Status == models.PlannedPositionStatusFilled && *p.EmployeeID == 3
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Without Finance Permission",
+			admin:      &models.Admin{ID: 2},
+			method:     "GET",
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", tt.admin.ID).Return(tt.admin, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, "/headcount/positions"+tt.query, bytes.NewBufferString(tt.body))
+			authorize(t, req, uint32(tt.admin.ID))
+			rr := httptest.NewRecorder()
+
+			server.handlePlannedPositions(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleFillPlannedPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Open",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListPlannedPositions", models.PlannedPositionFilter{EmployeeID: intPtr(3)}).Return([]models.PlannedPosition{}, nil)
+				m.On("FillPlannedPosition", 6, 3).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Frozen",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListPlannedPositions", models.PlannedPositionFilter{EmployeeID: intPtr(3)}).Return([]models.PlannedPosition{}, nil)
+				m.On("FillPlannedPosition", 6, 3).Return(db.ErrConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Already Holds One",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListPlannedPositions", models.PlannedPositionFilter{EmployeeID: intPtr(3)}).
+					Return([]models.PlannedPosition{{ID: 4}}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", 1).Return(financeAdmin, nil)
+			mockDB.On("GetPlannedPosition", 6).Return(&models.PlannedPosition{ID: 6, Status: models.PlannedPositionStatusOpen}, nil)
+			mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusActive}, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/headcount/positions/fill?id=6", bytes.NewBufferString(`{"employeeId":3}`))
+			authorize(t, req, 1)
+			rr := httptest.NewRecorder()
+
+			server.handleFillPlannedPosition(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleHeadcountReport(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(financeAdmin, nil)
+	from := models.NewDate(2025, time.January, 1)
+	to := models.NewDate(2025, time.February, 1)
+	mockDB.On("ListPlannedPositions", models.PlannedPositionFilter{DepartmentID: intPtr(2)}).Return([]models.PlannedPosition{
+		{ID: 1, DepartmentID: 2, Budget: 100, Currency: "EUR", OpenedOn: from},
+		{ID: 2, DepartmentID: 2, Budget: 100, Currency: "EUR", OpenedOn: to},
+	}, nil)
+	mockDB.On("ListPositionsBetween", from, to).Return([]models.Position{
+		{EmployeeID: 3, DepartmentID: intPtr(2), EffectiveFrom: from},
+		{EmployeeID: 4, DepartmentID: intPtr(5), EffectiveFrom: from},
+	}, nil)
+	// Employee 7 predates position history and has no position row; 8
+	// joined after the report.
+	mockDB.On("ListAppliedStatusTransitions", []int{3, 7}).Return([]models.StatusTransition{}, nil)
+	mockDB.On("ListEmployees", models.EmployeeFilter{}).Return([]models.Employee{
+		{ID: 3, Status: models.EmployeeStatusActive}, {ID: 4, Status: models.EmployeeStatusActive},
+		{ID: 7, Status: models.EmployeeStatusActive, DepartmentID: intPtr(2),
+			CreatedAt: time.Date(2023, time.May, 2, 9, 0, 0, 0, time.UTC)},
+		{ID: 8, Status: models.EmployeeStatusActive, DepartmentID: intPtr(2),
+			CreatedAt: time.Date(2025, time.March, 2, 9, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/headcount/report?from=2025-01-01&to=2025-02-01&departmentId=2", nil)
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+
+	server.handleHeadcountReport(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var points []models.HeadcountPoint
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&points))
+	require.Len(t, points, 2)
+	assert.Equal(t, 1, points[0].Planned)
+	assert.Equal(t, 2, points[0].Actual)
+	assert.Equal(t, 2, points[1].Planned)
+	assert.Equal(t, 2, points[1].Actual)
+	assert.Equal(t, 0, points[1].Variance)
+}
@@ -0,0 +1,350 @@
+package api
+
+import (
+	"employees/internal/holidays"
+	"employees/internal/leave"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// maxICalSize bounds iCalendar uploads; a national holiday feed covering
+// several decades is well under this.
+const maxICalSize = 1 << 20
+
+func (s *Server) handleLocation(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var loc models.Location
+		if err := json.NewDecoder(r.Body).Decode(&loc); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if !s.validLocation(w, &loc) {
+			return
+		}
+		if err := s.db.CreateLocation(&loc); err != nil {
+			s.logger.Error("Location creation failed", zap.Error(err))
+			http.Error(w, "Failed to create location", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Location created", zap.Any("location", loc))
+		s.writeJSON(w, http.StatusCreated, loc)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			locs, err := s.db.ListLocations()
+			if err != nil {
+				s.logger.Error("Failed to list locations", zap.Error(err))
+				http.Error(w, "Failed to list locations", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, locs)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		loc, err := s.db.GetLocation(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Location not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, loc)
+	case "PUT":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		var loc models.Location
+		if err := json.NewDecoder(r.Body).Decode(&loc); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if !s.validLocation(w, &loc) {
+			return
+		}
+		loc.ID = id
+		if err := s.db.UpdateLocation(&loc); err != nil {
+			s.logger.Error("Location update failed", zap.Error(err))
+			http.Error(w, "Location not found", http.StatusNotFound)
+			return
+		}
+		s.logger.Info("Location updated", zap.Any("location", loc))
+		s.writeJSON(w, http.StatusOK, loc)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteLocation(id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Location deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Location deleted", zap.Int("locationId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) validLocation(w http.ResponseWriter, loc *models.Location) bool {
+	if loc.Name == "" {
+		http.Error(w, "Name is required", http.StatusBadRequest)
+		s.logger.Error("Name is required")
+		return false
+	}
+	if loc.HolidayCalendarID != nil {
+		if _, err := s.db.GetHolidayCalendar(*loc.HolidayCalendarID); err != nil {
+			http.Error(w, "Holiday calendar not found", http.StatusBadRequest)
+			s.logger.Error("Holiday calendar not found", zap.Error(err))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) handleHolidayCalendar(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var cal models.HolidayCalendar
+		if err := json.NewDecoder(r.Body).Decode(&cal); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if cal.Name == "" {
+			http.Error(w, "Name is required", http.StatusBadRequest)
+			s.logger.Error("Name is required")
+			return
+		}
+		if err := s.db.CreateHolidayCalendar(&cal); err != nil {
+			s.logger.Error("Holiday calendar creation failed", zap.Error(err))
+			http.Error(w, "Failed to create holiday calendar", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Holiday calendar created", zap.Any("calendar", cal))
+		s.writeJSON(w, http.StatusCreated, cal)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			cals, err := s.db.ListHolidayCalendars()
+			if err != nil {
+				s.logger.Error("Failed to list holiday calendars", zap.Error(err))
+				http.Error(w, "Failed to list holiday calendars", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, cals)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		cal, err := s.db.GetHolidayCalendar(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Holiday calendar not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, cal)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteHolidayCalendar(id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Holiday calendar deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Holiday calendar deleted", zap.Int("calendarId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// calendarID reads ?id= and checks that the holiday calendar exists.
+func (s *Server) calendarID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return 0, false
+	}
+	if _, err := s.db.GetHolidayCalendar(id); err != nil {
+		http.Error(w, "Holiday calendar not found", http.StatusNotFound)
+		s.logger.Error("Holiday calendar not found", zap.Error(err))
+		return 0, false
+	}
+	return id, true
+}
+
+// handleCalendarHolidays lists the calendar's holidays between ?from= and
+// ?to=, rule-based ones included, or adds a single dated holiday.
+func (s *Server) handleCalendarHolidays(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		var hol models.Holiday
+		if err := json.NewDecoder(r.Body).Decode(&hol); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if hol.Name == "" || hol.Date.IsZero() {
+			http.Error(w, "name and date are required", http.StatusBadRequest)
+			s.logger.Error("Invalid holiday", zap.Any("holiday", hol))
+			return
+		}
+		hol.ID = 0
+		hol.CalendarID = id
+		if err := s.db.AddHolidays([]models.Holiday{hol}); err != nil {
+			s.logger.Error("Holiday creation failed", zap.Error(err))
+			http.Error(w, "Failed to add holiday", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Holiday added", zap.Any("holiday", hol))
+		s.writeJSON(w, http.StatusCreated, hol)
+	case "GET":
+		from, to, ok := s.dateRange(w, r)
+		if !ok {
+			return
+		}
+		hols, err := s.db.ListCalendarHolidays(id, from, to)
+		if err != nil {
+			s.logger.Error("Failed to list holidays", zap.Error(err))
+			http.Error(w, "Failed to list holidays", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, hols)
+	}
+}
+
+func (s *Server) handleHolidayRules(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		var rule models.HolidayRule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if rule.Name == "" {
+			http.Error(w, "Name is required", http.StatusBadRequest)
+			s.logger.Error("Name is required")
+			return
+		}
+		if _, err := holidays.ParseRule(rule.Rule); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid holiday rule", zap.Error(err))
+			return
+		}
+		rule.ID = 0
+		rule.CalendarID = id
+		if err := s.db.AddHolidayRule(&rule); err != nil {
+			s.logger.Error("Holiday rule creation failed", zap.Error(err))
+			http.Error(w, "Failed to add holiday rule", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Holiday rule added", zap.Any("rule", rule))
+		s.writeJSON(w, http.StatusCreated, rule)
+	case "GET":
+		rules, err := s.db.ListHolidayRules(id)
+		if err != nil {
+			s.logger.Error("Failed to list holiday rules", zap.Error(err))
+			http.Error(w, "Failed to list holiday rules", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, rules)
+	}
+}
+
+// handleImportHolidays adds the all-day events of an iCalendar file posted
+// as the request body to the calendar.
+func (s *Server) handleImportHolidays(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	hols, err := holidays.ParseICal(http.MaxBytesReader(w, r.Body, maxICalSize))
+	if err != nil {
+		http.Error(w, "Invalid iCalendar file: "+err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid iCalendar file", zap.Error(err))
+		return
+	}
+	for i := range hols {
+		hols[i].CalendarID = id
+	}
+
+	if err := s.db.AddHolidays(hols); err != nil {
+		s.logger.Error("Holiday import failed", zap.Error(err))
+		http.Error(w, "Failed to import holidays", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Holidays imported", zap.Int("calendarId", id), zap.Int("count", len(hols)))
+	s.writeJSON(w, http.StatusOK, map[string]int{"imported": len(hols)})
+}
+
+// dateRange reads the required ?from= and ?to= dates.
+func (s *Server) dateRange(w http.ResponseWriter, r *http.Request) (models.Date, models.Date, bool) {
+	from, errFrom := models.ParseDate(r.URL.Query().Get("from"))
+	to, errTo := models.ParseDate(r.URL.Query().Get("to"))
+	if errFrom != nil || errTo != nil || to.Before(from.Time) {
+		http.Error(w, "Valid from and to dates are required", http.StatusBadRequest)
+		s.logger.Error("Invalid date range", zap.String("from", r.URL.Query().Get("from")), zap.String("to", r.URL.Query().Get("to")))
+		return models.Date{}, models.Date{}, false
+	}
+	return from, to, true
+}
+
+// handleWorkingDays counts the employee's working days from ?from= to ?to=
+// inclusive, excluding weekends and their location's holidays.
+func (s *Server) handleWorkingDays(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	from, to, ok := s.dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	hols, err := s.db.GetHolidays(id, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get holidays", zap.Error(err))
+		http.Error(w, "Failed to count working days", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, struct {
+		EmployeeID  int              `json:"employeeId"`
+		From        models.Date      `json:"from"`
+		To          models.Date      `json:"to"`
+		WorkingDays float64          `json:"workingDays"`
+		Holidays    []models.Holiday `json:"holidays"`
+	}{id, from, to, leave.WorkingDays(from, to, false, false, leave.HolidaySet(hols)), hols})
+}
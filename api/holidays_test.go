@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleWorkingDays(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+		wantDays   float64
+	}{
+		{
+			name:  "Excludes Weekends And Holidays",
+			query: "?id=1&from=2025-05-19&to=2025-05-30",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("GetHolidays", 1, models.NewDate(2025, time.May, 19), models.NewDate(2025, time.May, 30)).
+					Return([]models.Holiday{{Name: "Memorial Day", Date: models.NewDate(2025, time.May, 26)}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantDays:   9,
+		},
+		{
+			name:       "Reversed Range",
+			query:      "?id=1&from=2025-05-30&to=2025-05-19",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Unknown Employee",
+			query: "?id=4&from=2025-05-19&to=2025-05-30",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "4").Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/employee/workingdays"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			server.handleWorkingDays(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got struct {
+					WorkingDays float64 `json:"workingDays"`
+				}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Equal(t, tt.wantDays, got.WorkingDays)
+			}
+		})
+	}
+}
+
+func TestHandleHolidayRulesAndImport(t *testing.T) {
+	calendar := &models.HolidayCalendar{ID: 2, Name: "US"}
+
+	t.Run("Valid Rule", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetHolidayCalendar", 2).Return(calendar, nil)
+		mockDB.On("AddHolidayRule", mock.MatchedBy(func(r *models.HolidayRule) bool {
+			return r.CalendarID == 2 && r.Rule == "last Monday of May"
+		})).Return(nil)
+
+		body := `{"name":"Memorial Day","rule":"last Monday of May"}`
+		req := httptest.NewRequest("POST", "/holiday/calendar/rules?id=2", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+
+		server.handleHolidayRules(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Invalid Rule", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetHolidayCalendar", 2).Return(calendar, nil)
+
+		body := `{"name":"Someday","rule":"fifth Friday of May"}`
+		req := httptest.NewRequest("POST", "/holiday/calendar/rules?id=2", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+
+		server.handleHolidayRules(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Import ICal", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetHolidayCalendar", 2).Return(calendar, nil)
+		mockDB.On("AddHolidays", []models.Holiday{
+			{CalendarID: 2, Date: models.NewDate(2025, time.July, 4), Name: "Independence Day"},
+		}).Return(nil)
+
+		ics := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20250704\r\nSUMMARY:Independence Day\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+		req := httptest.NewRequest("POST", "/holiday/calendar/import?id=2", bytes.NewBufferString(ics))
+		rr := httptest.NewRecorder()
+
+		server.handleImportHolidays(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"imported":1}`, rr.Body.String())
+	})
+
+	t.Run("Import ICal With Events On The Same Day", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetHolidayCalendar", 2).Return(calendar, nil)
+		mockDB.On("AddHolidays", []models.Holiday{
+			{CalendarID: 2, Date: models.NewDate(2025, time.November, 11), Name: "Veterans Day / Veterans Day (observed)"},
+		}).Return(nil)
+
+		ics := "BEGIN:VCALENDAR\r\n" +
+			"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20251111\r\nSUMMARY:Veterans Day\r\nEND:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20251111\r\nSUMMARY:Veterans Day (observed)\r\nEND:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"
+		req := httptest.NewRequest("POST", "/holiday/calendar/import?id=2", bytes.NewBufferString(ics))
+		rr := httptest.NewRecorder()
+
+		server.handleImportHolidays(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"imported":1}`, rr.Body.String())
+	})
+
+	t.Run("Unknown Calendar", func(t *testing.T) {
+		server, mockDB := setupTestServer(t)
+		mockDB.On("GetHolidayCalendar", 8).Return(nil, errors.New("not found"))
+
+		req := httptest.NewRequest("POST", "/holiday/calendar/import?id=8", bytes.NewBufferString(""))
+		rr := httptest.NewRecorder()
+
+		server.handleImportHolidays(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
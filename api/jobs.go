@@ -0,0 +1,32 @@
+package api
+
+import (
+	"employees/internal/models"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// runPeriodically runs job once immediately and then every interval for the
+// lifetime of the process. Failures are logged and retried on the next tick.
+func (s *Server) runPeriodically(name string, interval time.Duration, job func(now time.Time) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := job(time.Now()); err != nil {
+			s.logger.Error("Scheduled job failed", zap.String("job", name), zap.Error(err))
+		}
+		<-ticker.C
+	}
+}
+
+func (s *Server) activatePositions(now time.Time) error {
+	n, err := s.db.ActivatePositions(models.DateOf(now))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		s.logger.Info("Positions activated", zap.Int("employees", n))
+	}
+	return nil
+}
@@ -0,0 +1,415 @@
+package api
+
+import (
+	"employees/api/auth"
+	"employees/internal/db"
+	"employees/internal/leave"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// adminID returns the id of the admin whose token authenticated r, or nil
+// when there is none.
+func adminID(r *http.Request) *int {
+	uid, err := auth.ExtractTokenID(r)
+	if err != nil || uid == 0 {
+		return nil
+	}
+	id := int(uid)
+	return &id
+}
+
+// queryDate reads an optional date query parameter, defaulting to today.
+func (s *Server) queryDate(w http.ResponseWriter, r *http.Request, name string) (models.Date, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return models.DateOf(time.Now()), true
+	}
+	date, err := models.ParseDate(value)
+	if err != nil {
+		http.Error(w, "Invalid "+name+" date", http.StatusBadRequest)
+		s.logger.Error("Invalid date", zap.String("param", name), zap.Error(err))
+		return models.Date{}, false
+	}
+	return date, true
+}
+
+func (s *Server) handleLeaveTypes(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var lt models.LeaveType
+		if err := json.NewDecoder(r.Body).Decode(&lt); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if lt.Name == "" {
+			http.Error(w, "Name is required", http.StatusBadRequest)
+			s.logger.Error("Name is required")
+			return
+		}
+		if err := s.db.CreateLeaveType(&lt); err != nil {
+			s.logger.Error("Leave type creation failed", zap.Error(err))
+			http.Error(w, "Failed to create leave type", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Leave type created", zap.Any("leaveType", lt))
+		s.writeJSON(w, http.StatusCreated, lt)
+	case "GET":
+		types, err := s.db.ListLeaveTypes()
+		if err != nil {
+			s.logger.Error("Failed to list leave types", zap.Error(err))
+			http.Error(w, "Failed to list leave types", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, types)
+	}
+}
+
+func (s *Server) handleAccrualPolicies(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "PUT":
+		var policy models.AccrualPolicy
+		if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if policy.DaysPerYear < 0 || policy.StartDate.IsZero() {
+			http.Error(w, "daysPerYear and startDate are required", http.StatusBadRequest)
+			s.logger.Error("Invalid accrual policy", zap.Any("policy", policy))
+			return
+		}
+		if _, err := s.db.GetLeaveType(policy.LeaveTypeID); err != nil {
+			http.Error(w, "Leave type not found", http.StatusBadRequest)
+			s.logger.Error("Leave type not found", zap.Error(err))
+			return
+		}
+
+		policy.EmployeeID = id
+		if err := s.db.SetAccrualPolicy(&policy); err != nil {
+			s.logger.Error("Accrual policy update failed", zap.Error(err))
+			http.Error(w, "Failed to set accrual policy", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Accrual policy set", zap.Any("policy", policy))
+		s.writeJSON(w, http.StatusOK, policy)
+	case "GET":
+		policies, err := s.db.GetAccrualPolicies(id)
+		if err != nil {
+			s.logger.Error("Failed to get accrual policies", zap.Error(err))
+			http.Error(w, "Failed to get accrual policies", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, policies)
+	}
+}
+
+func (s *Server) handleLeaveRequests(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleCreateLeaveRequest(w, r)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		status := models.LeaveStatus(r.URL.Query().Get("status"))
+		reqs, err := s.db.ListLeaveRequests(id, status)
+		if err != nil {
+			s.logger.Error("Failed to list leave requests", zap.Error(err))
+			http.Error(w, "Failed to list leave requests", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, reqs)
+	}
+}
+
+func (s *Server) handleCreateLeaveRequest(w http.ResponseWriter, r *http.Request) {
+	var req models.LeaveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.StartDate.IsZero() || req.EndDate.IsZero() || req.EndDate.Before(req.StartDate.Time) {
+		http.Error(w, "A valid startDate and endDate are required", http.StatusBadRequest)
+		s.logger.Error("Invalid leave dates", zap.Any("request", req))
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(req.EmployeeID)); err != nil {
+		http.Error(w, "Employee not found", http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	lt, err := s.db.GetLeaveType(req.LeaveTypeID)
+	if err != nil {
+		http.Error(w, "Leave type not found", http.StatusBadRequest)
+		s.logger.Error("Leave type not found", zap.Error(err))
+		return
+	}
+
+	holidays, err := s.db.GetHolidays(req.EmployeeID, req.StartDate, req.EndDate)
+	if err != nil {
+		s.logger.Error("Failed to get holidays", zap.Error(err))
+		http.Error(w, "Failed to create leave request", http.StatusInternalServerError)
+		return
+	}
+
+	req.Days = leave.WorkingDays(req.StartDate, req.EndDate, req.HalfDayStart, req.HalfDayEnd, leave.HolidaySet(holidays))
+	if req.Days == 0 {
+		http.Error(w, "Leave request covers no working days", http.StatusBadRequest)
+		s.logger.Error("Leave request covers no working days", zap.Any("request", req))
+		return
+	}
+
+	if !lt.AllowNegative {
+		balance, err := s.leaveBalance(req.EmployeeID, lt.ID, req.StartDate)
+		if err != nil {
+			s.logger.Error("Failed to compute leave balance", zap.Error(err))
+			http.Error(w, "Failed to create leave request", http.StatusInternalServerError)
+			return
+		}
+		if balance.Available < req.Days {
+			http.Error(w, "Insufficient leave balance", http.StatusBadRequest)
+			s.logger.Error("Insufficient leave balance", zap.Any("balance", balance), zap.Float64("requested", req.Days))
+			return
+		}
+	}
+
+	req.ID = 0
+	req.Status = models.LeaveStatusPending
+	req.ApproverID, req.DecidedByAdminID, req.DecidedAt, req.DecisionNote = nil, nil, nil, ""
+	if err := s.db.CreateLeaveRequest(&req); err != nil {
+		s.logger.Error("Leave request creation failed", zap.Error(err))
+		http.Error(w, "Failed to create leave request", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Leave request created", zap.Any("request", req))
+	s.writeJSON(w, http.StatusCreated, req)
+}
+
+// handleLeaveDecision approves, rejects or cancels a leave request. The
+// deciding HR admin is taken from the token; a manager approving on their
+// report's behalf is named by approverId and must be above the requester in
+// the reporting line.
+func (s *Server) handleLeaveDecision(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var decision struct {
+		Status     models.LeaveStatus `json:"status"`
+		Note       string             `json:"note"`
+		ApproverID *int               `json:"approverId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&decision); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req, err := s.db.GetLeaveRequest(id)
+	if err != nil {
+		http.Error(w, "Leave request not found", http.StatusNotFound)
+		s.logger.Error("Leave request not found", zap.Error(err))
+		return
+	}
+
+	if decision.ApproverID != nil {
+		chain, err := s.db.GetReportingChain(req.EmployeeID)
+		if err != nil {
+			s.logger.Error("Failed to get reporting chain", zap.Error(err))
+			http.Error(w, "Failed to decide leave request", http.StatusInternalServerError)
+			return
+		}
+		if !containsEmployee(chain, *decision.ApproverID) {
+			http.Error(w, "Approver is not a manager of the requester", http.StatusForbidden)
+			s.logger.Error("Approver is not a manager of the requester", zap.Int("approverId", *decision.ApproverID))
+			return
+		}
+	}
+
+	from := req.Status
+	adj, err := leave.Decide(req, decision.Status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid leave decision", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	req.ApproverID = decision.ApproverID
+	req.DecidedByAdminID = adminID(r)
+	req.DecisionNote = decision.Note
+	req.DecidedAt = &now
+	if adj != nil {
+		adj.AdminID = req.DecidedByAdminID
+	}
+
+	if err := s.db.DecideLeaveRequest(req, from, adj); err != nil {
+		s.logger.Error("Leave decision failed", zap.Error(err))
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, "Failed to decide leave request", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Leave request decided", zap.Any("request", req))
+	s.writeJSON(w, http.StatusOK, req)
+}
+
+func containsEmployee(emps []models.Employee, id int) bool {
+	for _, emp := range emps {
+		if emp.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) leaveBalance(employeeID, leaveTypeID int, asOf models.Date) (models.LeaveBalance, error) {
+	balances, err := s.leaveBalances(employeeID, []int{leaveTypeID}, asOf)
+	if err != nil {
+		return models.LeaveBalance{}, err
+	}
+	return balances[0], nil
+}
+
+func (s *Server) leaveBalances(employeeID int, leaveTypeIDs []int, asOf models.Date) ([]models.LeaveBalance, error) {
+	policies, err := s.db.GetAccrualPolicies(employeeID)
+	if err != nil {
+		return nil, err
+	}
+	adjustments, err := s.db.GetBalanceAdjustments(employeeID)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.db.ListLeaveRequests(employeeID, models.LeaveStatusPending)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]models.LeaveBalance, 0, len(leaveTypeIDs))
+	for _, typeID := range leaveTypeIDs {
+		var policy *models.AccrualPolicy
+		for i := range policies {
+			if policies[i].LeaveTypeID == typeID {
+				policy = &policies[i]
+			}
+		}
+		balances = append(balances, leave.Balance(employeeID, typeID, policy, adjustments, pending, asOf))
+	}
+	return balances, nil
+}
+
+// handleLeaveBalance returns the employee's balance of every leave type, or
+// of ?leaveTypeId= only, as of ?asOf= (default today).
+func (s *Server) handleLeaveBalance(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	asOf, ok := s.queryDate(w, r, "asOf")
+	if !ok {
+		return
+	}
+
+	var typeIDs []int
+	if r.URL.Query().Get("leaveTypeId") != "" {
+		typeID, ok := s.queryID(w, r, "leaveTypeId")
+		if !ok {
+			return
+		}
+		typeIDs = []int{typeID}
+	} else {
+		types, err := s.db.ListLeaveTypes()
+		if err != nil {
+			s.logger.Error("Failed to list leave types", zap.Error(err))
+			http.Error(w, "Failed to compute leave balance", http.StatusInternalServerError)
+			return
+		}
+		for _, lt := range types {
+			typeIDs = append(typeIDs, lt.ID)
+		}
+	}
+
+	balances, err := s.leaveBalances(id, typeIDs, asOf)
+	if err != nil {
+		s.logger.Error("Failed to compute leave balance", zap.Error(err))
+		http.Error(w, "Failed to compute leave balance", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, balances)
+}
+
+func (s *Server) handleBalanceAdjustments(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		var adj models.BalanceAdjustment
+		if err := json.NewDecoder(r.Body).Decode(&adj); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if adj.Days == 0 || adj.Reason == "" {
+			http.Error(w, "days and reason are required", http.StatusBadRequest)
+			s.logger.Error("Invalid balance adjustment", zap.Any("adjustment", adj))
+			return
+		}
+		if _, err := s.db.GetLeaveType(adj.LeaveTypeID); err != nil {
+			http.Error(w, "Leave type not found", http.StatusBadRequest)
+			s.logger.Error("Leave type not found", zap.Error(err))
+			return
+		}
+
+		adj.ID = 0
+		adj.EmployeeID = id
+		adj.LeaveRequestID = nil
+		adj.AdminID = adminID(r)
+		if err := s.db.AddBalanceAdjustment(&adj); err != nil {
+			s.logger.Error("Balance adjustment failed", zap.Error(err))
+			http.Error(w, "Failed to adjust balance", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Leave balance adjusted", zap.Any("adjustment", adj))
+		s.writeJSON(w, http.StatusCreated, adj)
+	case "GET":
+		adjs, err := s.db.GetBalanceAdjustments(id)
+		if err != nil {
+			s.logger.Error("Failed to get balance adjustments", zap.Error(err))
+			http.Error(w, "Failed to get balance adjustments", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, adjs)
+	}
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCreateLeaveRequest(t *testing.T) {
+	vacation := &models.LeaveType{ID: 1, Name: "Vacation"}
+	sick := &models.LeaveType{ID: 2, Name: "Sick", AllowNegative: true}
+	policy := []models.AccrualPolicy{{EmployeeID: 1, LeaveTypeID: 1, DaysPerYear: 24, StartDate: models.NewDate(2025, time.January, 1)}}
+
+	// 2025-06-02 is a Monday.
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+		wantDays   float64
+	}{
+		{
+			name: "Within Balance",
+			body: `{"employeeId":1,"leaveTypeId":1,"startDate":"2025-06-02","endDate":"2025-06-06","halfDayEnd":true}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("GetLeaveType", 1).Return(vacation, nil)
+				db.On("GetHolidays", 1, models.NewDate(2025, time.June, 2), models.NewDate(2025, time.June, 6)).
+					Return([]models.Holiday{{Date: models.NewDate(2025, time.June, 4)}}, nil)
+				db.On("GetAccrualPolicies", 1).Return(policy, nil)
+				db.On("GetBalanceAdjustments", 1).Return([]models.BalanceAdjustment{}, nil)
+				db.On("ListLeaveRequests", 1, models.LeaveStatusPending).Return([]models.LeaveRequest{}, nil)
+				db.On("CreateLeaveRequest", mock.AnythingOfType("*models.LeaveRequest")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantDays:   3.5,
+		},
+		{
+			name: "Insufficient Balance",
+			body: `{"employeeId":1,"leaveTypeId":1,"startDate":"2025-06-02","endDate":"2025-06-20"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("GetLeaveType", 1).Return(vacation, nil)
+				db.On("GetHolidays", 1, mock.Anything, mock.Anything).Return([]models.Holiday{}, nil)
+				db.On("GetAccrualPolicies", 1).Return(policy, nil)
+				db.On("GetBalanceAdjustments", 1).Return([]models.BalanceAdjustment{{LeaveTypeID: 1, Days: -5}}, nil)
+				db.On("ListLeaveRequests", 1, models.LeaveStatusPending).Return([]models.LeaveRequest{}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Negative Balance Allowed",
+			body: `{"employeeId":1,"leaveTypeId":2,"startDate":"2025-06-02","endDate":"2025-06-02"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("GetLeaveType", 2).Return(sick, nil)
+				db.On("GetHolidays", 1, mock.Anything, mock.Anything).Return([]models.Holiday{}, nil)
+				db.On("CreateLeaveRequest", mock.AnythingOfType("*models.LeaveRequest")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantDays:   1,
+		},
+		{
+			name: "Weekend Only",
+			body: `{"employeeId":1,"leaveTypeId":2,"startDate":"2025-06-07","endDate":"2025-06-08"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("GetLeaveType", 2).Return(sick, nil)
+				db.On("GetHolidays", 1, mock.Anything, mock.Anything).Return([]models.Holiday{}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "End Before Start",
+			body:       `{"employeeId":1,"leaveTypeId":1,"startDate":"2025-06-06","endDate":"2025-06-02"}`,
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/leave/requests", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleLeaveRequests(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var got models.LeaveRequest
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Equal(t, tt.wantDays, got.Days)
+				assert.Equal(t, models.LeaveStatusPending, got.Status)
+			}
+		})
+	}
+}
+
+func TestHandleLeaveDecision(t *testing.T) {
+	pending := func() *models.LeaveRequest {
+		return &models.LeaveRequest{ID: 5, EmployeeID: 3, LeaveTypeID: 1, Days: 2, Status: models.LeaveStatusPending}
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Manager Approves",
+			body: `{"status":"approved","approverId":2}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(pending(), nil)
+				m.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}, {ID: 1}}, nil)
+				m.On("DecideLeaveRequest", mock.AnythingOfType("*models.LeaveRequest"), models.LeaveStatusPending,
+					mock.MatchedBy(func(adj *models.BalanceAdjustment) bool {
+						return adj.Days == -2 && *adj.LeaveRequestID == 5
+					})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "HR Rejects",
+			body: `{"status":"rejected","note":"Peak season"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(pending(), nil)
+				m.On("DecideLeaveRequest", mock.AnythingOfType("*models.LeaveRequest"), models.LeaveStatusPending,
+					(*models.BalanceAdjustment)(nil)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Approver Outside Reporting Line",
+			body: `{"status":"approved","approverId":9}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(pending(), nil)
+				m.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}}, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "Invalid Transition",
+			body: `{"status":"pending"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(pending(), nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Concurrent Decision",
+			body: `{"status":"approved"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(pending(), nil)
+				m.On("DecideLeaveRequest", mock.Anything, mock.Anything, mock.Anything).Return(db.ErrConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Unknown Request",
+			body: `{"status":"approved"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetLeaveRequest", 5).Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/leave/requests/decision?id=5", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleLeaveDecision(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleLeaveBalance(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("ListLeaveTypes").Return([]models.LeaveType{{ID: 1}, {ID: 2}}, nil)
+	mockDB.On("GetAccrualPolicies", 1).Return([]models.AccrualPolicy{
+		{LeaveTypeID: 1, DaysPerYear: 12, StartDate: models.NewDate(2025, time.January, 1)},
+	}, nil)
+	mockDB.On("GetBalanceAdjustments", 1).Return([]models.BalanceAdjustment{{LeaveTypeID: 1, Days: -2}}, nil)
+	mockDB.On("ListLeaveRequests", 1, models.LeaveStatusPending).Return([]models.LeaveRequest{
+		{LeaveTypeID: 2, Days: 1, Status: models.LeaveStatusPending},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/leave/balance?id=1&asOf=2025-07-01", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleLeaveBalance(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []models.LeaveBalance
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	require.Len(t, got, 2)
+	assert.Equal(t, 4.0, got[0].Available)
+	assert.Equal(t, -1.0, got[1].Available)
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"employees/internal/address"
+	"employees/internal/db"
+	"employees/internal/lifecycle"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// OnTransition registers a hook to run after an employee moves into
+// status, or after every transition when status is empty.
+func (s *Server) OnTransition(status models.EmployeeStatus, hook lifecycle.Hook) {
+	s.hooks.On(status, hook)
+}
+
+// runHooks runs the transition hooks. Hooks run after the transition has
+// been stored, so their failures are logged rather than undoing it.
+func (s *Server) runHooks(emp models.Employee, tr models.StatusTransition) {
+	if err := s.hooks.Run(emp, tr); err != nil {
+		s.logger.Error("Status transition hook failed", zap.Int("employeeId", emp.ID),
+			zap.String("status", string(tr.To)), zap.Error(err))
+	}
+}
+
+// reassignReports moves a terminated employee's direct reports up to the
+// employee's own manager.
+func (s *Server) reassignReports(emp models.Employee, tr models.StatusTransition) error {
+	reports, err := s.db.GetDirectReports(emp.ID)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, report := range reports {
+		report.ManagerID = emp.ManagerID
+		if err := s.db.UpdateEmployee(&report); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// employeeFilter reads the filters shared by the employee list and export:
+// ?departmentId=, ?status=a,b, ?country= and ?cf.<key>=. It writes a 400
+// response and returns false when one is malformed.
+func (s *Server) employeeFilter(w http.ResponseWriter, r *http.Request) (models.EmployeeFilter, bool) {
+	var filter models.EmployeeFilter
+	if r.URL.Query().Get("departmentId") != "" {
+		id, ok := s.queryID(w, r, "departmentId")
+		if !ok {
+			return filter, false
+		}
+		filter.DepartmentID = &id
+	}
+	if statuses := r.URL.Query().Get("status"); statuses != "" {
+		for _, status := range strings.Split(statuses, ",") {
+			status := models.EmployeeStatus(strings.TrimSpace(status))
+			if !lifecycle.Valid(status) {
+				http.Error(w, "Invalid status "+string(status), http.StatusBadRequest)
+				s.logger.Error("Invalid status filter", zap.String("status", string(status)))
+				return filter, false
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	if country := r.URL.Query().Get("country"); country != "" {
+		filter.Country = strings.ToUpper(country)
+		if !address.ValidCountry(filter.Country) {
+			http.Error(w, "country must be an ISO 3166-1 alpha-2 code", http.StatusBadRequest)
+			s.logger.Error("Invalid country filter", zap.String("country", country))
+			return filter, false
+		}
+	}
+
+	customFields, err := s.customFieldFilter(r)
+	if err != nil {
+		s.writeCustomFieldError(w, err)
+		return filter, false
+	}
+	filter.CustomFields = customFields
+	return filter, true
+}
+
+func (s *Server) handleListEmployees(w http.ResponseWriter, r *http.Request) {
+	filter, ok := s.employeeFilter(w, r)
+	if !ok {
+		return
+	}
+
+	emps, err := s.db.ListEmployees(filter)
+	if err != nil {
+		s.logger.Error("Failed to list employees", zap.Error(err))
+		http.Error(w, "Failed to list employees", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Employees listed", zap.Int("count", len(emps)))
+	s.signPhotos(emps)
+	s.writeJSON(w, http.StatusOK, emps)
+}
+
+func (s *Server) handleEmployeeStatus(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleTransitionEmployee(w, r)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		transitions, err := s.db.ListStatusTransitions(id)
+		if err != nil {
+			s.logger.Error("Failed to list status transitions", zap.Error(err))
+			http.Error(w, "Failed to list status transitions", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, transitions)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "transitionId")
+		if !ok {
+			return
+		}
+		if err := s.db.CancelStatusTransition(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Only pending transitions can be cancelled", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to cancel status transition", http.StatusInternalServerError)
+			}
+			s.logger.Error("Status transition cancellation failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Status transition cancelled", zap.Int("transitionId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleTransitionEmployee changes an employee's status. A transition
+// effective today or earlier applies at once and runs its hooks; a future
+// one is scheduled and applied by applyStatusTransitions on its day.
+func (s *Server) handleTransitionEmployee(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var tr models.StatusTransition
+	if err := json.NewDecoder(r.Body).Decode(&tr); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(tr.Reason) == "" {
+		http.Error(w, "reason is required", http.StatusBadRequest)
+		s.logger.Error("reason is required")
+		return
+	}
+
+	emp, err := s.db.GetEmployee(strconv.Itoa(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if err := lifecycle.Transition(emp.Status, tr.To); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid status transition", zap.Error(err))
+		return
+	}
+
+	pending, err := s.db.GetPendingStatusTransition(id)
+	if err != nil {
+		s.logger.Error("Failed to get pending status transition", zap.Error(err))
+		http.Error(w, "Failed to change status", http.StatusInternalServerError)
+		return
+	}
+	if pending != nil {
+		http.Error(w, "A status change is already scheduled for "+pending.EffectiveDate.String(), http.StatusConflict)
+		s.logger.Error("Status transition already pending", zap.Any("transition", pending))
+		return
+	}
+
+	now := time.Now()
+	today := models.DateOf(now)
+	if tr.EffectiveDate.IsZero() {
+		tr.EffectiveDate = today
+	}
+	tr.ID = 0
+	tr.EmployeeID = id
+	tr.From = emp.Status
+	tr.AdminID = adminID(r)
+	tr.AppliedAt = nil
+	if !tr.EffectiveDate.After(today.Time) {
+		tr.AppliedAt = &now
+	}
+
+	if err := s.db.RecordStatusTransition(&tr); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Employee status changed concurrently", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to change status", http.StatusInternalServerError)
+		}
+		s.logger.Error("Status transition failed", zap.Error(err))
+		return
+	}
+
+	if tr.AppliedAt != nil {
+		emp.Status = tr.To
+		s.runHooks(*emp, tr)
+		s.logger.Info("Employee status changed", zap.Any("transition", tr))
+		s.writeJSON(w, http.StatusOK, tr)
+		return
+	}
+
+	s.logger.Info("Employee status change scheduled", zap.Any("transition", tr))
+	s.writeJSON(w, http.StatusAccepted, tr)
+}
+
+// applyStatusTransitions applies scheduled transitions that have come due.
+// One that no longer fits the employee's status is cancelled.
+func (s *Server) applyStatusTransitions(now time.Time) error {
+	due, err := s.db.DueStatusTransitions(models.DateOf(now))
+	if err != nil {
+		return err
+	}
+
+	for _, tr := range due {
+		emp, err := s.db.GetEmployee(strconv.Itoa(tr.EmployeeID))
+		if err != nil {
+			s.logger.Error("Employee not found", zap.Int("employeeId", tr.EmployeeID), zap.Error(err))
+			continue
+		}
+
+		err = s.db.ApplyStatusTransition(&tr)
+		if errors.Is(err, db.ErrConflict) {
+			s.logger.Error("Scheduled status transition no longer applies", zap.Any("transition", tr))
+			if err := s.db.CancelStatusTransition(tr.ID); err != nil && !errors.Is(err, db.ErrConflict) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		emp.Status = tr.To
+		s.runHooks(*emp, tr)
+		s.logger.Info("Scheduled status transition applied", zap.Any("transition", tr))
+	}
+	return nil
+}
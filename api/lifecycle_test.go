@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTransitionEmployee(t *testing.T) {
+	active := func() *models.Employee {
+		return &models.Employee{ID: 3, ManagerID: intPtr(1), Status: models.EmployeeStatusActive}
+	}
+	tomorrow := models.DateOf(time.Now()).AddDays(1).String()
+
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Terminate Now Runs Hooks",
+			body: `{"to":"terminated","reason":"Resigned"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(active(), nil)
+				m.On("GetPendingStatusTransition", 3).Return(nil, nil)
+				m.On("RecordStatusTransition", mock.MatchedBy(func(tr *models.StatusTransition) bool {
+					return tr.From == models.EmployeeStatusActive && tr.AppliedAt != nil
+				})).Return(nil)
+				m.On("GetDirectReports", 3).Return([]models.Employee{{ID: 5, ManagerID: intPtr(3)}}, nil)
+				m.On("UpdateEmployee", mock.MatchedBy(func(e *models.Employee) bool {
+					return e.ID == 5 && *e.ManagerID == 1
+				})).Return(nil)
+				m.On("StartChecklists", mock.AnythingOfType("*models.Employee"), models.ChecklistKindOffboarding,
+					mock.Anything).Return([]models.Checklist{}, nil)
+				m.On("ListAssets", models.AssetFilter{EmployeeID: intPtr(3)}).Return([]models.Asset{}, nil)
+				m.On("ListPlannedPositions", models.PlannedPositionFilter{EmployeeID: intPtr(3)}).
+					Return([]models.PlannedPosition{{ID: 6}}, nil)
+				m.On("VacatePlannedPosition", 6).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Future Leave Is Scheduled",
+			body: `{"to":"on_leave","reason":"Sabbatical","effectiveDate":"` + tomorrow + `"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(active(), nil)
+				m.On("GetPendingStatusTransition", 3).Return(nil, nil)
+				m.On("RecordStatusTransition", mock.MatchedBy(func(tr *models.StatusTransition) bool {
+					return tr.AppliedAt == nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name: "Invalid Transition",
+			body: `{"to":"onboarding","reason":"Oops"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(active(), nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Already Scheduled",
+			body: `{"to":"on_leave","reason":"Parental leave"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(active(), nil)
+				m.On("GetPendingStatusTransition", 3).Return(&models.StatusTransition{ID: 9}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Missing Reason",
+			body:       `{"to":"terminated"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/employee/status?id=3", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleEmployeeStatus(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestOnTransitionHook(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusOnboarding}, nil)
+	mockDB.On("GetPendingStatusTransition", 3).Return(nil, nil)
+	mockDB.On("RecordStatusTransition", mock.AnythingOfType("*models.StatusTransition")).Return(nil)
+
+	var got models.Employee
+	server.OnTransition(models.EmployeeStatusActive, func(emp models.Employee, tr models.StatusTransition) error {
+		got = emp
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "/employee/status?id=3", bytes.NewBufferString(`{"to":"active","reason":"Onboarded"}`))
+	rr := httptest.NewRecorder()
+
+	server.handleEmployeeStatus(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, models.EmployeeStatusActive, got.Status)
+}
+
+func TestApplyStatusTransitions(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	now := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	due := []models.StatusTransition{
+		{ID: 1, EmployeeID: 3, From: models.EmployeeStatusActive, To: models.EmployeeStatusOnLeave},
+		{ID: 2, EmployeeID: 4, From: models.EmployeeStatusActive, To: models.EmployeeStatusTerminated},
+	}
+	mockDB.On("DueStatusTransitions", models.DateOf(now)).Return(due, nil)
+	mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3, Status: models.EmployeeStatusActive}, nil)
+	mockDB.On("GetEmployee", "4").Return(&models.Employee{ID: 4, Status: models.EmployeeStatusOnLeave}, nil)
+	mockDB.On("ApplyStatusTransition", mock.MatchedBy(func(tr *models.StatusTransition) bool { return tr.ID == 1 })).Return(nil)
+	mockDB.On("ApplyStatusTransition", mock.MatchedBy(func(tr *models.StatusTransition) bool { return tr.ID == 2 })).Return(db.ErrConflict)
+	mockDB.On("CancelStatusTransition", 2).Return(nil)
+
+	require.NoError(t, server.applyStatusTransitions(now))
+}
+
+func TestHandleListEmployees(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:  "By Status",
+			query: "?status=active,on_leave&departmentId=2",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListEmployees", models.EmployeeFilter{DepartmentID: intPtr(2),
+					Statuses: []models.EmployeeStatus{models.EmployeeStatusActive, models.EmployeeStatusOnLeave}}).
+					Return([]models.Employee{{ID: 1, Status: models.EmployeeStatusActive}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Unknown Status",
+			query:      "?status=retired",
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/employees"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			server.handleListEmployees(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestCreateEmployeeInitialStatus(t *testing.T) {
+	server, _ := setupTestServer(t)
+	payload, err := json.Marshal(models.Employee{FirstName: "Ada", LastName: "King", Email: "ada@example.com",
+		Status: models.EmployeeStatusTerminated})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/employee", bytes.NewBuffer(payload))
+	rr := httptest.NewRecorder()
+
+	server.handleCreateEmployee(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
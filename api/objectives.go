@@ -0,0 +1,380 @@
+package api
+
+import (
+	"employees/internal/models"
+	"employees/internal/okr"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+var errObjectiveCycle = errors.New("objective cannot be aligned to one aligned below it")
+
+func (s *Server) handleObjectives(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var obj models.Objective
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		obj.ID = 0
+		for i := range obj.KeyResults {
+			obj.KeyResults[i].ID = 0
+		}
+		if !s.validObjective(w, &obj) {
+			return
+		}
+		if err := s.db.CreateObjective(&obj); err != nil {
+			s.logger.Error("Objective creation failed", zap.Error(err))
+			http.Error(w, "Failed to create objective", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Objective created", zap.Int("objectiveId", obj.ID))
+		s.writeJSON(w, http.StatusCreated, obj)
+	case "GET":
+		if r.URL.Query().Get("id") != "" {
+			obj, ok := s.objective(w, r, "id")
+			if !ok {
+				return
+			}
+			s.writeJSON(w, http.StatusOK, obj)
+			return
+		}
+		filter, ok := s.objectiveFilter(w, r)
+		if !ok {
+			return
+		}
+		objectives, err := s.db.ListObjectives(filter)
+		if err != nil {
+			s.logger.Error("Failed to list objectives", zap.Error(err))
+			http.Error(w, "Failed to list objectives", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, objectives)
+	case "PUT":
+		existing, ok := s.objective(w, r, "id")
+		if !ok {
+			return
+		}
+		var obj models.Objective
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		obj.ID = existing.ID
+		obj.KeyResults = nil
+		if !s.validObjective(w, &obj) {
+			return
+		}
+		if err := s.db.UpdateObjective(&obj); err != nil {
+			s.logger.Error("Objective update failed", zap.Error(err))
+			http.Error(w, "Failed to update objective", http.StatusInternalServerError)
+			return
+		}
+		obj.KeyResults = existing.KeyResults
+		obj.CreatedAt = existing.CreatedAt
+		s.logger.Info("Objective updated", zap.Int("objectiveId", obj.ID))
+		s.writeJSON(w, http.StatusOK, obj)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteObjective(id); err != nil {
+			http.Error(w, "Objective not found", http.StatusNotFound)
+			s.logger.Error("Objective deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Objective deleted", zap.Int("objectiveId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// objective loads the objective named by query parameter name, writing a
+// 404 when there is none.
+func (s *Server) objective(w http.ResponseWriter, r *http.Request, name string) (*models.Objective, bool) {
+	id, ok := s.queryID(w, r, name)
+	if !ok {
+		return nil, false
+	}
+	obj, err := s.db.GetObjective(id)
+	if err != nil {
+		http.Error(w, "Objective not found", http.StatusNotFound)
+		s.logger.Error("Objective not found", zap.Error(err))
+		return nil, false
+	}
+	return obj, true
+}
+
+// objectiveFilter reads ?quarter=, ?employeeId=, ?departmentId=,
+// ?teamId=, ?managerId= and ?parentId=.
+func (s *Server) objectiveFilter(w http.ResponseWriter, r *http.Request) (models.ObjectiveFilter, bool) {
+	filter := models.ObjectiveFilter{Quarter: strings.ToUpper(r.URL.Query().Get("quarter"))}
+	if filter.Quarter != "" && !okr.ValidQuarter(filter.Quarter) {
+		http.Error(w, "quarter must look like 2025-Q3", http.StatusBadRequest)
+		s.logger.Error("Invalid quarter", zap.String("quarter", filter.Quarter))
+		return filter, false
+	}
+	for name, field := range map[string]**int{
+		"employeeId":   &filter.EmployeeID,
+		"departmentId": &filter.DepartmentID,
+		"teamId":       &filter.TeamID,
+		"managerId":    &filter.ManagerID,
+		"parentId":     &filter.ParentID,
+	} {
+		if r.URL.Query().Get(name) == "" {
+			continue
+		}
+		id, ok := s.queryID(w, r, name)
+		if !ok {
+			return filter, false
+		}
+		*field = &id
+	}
+	return filter, true
+}
+
+// validObjective validates obj and checks that its owner and parent exist
+// and that the parent is not aligned below obj. It writes the error
+// response itself.
+func (s *Server) validObjective(w http.ResponseWriter, obj *models.Objective) bool {
+	err := okr.ValidateObjective(obj)
+	if err == nil {
+		err = s.checkObjectiveReferences(obj)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid objective", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func (s *Server) checkObjectiveReferences(obj *models.Objective) error {
+	if obj.EmployeeID != nil {
+		if _, err := s.db.GetEmployee(strconv.Itoa(*obj.EmployeeID)); err != nil {
+			return errors.New("employee not found")
+		}
+	}
+	if obj.DepartmentID != nil {
+		if _, err := s.db.GetDepartment(*obj.DepartmentID); err != nil {
+			return errDepartmentNotFound
+		}
+	}
+	if obj.TeamID != nil {
+		if _, err := s.db.GetTeam(*obj.TeamID); err != nil {
+			return errTeamNotFound
+		}
+	}
+	if obj.ParentID == nil {
+		return nil
+	}
+	if _, err := s.db.GetObjective(*obj.ParentID); err != nil {
+		return errors.New("parent objective not found")
+	}
+	if obj.ID == 0 {
+		return nil
+	}
+	tree, err := s.db.ListObjectiveTree(obj.ID)
+	if err != nil {
+		return err
+	}
+	for _, o := range tree {
+		if o.ID == *obj.ParentID {
+			return errObjectiveCycle
+		}
+	}
+	return nil
+}
+
+// handleKeyResults adds a key result to ?objectiveId= (POST), or changes
+// (PUT) or removes (DELETE) key result ?id=.
+func (s *Server) handleKeyResults(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		var kr models.KeyResult
+		if err := json.NewDecoder(r.Body).Decode(&kr); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+
+		if r.Method == "POST" {
+			obj, ok := s.objective(w, r, "objectiveId")
+			if !ok {
+				return
+			}
+			kr.ID = 0
+			kr.ObjectiveID = obj.ID
+		} else {
+			id, ok := s.queryID(w, r, "id")
+			if !ok {
+				return
+			}
+			existing, err := s.db.GetKeyResult(id)
+			if err != nil {
+				http.Error(w, "Key result not found", http.StatusNotFound)
+				s.logger.Error("Key result not found", zap.Error(err))
+				return
+			}
+			kr.ID = existing.ID
+			kr.ObjectiveID = existing.ObjectiveID
+			kr.CurrentValue = existing.CurrentValue
+			kr.CreatedAt = existing.CreatedAt
+		}
+		if err := okr.ValidateKeyResult(&kr); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid key result", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			if err := s.db.CreateKeyResult(&kr); err != nil {
+				s.logger.Error("Key result creation failed", zap.Error(err))
+				http.Error(w, "Failed to create key result", http.StatusInternalServerError)
+				return
+			}
+			s.logger.Info("Key result created", zap.Int("objectiveId", kr.ObjectiveID), zap.Int("keyResultId", kr.ID))
+			s.writeJSON(w, http.StatusCreated, kr)
+			return
+		}
+		if err := s.db.UpdateKeyResult(&kr); err != nil {
+			s.logger.Error("Key result update failed", zap.Error(err))
+			http.Error(w, "Failed to update key result", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Key result updated", zap.Int("keyResultId", kr.ID))
+		s.writeJSON(w, http.StatusOK, kr)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteKeyResult(id); err != nil {
+			s.logger.Error("Key result deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete key result", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Key result deleted", zap.Int("keyResultId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleCheckIns lists the check-in history of ?keyResultId= (GET) or
+// records a new value for it (POST).
+func (s *Server) handleCheckIns(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "keyResultId")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		checkIns, err := s.db.ListCheckIns(id)
+		if err != nil {
+			s.logger.Error("Failed to list check-ins", zap.Error(err))
+			http.Error(w, "Failed to list check-ins", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, checkIns)
+	case "POST":
+		var ci models.CheckIn
+		if err := json.NewDecoder(r.Body).Decode(&ci); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if _, err := s.db.GetKeyResult(id); err != nil {
+			http.Error(w, "Key result not found", http.StatusNotFound)
+			s.logger.Error("Key result not found", zap.Error(err))
+			return
+		}
+		ci.ID = 0
+		ci.KeyResultID = id
+		ci.Note = strings.TrimSpace(ci.Note)
+		ci.AdminID = adminID(r)
+		if err := s.db.RecordCheckIn(&ci); err != nil {
+			s.logger.Error("Check-in failed", zap.Error(err))
+			http.Error(w, "Failed to record check-in", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Key result checked in", zap.Int("keyResultId", id), zap.Float64("value", ci.Value))
+		s.writeJSON(w, http.StatusCreated, ci)
+	}
+}
+
+type progressSummary struct {
+	Progress   float64                    `json:"progress"`
+	Objectives []models.ObjectiveProgress `json:"objectives"`
+}
+
+// handleObjectiveProgress rolls up the progress of objective ?id= through
+// everything aligned below it. Without ?id= it rolls up each objective
+// matching the list filters and reports their mean, such as an
+// employee's progress for a quarter.
+func (s *Server) handleObjectiveProgress(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("id") != "" {
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		progress, ok := s.rollupObjective(w, id)
+		if !ok {
+			return
+		}
+		s.writeJSON(w, http.StatusOK, progress)
+		return
+	}
+
+	filter, ok := s.objectiveFilter(w, r)
+	if !ok {
+		return
+	}
+	objectives, err := s.db.ListObjectives(filter)
+	if err != nil {
+		s.logger.Error("Failed to list objectives", zap.Error(err))
+		http.Error(w, "Failed to list objectives", http.StatusInternalServerError)
+		return
+	}
+	summary := progressSummary{Objectives: []models.ObjectiveProgress{}}
+	for _, obj := range objectives {
+		progress, ok := s.rollupObjective(w, obj.ID)
+		if !ok {
+			return
+		}
+		summary.Objectives = append(summary.Objectives, progress)
+		summary.Progress += progress.Progress
+	}
+	if len(summary.Objectives) > 0 {
+		summary.Progress /= float64(len(summary.Objectives))
+	}
+	s.writeJSON(w, http.StatusOK, summary)
+}
+
+func (s *Server) rollupObjective(w http.ResponseWriter, id int) (models.ObjectiveProgress, bool) {
+	tree, err := s.db.ListObjectiveTree(id)
+	if err != nil {
+		s.logger.Error("Failed to list objectives", zap.Error(err))
+		http.Error(w, "Failed to calculate progress", http.StatusInternalServerError)
+		return models.ObjectiveProgress{}, false
+	}
+	if len(tree) == 0 {
+		http.Error(w, "Objective not found", http.StatusNotFound)
+		s.logger.Error("Objective not found", zap.Int("objectiveId", id))
+		return models.ObjectiveProgress{}, false
+	}
+	progress, err := okr.Rollup(tree, id)
+	if err != nil {
+		s.logger.Error("Failed to calculate progress", zap.Error(err))
+		http.Error(w, "Failed to calculate progress", http.StatusInternalServerError)
+		return models.ObjectiveProgress{}, false
+	}
+	return progress, true
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleObjectives(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create With Key Results",
+			method: "POST",
+			url:    "/objectives",
+			body: `{"title":"Ship v2","quarter":"2025-Q3","employeeId":3,"parentId":1,
+				"keyResults":[{"title":"Beta customers","targetValue":10}]}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("GetObjective", 1).Return(&models.Objective{ID: 1}, nil)
+				m.On("CreateObjective", mock.MatchedBy(func(o *models.Objective) bool {
+					return len(o.KeyResults) == 1 && o.KeyResults[0].Weight == 1
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Create Without Owner",
+			method:     "POST",
+			url:        "/objectives",
+			body:       `{"title":"Ship v2","quarter":"2025-Q3"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Align Below Own Child",
+			method: "PUT",
+			url:    "/objectives?id=1",
+			body:   `{"title":"Company","quarter":"2025-Q3","departmentId":2,"parentId":5}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetObjective", 1).Return(&models.Objective{ID: 1}, nil)
+				m.On("GetDepartment", 2).Return(&models.Department{ID: 2}, nil)
+				m.On("GetObjective", 5).Return(&models.Objective{ID: 5, ParentID: intPtr(1)}, nil)
+				m.On("ListObjectiveTree", 1).Return([]models.Objective{{ID: 1}, {ID: 5, ParentID: intPtr(1)}}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "List Reports For Quarter",
+			method: "GET",
+			url:    "/objectives?managerId=1&quarter=2025-q3",
+			setupMock: func(m *mocks.Database) {
+				m.On("ListObjectives", models.ObjectiveFilter{Quarter: "2025-Q3", ManagerID: intPtr(1)}).
+					Return([]models.Objective{{ID: 4}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, tt.url, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleObjectives(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleCheckIns(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetKeyResult", 10).Return(&models.KeyResult{ID: 10, TargetValue: 10}, nil)
+	mockDB.On("RecordCheckIn", mock.MatchedBy(func(ci *models.CheckIn) bool {
+		return ci.KeyResultID == 10 && ci.Value == 4 && ci.Note == "Two more signed"
+	})).Return(nil)
+
+	req := httptest.NewRequest("POST", "/objectives/check-ins?keyResultId=10",
+		bytes.NewBufferString(`{"value":4,"note":" Two more signed "}`))
+	rr := httptest.NewRecorder()
+
+	server.handleCheckIns(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestHandleObjectiveProgress(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("ListObjectives", models.ObjectiveFilter{Quarter: "2025-Q3", EmployeeID: intPtr(3)}).
+		Return([]models.Objective{{ID: 4}, {ID: 6}}, nil)
+	mockDB.On("ListObjectiveTree", 4).Return([]models.Objective{{ID: 4, KeyResults: []models.KeyResult{
+		{ID: 40, TargetValue: 10, CurrentValue: 10, Weight: 1},
+	}}}, nil)
+	mockDB.On("ListObjectiveTree", 6).Return([]models.Objective{{ID: 6, KeyResults: []models.KeyResult{
+		{ID: 60, TargetValue: 10, CurrentValue: 0, Weight: 1},
+	}}}, nil)
+
+	req := httptest.NewRequest("GET", "/objectives/progress?employeeId=3&quarter=2025-Q3", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleObjectiveProgress(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got progressSummary
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	assert.Len(t, got.Objectives, 2)
+	assert.InDelta(t, 0.5, got.Progress, 1e-9)
+}
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errManagerNotFound    = errors.New("manager not found")
+	errDepartmentNotFound = errors.New("department not found")
+	errLocationNotFound   = errors.New("location not found")
+	errSelfManaged        = errors.New("employee cannot be their own manager")
+	errManagerCycle       = errors.New("manager change would create a reporting cycle")
+)
+
+func (s *Server) handleDepartment(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleCreateDepartment(w, r)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			s.handleListDepartments(w, r)
+			return
+		}
+		s.handleGetDepartment(w, r)
+	case "PUT":
+		s.handleUpdateDepartment(w, r)
+	case "DELETE":
+		s.handleDeleteDepartment(w, r)
+	}
+}
+
+// queryID reads a required integer query parameter, writing a 400 response
+// and returning false when it is missing or malformed.
+func (s *Server) queryID(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	id := r.URL.Query().Get(name)
+	if id == "" {
+		http.Error(w, "ID is required", http.StatusBadRequest)
+		s.logger.Error("ID is required", zap.String("param", name))
+		return 0, false
+	}
+
+	intId, ok := validateId(id)
+	if !ok {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		s.logger.Error("Invalid ID", zap.String("param", name), zap.String("value", id))
+		return 0, false
+	}
+	return intId, true
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Error("Failed to encode response", zap.Error(err))
+	}
+}
+
+// validateReferences checks that the department, location and manager
+// referenced by emp exist and that the manager is not emp or anyone
+// reporting to emp.
+func (s *Server) validateReferences(emp *models.Employee) error {
+	if emp.DepartmentID != nil {
+		if _, err := s.db.GetDepartment(*emp.DepartmentID); err != nil {
+			return errDepartmentNotFound
+		}
+	}
+	if emp.LocationID != nil {
+		if _, err := s.db.GetLocation(*emp.LocationID); err != nil {
+			return errLocationNotFound
+		}
+	}
+
+	if emp.ManagerID == nil {
+		return nil
+	}
+	if emp.ID != 0 && *emp.ManagerID == emp.ID {
+		return errSelfManaged
+	}
+	if _, err := s.db.GetEmployee(strconv.Itoa(*emp.ManagerID)); err != nil {
+		return errManagerNotFound
+	}
+	if emp.ID == 0 {
+		return nil
+	}
+
+	chain, err := s.db.GetReportingChain(*emp.ManagerID)
+	if err != nil {
+		return err
+	}
+	for _, m := range chain {
+		if m.ID == emp.ID {
+			return errManagerCycle
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleDirectReports(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	emps, err := s.db.GetDirectReports(id)
+	if err != nil {
+		s.logger.Error("Failed to get direct reports", zap.Error(err))
+		http.Error(w, "Failed to get direct reports", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Direct reports retrieved", zap.Int("managerId", id), zap.Int("count", len(emps)))
+	s.signPhotos(emps)
+	s.writeJSON(w, http.StatusOK, emps)
+}
+
+func (s *Server) handleReportingChain(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	emps, err := s.db.GetReportingChain(id)
+	if err != nil {
+		s.logger.Error("Failed to get reporting chain", zap.Error(err))
+		http.Error(w, "Failed to get reporting chain", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Reporting chain retrieved", zap.Int("employeeId", id), zap.Int("count", len(emps)))
+	s.signPhotos(emps)
+	s.writeJSON(w, http.StatusOK, emps)
+}
+
+func (s *Server) handleSubordinates(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	emps, err := s.db.GetSubordinates(id)
+	if err != nil {
+		s.logger.Error("Failed to get subordinates", zap.Error(err))
+		http.Error(w, "Failed to get subordinates", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Subordinates retrieved", zap.Int("managerId", id), zap.Int("count", len(emps)))
+	s.signPhotos(emps)
+	s.writeJSON(w, http.StatusOK, emps)
+}
+
+func (s *Server) handleCreateDepartment(w http.ResponseWriter, r *http.Request) {
+	var dept models.Department
+	if err := json.NewDecoder(r.Body).Decode(&dept); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if dept.Name == "" {
+		http.Error(w, "Name is required", http.StatusBadRequest)
+		s.logger.Error("Name is required")
+		return
+	}
+
+	if err := s.db.CreateDepartment(&dept); err != nil {
+		s.logger.Error("Department creation failed", zap.Error(err))
+		http.Error(w, "Failed to create department", http.StatusBadRequest)
+		return
+	}
+
+	s.logger.Info("Department created", zap.Any("department", dept))
+	s.writeJSON(w, http.StatusCreated, dept)
+}
+
+func (s *Server) handleGetDepartment(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	dept, err := s.db.GetDepartment(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Department not found", zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Department retrieved", zap.Any("department", dept))
+	s.writeJSON(w, http.StatusOK, dept)
+}
+
+func (s *Server) handleListDepartments(w http.ResponseWriter, r *http.Request) {
+	depts, err := s.db.ListDepartments()
+	if err != nil {
+		s.logger.Error("Failed to list departments", zap.Error(err))
+		http.Error(w, "Failed to list departments", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Departments listed", zap.Int("count", len(depts)))
+	s.writeJSON(w, http.StatusOK, depts)
+}
+
+func (s *Server) handleUpdateDepartment(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var dept models.Department
+	if err := json.NewDecoder(r.Body).Decode(&dept); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if dept.Name == "" {
+		http.Error(w, "Name is required", http.StatusBadRequest)
+		s.logger.Error("Name is required")
+		return
+	}
+
+	dept.ID = id
+	if err := s.db.UpdateDepartment(&dept); err != nil {
+		s.logger.Error("Department update failed", zap.Error(err))
+		http.Error(w, "Department not found", http.StatusNotFound)
+		return
+	}
+
+	s.logger.Info("Department updated", zap.Any("department", dept))
+	s.writeJSON(w, http.StatusOK, dept)
+}
+
+func (s *Server) handleDeleteDepartment(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := s.db.DeleteDepartment(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Department deletion failed", zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Department deleted", zap.Int("departmentId", id))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestHandleUpdateEmployeeReportingLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		update     models.Employee
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Valid Manager",
+			id:     "3",
+			update: models.Employee{FirstName: "Ann", LastName: "Lee", Email: "ann@example.com", ManagerID: intPtr(2)},
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "2").Return(&models.Employee{ID: 2}, nil)
+				db.On("GetReportingChain", 2).Return([]models.Employee{{ID: 1}}, nil)
+				db.On("UpdateEmployee", mock.AnythingOfType("*models.Employee")).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Self Managed",
+			id:         "3",
+			update:     models.Employee{ManagerID: intPtr(3)},
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Reporting Cycle",
+			id:     "1",
+			update: models.Employee{ManagerID: intPtr(3)},
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				db.On("GetReportingChain", 3).Return([]models.Employee{{ID: 2}, {ID: 1}}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Unknown Manager",
+			id:     "3",
+			update: models.Employee{ManagerID: intPtr(99)},
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "99").Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Unknown Department",
+			id:     "3",
+			update: models.Employee{DepartmentID: intPtr(7)},
+			setupMock: func(db *mocks.Database) {
+				db.On("GetDepartment", 7).Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			payload, err := json.Marshal(tt.update)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("PUT", "/employee?id="+tt.id, bytes.NewBuffer(payload))
+			rr := httptest.NewRecorder()
+
+			server.handleUpdateEmployee(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleHierarchyQueries(t *testing.T) {
+	reports := []models.Employee{{ID: 2, ManagerID: intPtr(1)}, {ID: 3, ManagerID: intPtr(1)}}
+
+	tests := []struct {
+		name       string
+		handler    func(*Server) http.HandlerFunc
+		id         string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+		wantLen    int
+	}{
+		{
+			name:    "Direct Reports",
+			handler: func(s *Server) http.HandlerFunc { return s.handleDirectReports },
+			id:      "1",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetDirectReports", 1).Return(reports, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantLen:    2,
+		},
+		{
+			name:    "Reporting Chain",
+			handler: func(s *Server) http.HandlerFunc { return s.handleReportingChain },
+			id:      "3",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				db.On("GetReportingChain", 3).Return([]models.Employee{{ID: 1}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantLen:    1,
+		},
+		{
+			name:    "Reporting Chain Unknown Employee",
+			handler: func(s *Server) http.HandlerFunc { return s.handleReportingChain },
+			id:      "9",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "9").Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:    "Subordinates",
+			handler: func(s *Server) http.HandlerFunc { return s.handleSubordinates },
+			id:      "1",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetSubordinates", 1).Return(append(reports, models.Employee{ID: 4, ManagerID: intPtr(2)}), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantLen:    3,
+		},
+		{
+			name:       "Invalid ID",
+			handler:    func(s *Server) http.HandlerFunc { return s.handleSubordinates },
+			id:         "abc",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/employee/reports?id="+tt.id, nil)
+			rr := httptest.NewRecorder()
+
+			tt.handler(server)(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got []models.Employee
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Len(t, got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestHandleDepartment(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		query      string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Create",
+			method: "POST",
+			body:   `{"name":"Engineering"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("CreateDepartment", mock.AnythingOfType("*models.Department")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Create Missing Name",
+			method:     "POST",
+			body:       `{}`,
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "List",
+			method: "GET",
+			setupMock: func(db *mocks.Database) {
+				db.On("ListDepartments").Return([]models.Department{{ID: 1, Name: "Engineering"}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Get Missing",
+			method: "GET",
+			query:  "?id=5",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetDepartment", 5).Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:   "Update",
+			method: "PUT",
+			query:  "?id=1",
+			body:   `{"name":"R&D"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("UpdateDepartment", mock.AnythingOfType("*models.Department")).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Delete",
+			method: "DELETE",
+			query:  "?id=1",
+			setupMock: func(db *mocks.Database) {
+				db.On("DeleteDepartment", 1).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, "/department"+tt.query, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleDepartment(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"employees/internal/models"
+	"employees/internal/orgchart"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// orgChartStatuses are the statuses of the employees on staff, who are the
+// ones shown in the org chart.
+var orgChartStatuses = []models.EmployeeStatus{
+	models.EmployeeStatusOnboarding,
+	models.EmployeeStatusActive,
+	models.EmployeeStatusOnLeave,
+}
+
+// handleOrgChart exports the reporting lines of the employees on staff as
+// ?format=json (default), dot or svg, optionally limited to ?departmentId=,
+// the subtree under ?rootId= and ?depth= levels below the top of the chart.
+// Anyone whose manager is left out is shown under their nearest manager who
+// is in the chart.
+func (s *Server) handleOrgChart(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	var rootID, departmentID *int
+	if query.Get("rootId") != "" {
+		id, ok := s.queryID(w, r, "rootId")
+		if !ok {
+			return
+		}
+		rootID = &id
+	}
+	if query.Get("departmentId") != "" {
+		id, ok := s.queryID(w, r, "departmentId")
+		if !ok {
+			return
+		}
+		departmentID = &id
+	}
+
+	depth := 0
+	if d := query.Get("depth"); d != "" {
+		intDepth, ok := validateId(d)
+		if !ok || intDepth < 0 {
+			http.Error(w, "Invalid depth", http.StatusBadRequest)
+			s.logger.Error("Invalid depth", zap.String("depth", d))
+			return
+		}
+		depth = intDepth
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "dot" && format != "svg" {
+		http.Error(w, "Invalid format", http.StatusBadRequest)
+		s.logger.Error("Invalid format", zap.String("format", format))
+		return
+	}
+
+	var emps []models.Employee
+	if rootID != nil {
+		root, err := s.db.GetEmployee(strconv.Itoa(*rootID))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Employee not found", zap.Error(err))
+			return
+		}
+		subs, err := s.db.GetSubordinates(*rootID)
+		if err != nil {
+			s.logger.Error("Failed to get subordinates", zap.Error(err))
+			http.Error(w, "Failed to build org chart", http.StatusInternalServerError)
+			return
+		}
+		emps = append([]models.Employee{*root}, subs...)
+	} else {
+		var err error
+		emps, err = s.db.ListEmployees(models.EmployeeFilter{})
+		if err != nil {
+			s.logger.Error("Failed to list employees", zap.Error(err))
+			http.Error(w, "Failed to build org chart", http.StatusInternalServerError)
+			return
+		}
+	}
+	// Everyone is listed so that people under someone left out can still be
+	// placed under the next manager up.
+	emps = orgchart.Filter(emps, func(emp models.Employee) bool {
+		if rootID != nil && emp.ID == *rootID {
+			return true
+		}
+		return slices.Contains(orgChartStatuses, emp.Status) &&
+			(departmentID == nil || (emp.DepartmentID != nil && *emp.DepartmentID == *departmentID))
+	})
+
+	forest := orgchart.Build(emps, rootID, depth)
+	s.logger.Info("Org chart exported", zap.String("format", format), zap.Int("employees", len(emps)))
+
+	switch format {
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(orgchart.DOT(forest)))
+	case "svg":
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(orgchart.SVG(forest)))
+	default:
+		s.writeJSON(w, http.StatusOK, forest)
+	}
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleOrgChart(t *testing.T) {
+	staff := []models.Employee{
+		{ID: 1, FirstName: "Ada", LastName: "Boss", DepartmentID: intPtr(1), Status: models.EmployeeStatusActive},
+		{ID: 2, FirstName: "Bob", LastName: "Lead", DepartmentID: intPtr(1), ManagerID: intPtr(1),
+			Status: models.EmployeeStatusActive},
+	}
+	sales := models.Employee{ID: 3, FirstName: "Cy", LastName: "Sales", DepartmentID: intPtr(2), ManagerID: intPtr(2),
+		Status: models.EmployeeStatusActive}
+	dev := models.Employee{ID: 4, FirstName: "Di", LastName: "Dev", DepartmentID: intPtr(1), ManagerID: intPtr(3),
+		Status: models.EmployeeStatusOnLeave}
+	leaver := models.Employee{ID: 5, FirstName: "Ed", LastName: "Gone", DepartmentID: intPtr(1), ManagerID: intPtr(2),
+		Status: models.EmployeeStatusTerminated}
+	hire := models.Employee{ID: 6, FirstName: "Flo", LastName: "New", DepartmentID: intPtr(1), ManagerID: intPtr(2),
+		Status: models.EmployeeStatusCandidate}
+
+	tests := []struct {
+		name            string
+		query           string
+		setupMock       func(*mocks.Database)
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+		notWantBody     string
+	}{
+		{
+			name:  "JSON Default",
+			query: "",
+			setupMock: func(db *mocks.Database) {
+				db.On("ListEmployees", models.EmployeeFilter{}).Return(staff, nil)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `"children":[{"id":2`,
+		},
+		{
+			name:  "DOT By Department",
+			query: "?format=dot&departmentId=1",
+			setupMock: func(db *mocks.Database) {
+				db.On("ListEmployees", models.EmployeeFilter{}).Return(append(staff, sales, dev, leaver, hire), nil)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/vnd.graphviz; charset=utf-8",
+			wantBody:        `"2" -> "4";`,
+			notWantBody:     `"3"`,
+		},
+		{
+			name:  "Staff Only",
+			query: "?format=dot",
+			setupMock: func(db *mocks.Database) {
+				db.On("ListEmployees", models.EmployeeFilter{}).Return(append(staff, leaver, hire), nil)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/vnd.graphviz; charset=utf-8",
+			wantBody:        `"1" -> "2";`,
+			notWantBody:     `"5"`,
+		},
+		{
+			name:  "SVG From Root",
+			query: "?format=svg&rootId=1&depth=1",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&staff[0], nil)
+				db.On("GetSubordinates", 1).Return(staff[1:], nil)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "image/svg+xml",
+			wantBody:        `<g id="employee-2">`,
+		},
+		{
+			name:  "Department From Root",
+			query: "?format=dot&rootId=1&departmentId=1",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&staff[0], nil)
+				db.On("GetSubordinates", 1).Return([]models.Employee{staff[1], sales, dev, leaver, hire}, nil)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/vnd.graphviz; charset=utf-8",
+			wantBody:        `"2" -> "4";`,
+			notWantBody:     `"6"`,
+		},
+		{
+			name:  "Unknown Root",
+			query: "?rootId=9",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "9").Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Invalid Format",
+			query:      "?format=png",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid Depth",
+			query:      "?depth=-1",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/orgchart"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			server.handleOrgChart(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantContentType, rr.Header().Get("Content-Type"))
+				assert.Contains(t, rr.Body.String(), tt.wantBody)
+				if tt.notWantBody != "" {
+					assert.NotContains(t, rr.Body.String(), tt.notWantBody)
+				}
+			}
+		})
+	}
+}
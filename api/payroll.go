@@ -0,0 +1,299 @@
+package api
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"employees/internal/payroll"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ruleSetName       = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	errUnknownRuleSet = errors.New("unknown rule set")
+)
+
+// payrollStatuses are the employee statuses included in payroll runs.
+var payrollStatuses = []models.EmployeeStatus{
+	models.EmployeeStatusOnboarding, models.EmployeeStatusActive, models.EmployeeStatusOnLeave,
+}
+
+// loadRuleSet reads <name>.json from the payroll rules directory.
+func (s *Server) loadRuleSet(name string) (*payroll.RuleSet, error) {
+	if !ruleSetName.MatchString(name) {
+		return nil, errUnknownRuleSet
+	}
+	rules, err := payroll.LoadRuleSet(filepath.Join(s.payrollRulesDir, name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %v", errUnknownRuleSet, name, err)
+	}
+	return rules, nil
+}
+
+// calculatePayroll fills in the run's payslips from each employee on the
+// payroll. Employees without compensation in effect at the end of the
+// period are listed in Skipped.
+func (s *Server) calculatePayroll(run *models.PayrollRun) error {
+	rules, err := s.loadRuleSet(run.RuleSet)
+	if err != nil {
+		return err
+	}
+	run.RuleSetSource = rules.Source
+
+	emps, err := s.db.ListEmployees(models.EmployeeFilter{DepartmentID: run.DepartmentID, Statuses: payrollStatuses})
+	if err != nil {
+		return err
+	}
+
+	run.Payslips = []models.Payslip{}
+	run.Skipped = nil
+	for _, emp := range emps {
+		comp, err := s.db.GetCompensationAsOf(emp.ID, run.PeriodEnd)
+		if err != nil {
+			run.Skipped = append(run.Skipped, emp.ID)
+			continue
+		}
+		bonuses, err := s.db.ListBonuses(emp.ID)
+		if err != nil {
+			return err
+		}
+		slip, err := payroll.Calculate(emp, *comp, bonuses, *run, rules)
+		if err != nil {
+			return err
+		}
+		run.Payslips = append(run.Payslips, slip)
+	}
+	return nil
+}
+
+// writePayrollError maps calculation failures caused by the request to 400.
+func (s *Server) writePayrollError(w http.ResponseWriter, err error) {
+	s.logger.Error("Payroll calculation failed", zap.Error(err))
+	if errors.Is(err, errUnknownRuleSet) || errors.Is(err, payroll.ErrCurrencyMismatch) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.Error(w, "Failed to calculate payroll", http.StatusInternalServerError)
+}
+
+func (s *Server) handlePayrollRuns(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		s.handleCreatePayrollRun(w, r)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			runs, err := s.db.ListPayrollRuns()
+			if err != nil {
+				s.logger.Error("Failed to list payroll runs", zap.Error(err))
+				http.Error(w, "Failed to list payroll runs", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, runs)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		run, err := s.db.GetPayrollRun(id)
+		if err != nil {
+			http.Error(w, "Payroll run not found", http.StatusNotFound)
+			s.logger.Error("Payroll run not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, run)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeletePayrollRun(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Locked payroll runs cannot be deleted", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to delete payroll run", http.StatusInternalServerError)
+			}
+			s.logger.Error("Payroll run deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Payroll run deleted", zap.Int("runId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleCreatePayrollRun(w http.ResponseWriter, r *http.Request) {
+	var run models.PayrollRun
+	if err := json.NewDecoder(r.Body).Decode(&run); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := payroll.ValidateRun(run); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid payroll run", zap.Error(err))
+		return
+	}
+
+	run.ID = 0
+	run.Status = models.PayrollRunStatusDraft
+	run.CreatedByAdminID = adminID(r)
+	run.LockedAt, run.LockedByAdminID = nil, nil
+	if err := s.calculatePayroll(&run); err != nil {
+		s.writePayrollError(w, err)
+		return
+	}
+
+	if err := s.db.CreatePayrollRun(&run); err != nil {
+		s.logger.Error("Payroll run creation failed", zap.Error(err))
+		http.Error(w, "Failed to create payroll run", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Payroll run created", zap.Int("runId", run.ID), zap.Int("payslips", len(run.Payslips)))
+	s.writeJSON(w, http.StatusCreated, run)
+}
+
+// handleRecalculatePayrollRun recomputes a draft run against current
+// compensation and, with ?ruleSet=, a different rule set.
+func (s *Server) handleRecalculatePayrollRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	run, err := s.db.GetPayrollRun(id)
+	if err != nil {
+		http.Error(w, "Payroll run not found", http.StatusNotFound)
+		s.logger.Error("Payroll run not found", zap.Error(err))
+		return
+	}
+	if run.Status != models.PayrollRunStatusDraft {
+		http.Error(w, "Payroll run is locked", http.StatusConflict)
+		s.logger.Error("Payroll run is locked", zap.Int("runId", id))
+		return
+	}
+	if name := r.URL.Query().Get("ruleSet"); name != "" {
+		run.RuleSet = name
+	}
+
+	if err := s.calculatePayroll(run); err != nil {
+		s.writePayrollError(w, err)
+		return
+	}
+
+	if err := s.db.RecalculatePayrollRun(run); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Payroll run is locked", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to recalculate payroll run", http.StatusInternalServerError)
+		}
+		s.logger.Error("Payroll run recalculation failed", zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Payroll run recalculated", zap.Int("runId", run.ID), zap.Int("payslips", len(run.Payslips)))
+	s.writeJSON(w, http.StatusOK, run)
+}
+
+func (s *Server) handleLockPayrollRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := s.db.LockPayrollRun(id, adminID(r)); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Payroll run is already locked", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to lock payroll run", http.StatusInternalServerError)
+		}
+		s.logger.Error("Payroll run lock failed", zap.Error(err))
+		return
+	}
+
+	run, err := s.db.GetPayrollRun(id)
+	if err != nil {
+		http.Error(w, "Payroll run not found", http.StatusNotFound)
+		s.logger.Error("Payroll run not found", zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Payroll run locked", zap.Int("runId", id), zap.Any("adminId", run.LockedByAdminID))
+	s.writeJSON(w, http.StatusOK, run)
+}
+
+// handlePayslip returns one employee's payslip from a run as JSON or, with
+// ?format=pdf, as a PDF document.
+func (s *Server) handlePayslip(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionCompensation) {
+		return
+	}
+
+	runID, ok := s.queryID(w, r, "runId")
+	if !ok {
+		return
+	}
+	employeeID, ok := s.queryID(w, r, "employeeId")
+	if !ok {
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "pdf" {
+		http.Error(w, "format must be json or pdf", http.StatusBadRequest)
+		s.logger.Error("Invalid payslip format", zap.String("format", format))
+		return
+	}
+
+	run, err := s.db.GetPayrollRun(runID)
+	if err != nil {
+		http.Error(w, "Payroll run not found", http.StatusNotFound)
+		s.logger.Error("Payroll run not found", zap.Error(err))
+		return
+	}
+	slip, err := s.db.GetPayslip(runID, employeeID)
+	if err != nil {
+		http.Error(w, "Payslip not found", http.StatusNotFound)
+		s.logger.Error("Payslip not found", zap.Error(err))
+		return
+	}
+
+	if format != "pdf" {
+		s.writeJSON(w, http.StatusOK, slip)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="payslip-%d-%d.pdf"`, runID, employeeID))
+	if err := payroll.PayslipPDF(w, *run, *slip); err != nil {
+		s.logger.Error("Failed to write payslip PDF", zap.Error(err))
+	}
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var payrollAdmin = &models.Admin{ID: 1, Permissions: []string{models.PermissionCompensation}}
+
+func TestHandleCreatePayrollRun(t *testing.T) {
+	periodEnd := models.NewDate(2025, time.June, 30)
+
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+		wantSlips  int
+	}{
+		{
+			name: "Success",
+			body: `{"periodStart":"2025-06-01","periodEnd":"2025-06-30","payFrequency":"monthly","currency":"EUR","ruleSet":"flat"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("ListEmployees", models.EmployeeFilter{Statuses: payrollStatuses}).Return([]models.Employee{{ID: 1}, {ID: 2}}, nil)
+				m.On("GetCompensationAsOf", 1, periodEnd).Return(&models.Compensation{
+					BasePay: 400000, Currency: "EUR", PayFrequency: models.PayFrequencyMonthly}, nil)
+				m.On("GetCompensationAsOf", 2, periodEnd).Return(nil, errors.New("record not found"))
+				m.On("ListBonuses", 1).Return([]models.Bonus{}, nil)
+				m.On("CreatePayrollRun", mock.MatchedBy(func(run *models.PayrollRun) bool {
+					return run.Status == models.PayrollRunStatusDraft && run.Payslips[0].Net == 300000 &&
+						strings.Contains(run.RuleSetSource, "Flat tax")
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantSlips:  1,
+		},
+		{
+			name:       "Unknown Rule Set",
+			body:       `{"periodStart":"2025-06-01","periodEnd":"2025-06-30","payFrequency":"monthly","currency":"EUR","ruleSet":"../flat"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Hourly Run",
+			body:       `{"periodStart":"2025-06-01","periodEnd":"2025-06-30","payFrequency":"hourly","currency":"EUR","ruleSet":"flat"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Currency Mismatch",
+			body: `{"periodStart":"2025-06-01","periodEnd":"2025-06-30","payFrequency":"monthly","currency":"EUR","ruleSet":"flat"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("ListEmployees", models.EmployeeFilter{Statuses: payrollStatuses}).Return([]models.Employee{{ID: 1}}, nil)
+				m.On("GetCompensationAsOf", 1, periodEnd).Return(&models.Compensation{
+					BasePay: 400000, Currency: "USD", PayFrequency: models.PayFrequencyMonthly}, nil)
+				m.On("ListBonuses", 1).Return([]models.Bonus{}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			server.payrollRulesDir = "../internal/payroll/testdata"
+			mockDB.On("GetAdminByID", 1).Return(payrollAdmin, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/payroll/runs", bytes.NewBufferString(tt.body))
+			authorize(t, req, 1)
+			rr := httptest.NewRecorder()
+
+			server.handlePayrollRuns(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var got models.PayrollRun
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Len(t, got.Payslips, tt.wantSlips)
+				assert.Equal(t, []int{2}, got.Skipped)
+			}
+		})
+	}
+}
+
+func TestHandlePayrollRunsForbidden(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 2).Return(&models.Admin{ID: 2}, nil)
+
+	req := httptest.NewRequest("GET", "/payroll/runs", nil)
+	authorize(t, req, 2)
+	rr := httptest.NewRecorder()
+
+	server.handlePayrollRuns(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestHandleLockPayrollRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		lockErr    error
+		wantStatus int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"Already Locked", db.ErrConflict, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", 1).Return(payrollAdmin, nil)
+			mockDB.On("LockPayrollRun", 4, intPtr(1)).Return(tt.lockErr)
+			if tt.lockErr == nil {
+				mockDB.On("GetPayrollRun", 4).Return(&models.PayrollRun{ID: 4, Status: models.PayrollRunStatusLocked}, nil)
+			}
+
+			req := httptest.NewRequest("POST", "/payroll/runs/lock?id=4", nil)
+			authorize(t, req, 1)
+			rr := httptest.NewRecorder()
+
+			server.handleLockPayrollRun(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandlePayslipPDF(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(payrollAdmin, nil)
+	mockDB.On("GetPayrollRun", 4).Return(&models.PayrollRun{ID: 4, Currency: "EUR",
+		PeriodStart: models.NewDate(2025, time.June, 1), PeriodEnd: models.NewDate(2025, time.June, 30)}, nil)
+	mockDB.On("GetPayslip", 4, 1).Return(&models.Payslip{RunID: 4, EmployeeID: 1, Currency: "EUR",
+		BasePay: 400000, Gross: 400000, Net: 400000}, nil)
+
+	req := httptest.NewRequest("GET", "/payroll/payslip?runId=4&employeeId=1&format=pdf", nil)
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+
+	server.handlePayslip(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/pdf", rr.Header().Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(rr.Body.String(), "%PDF-"))
+}
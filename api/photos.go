@@ -0,0 +1,298 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"employees/internal/blob"
+	"employees/internal/models"
+	"employees/internal/photo"
+	"employees/internal/signedurl"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	maxPhotoSize     = 5 << 20
+	defaultPhotoSize = "medium"
+)
+
+func photoKey(employeeID int, version, size string) string {
+	return fmt.Sprintf("photos/%d/%s/%s", employeeID, version, size)
+}
+
+// photoLinkTTL is how long a signed photo link lasts at least. Links are
+// signed to expire at the end of the following window, so one employee's
+// link stays the same for a whole window and can be cached.
+const photoLinkTTL = 24 * time.Hour
+
+func photoResource(employeeID int, version string) string {
+	return fmt.Sprintf("photo/%d/%s", employeeID, version)
+}
+
+// photoURL is where a version of the employee's photo is stored as being
+// served. The version changes with every upload, so clients can cache a
+// URL forever.
+func photoURL(employeeID int, version string) string {
+	return fmt.Sprintf("/employee/photo?id=%d&v=%s", employeeID, version)
+}
+
+// signPhoto replaces the employee's stored photo URL with a signed link to
+// the same version, which can be used as an image source without a login
+// token until it expires.
+func (s *Server) signPhoto(emp *models.Employee) {
+	version := photoVersion(emp.PhotoURL)
+	if version == "" {
+		return
+	}
+	expires := time.Now().Truncate(photoLinkTTL).Add(2 * photoLinkTTL)
+	params := s.signer.Sign(photoResource(emp.ID, version), expires)
+	params.Set("id", strconv.Itoa(emp.ID))
+	params.Set("v", version)
+	emp.PhotoURL = "/photos/signed?" + params.Encode()
+}
+
+// signPhotos calls signPhoto for each of emps.
+func (s *Server) signPhotos(emps []models.Employee) {
+	for i := range emps {
+		s.signPhoto(&emps[i])
+	}
+}
+
+// photoVersion extracts the version from a URL built by photoURL or
+// signPhoto.
+func photoVersion(photoURL string) string {
+	if photoURL == "" {
+		return ""
+	}
+	u, err := url.Parse(photoURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("v")
+}
+
+func (s *Server) handlePhoto(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		s.handleUploadPhoto(w, r)
+	case "GET":
+		s.handleGetPhoto(w, r)
+	case "DELETE":
+		s.handleDeletePhoto(w, r)
+	}
+}
+
+func (s *Server) deletePhotoVersion(employeeID int, version string) {
+	for size := range photo.Sizes {
+		if err := s.blobs.Delete(photoKey(employeeID, version, size)); err != nil {
+			s.logger.Error("Failed to delete photo", zap.Int("employeeId", employeeID), zap.String("size", size), zap.Error(err))
+		}
+	}
+}
+
+// handleUploadPhoto replaces the employee's photo with the "file" part of
+// a multipart form. The upload is re-encoded into each thumbnail size, which
+// strips EXIF and other metadata such as GPS coordinates.
+func (s *Server) handleUploadPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxPhotoSize+1<<20)
+	if err := r.ParseMultipartForm(maxPhotoSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "Photo is too large", http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		}
+		s.logger.Error("Invalid photo upload", zap.Error(err))
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		s.logger.Error("file is required", zap.Error(err))
+		return
+	}
+	defer file.Close()
+	if header.Size > maxPhotoSize {
+		http.Error(w, "Photo is too large", http.StatusRequestEntityTooLarge)
+		s.logger.Error("Photo is too large", zap.Int64("size", header.Size))
+		return
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		s.logger.Error("Failed to read photo", zap.Error(err))
+		http.Error(w, "Failed to read photo", http.StatusBadRequest)
+		return
+	}
+
+	emp, err := s.db.GetEmployee(strconv.Itoa(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	images, err := photo.Process(data)
+	if err != nil {
+		switch {
+		case errors.Is(err, photo.ErrUnsupportedType):
+			http.Error(w, photo.ErrUnsupportedType.Error(), http.StatusUnsupportedMediaType)
+		case errors.Is(err, photo.ErrTooLarge):
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		default:
+			http.Error(w, "Failed to process photo", http.StatusInternalServerError)
+		}
+		s.logger.Error("Failed to process photo", zap.Int("employeeId", id), zap.Error(err))
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	version := hex.EncodeToString(sum[:8])
+	previous := photoVersion(emp.PhotoURL)
+	for size, img := range images {
+		if err := s.blobs.Put(photoKey(id, version, size), bytes.NewReader(img.Data)); err != nil {
+			s.logger.Error("Failed to store photo", zap.Error(err))
+			http.Error(w, "Failed to store photo", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	emp.PhotoURL = photoURL(id, version)
+	if err := s.db.SetEmployeePhoto(id, emp.PhotoURL); err != nil {
+		s.logger.Error("Failed to update photo", zap.Error(err))
+		if version != previous {
+			s.deletePhotoVersion(id, version)
+		}
+		http.Error(w, "Failed to store photo", http.StatusInternalServerError)
+		return
+	}
+	if previous != "" && previous != version {
+		s.deletePhotoVersion(id, previous)
+	}
+
+	s.logger.Info("Photo uploaded", zap.Int("employeeId", id), zap.String("version", version))
+	s.signPhoto(emp)
+	s.writeJSON(w, http.StatusOK, emp)
+}
+
+// handleGetPhoto serves a thumbnail of the employee's photo to a logged-in
+// caller; see servePhoto.
+func (s *Server) handleGetPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	s.servePhoto(w, r, id)
+}
+
+// handleSignedPhoto serves a thumbnail to anyone holding an unexpired link
+// from signPhoto; see servePhoto.
+func (s *Server) handleSignedPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	version := r.URL.Query().Get("v")
+	if err := s.signer.Verify(photoResource(id, version), r.URL.Query(), time.Now()); err != nil {
+		status := http.StatusForbidden
+		if errors.Is(err, signedurl.ErrExpired) {
+			status = http.StatusGone
+		}
+		http.Error(w, err.Error(), status)
+		s.logger.Error("Rejected photo link", zap.Int("employeeId", id), zap.Error(err))
+		return
+	}
+	s.servePhoto(w, r, id)
+}
+
+// servePhoto serves a thumbnail, ?size= small, medium (default) or large.
+// A request naming the current ?v= may be cached indefinitely; any other
+// must be revalidated, which the ETag makes cheap.
+func (s *Server) servePhoto(w http.ResponseWriter, r *http.Request, id int) {
+	size := r.URL.Query().Get("size")
+	if size == "" {
+		size = defaultPhotoSize
+	}
+	if _, ok := photo.Sizes[size]; !ok {
+		http.Error(w, "size must be small, medium or large", http.StatusBadRequest)
+		s.logger.Error("Invalid photo size", zap.String("size", size))
+		return
+	}
+
+	emp, err := s.db.GetEmployee(strconv.Itoa(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	version := photoVersion(emp.PhotoURL)
+	if version == "" {
+		http.Error(w, "Employee has no photo", http.StatusNotFound)
+		return
+	}
+
+	contents, err := s.blobs.Get(photoKey(id, version, size))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, blob.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, "Photo unavailable", status)
+		s.logger.Error("Failed to read photo", zap.Int("employeeId", id), zap.Error(err))
+		return
+	}
+	defer contents.Close()
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		http.Error(w, "Photo unavailable", http.StatusInternalServerError)
+		s.logger.Error("Failed to read photo", zap.Int("employeeId", id), zap.Error(err))
+		return
+	}
+
+	if r.URL.Query().Get("v") == version {
+		w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	} else {
+		w.Header().Set("Cache-Control", "private, no-cache")
+	}
+	w.Header().Set("Content-Type", http.DetectContentType(data))
+	w.Header().Set("ETag", `"`+version+"-"+size+`"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+}
+
+func (s *Server) handleDeletePhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	emp, err := s.db.GetEmployee(strconv.Itoa(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if err := s.db.SetEmployeePhoto(id, ""); err != nil {
+		s.logger.Error("Failed to remove photo", zap.Error(err))
+		http.Error(w, "Failed to remove photo", http.StatusInternalServerError)
+		return
+	}
+	if version := photoVersion(emp.PhotoURL); version != "" {
+		s.deletePhotoVersion(id, version)
+	}
+	s.logger.Info("Photo removed", zap.Int("employeeId", id))
+	w.WriteHeader(http.StatusNoContent)
+}
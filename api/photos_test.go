@@ -0,0 +1,245 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/blob"
+	"employees/internal/models"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testPNG(t *testing.T) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 30))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	img.Set(0, 0, color.Black)
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestHandleUploadPhoto(t *testing.T) {
+	tests := []struct {
+		name       string
+		contents   []byte
+		wantStatus int
+	}{
+		{
+			name:       "PNG",
+			contents:   testPNG(t),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "PDF Rejected",
+			contents:   testPDF,
+			wantStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "Too Large",
+			contents:   make([]byte, maxPhotoSize+1),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			server.blobs = blob.NewLocalStore(t.TempDir())
+			require.NoError(t, server.blobs.Put(photoKey(7, "old", "small"), bytes.NewReader([]byte("x"))))
+			if tt.wantStatus != http.StatusRequestEntityTooLarge {
+				mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7, PhotoURL: photoURL(7, "old")}, nil)
+			}
+			if tt.wantStatus == http.StatusOK {
+				mockDB.On("SetEmployeePhoto", 7, mock.AnythingOfType("string")).Return(nil)
+			}
+
+			body, contentType := multipartUpload(t, nil, "me.png", tt.contents)
+			req := httptest.NewRequest("POST", "/employee/photo?id=7", body)
+			req.Header.Set("Content-Type", contentType)
+			rr := httptest.NewRecorder()
+
+			server.handlePhoto(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var emp models.Employee
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&emp))
+			version := photoVersion(emp.PhotoURL)
+			require.NotEmpty(t, version)
+			assert.NotEqual(t, "old", version)
+			mockDB.AssertCalled(t, "SetEmployeePhoto", 7, photoURL(7, version))
+			assert.True(t, strings.HasPrefix(emp.PhotoURL, "/photos/signed?"), emp.PhotoURL)
+
+			for _, size := range []string{"small", "medium", "large"} {
+				contents, err := server.blobs.Get(photoKey(7, version, size))
+				require.NoError(t, err, size)
+				contents.Close()
+			}
+			_, err := server.blobs.Get(photoKey(7, "old", "small"))
+			assert.ErrorIs(t, err, blob.ErrNotFound)
+		})
+	}
+}
+
+func TestHandleGetPhoto(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	server.blobs = blob.NewLocalStore(t.TempDir())
+	thumb := testPNG(t)
+	require.NoError(t, server.blobs.Put(photoKey(7, "abc", "small"), bytes.NewReader(thumb)))
+	mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7, PhotoURL: photoURL(7, "abc")}, nil)
+	mockDB.On("GetEmployee", "8").Return(&models.Employee{ID: 8}, nil)
+
+	tests := []struct {
+		name         string
+		url          string
+		ifNoneMatch  string
+		wantStatus   int
+		wantCache    string
+		wantContents bool
+	}{
+		{
+			name:         "Current Version",
+			url:          "/employee/photo?id=7&v=abc&size=small",
+			wantStatus:   http.StatusOK,
+			wantCache:    "private, max-age=31536000, immutable",
+			wantContents: true,
+		},
+		{
+			name:         "Stale Version",
+			url:          "/employee/photo?id=7&v=old&size=small",
+			wantStatus:   http.StatusOK,
+			wantCache:    "private, no-cache",
+			wantContents: true,
+		},
+		{
+			name:        "Not Modified",
+			url:         "/employee/photo?id=7&size=small",
+			ifNoneMatch: `"abc-small"`,
+			wantStatus:  http.StatusNotModified,
+			wantCache:   "private, no-cache",
+		},
+		{
+			name:       "Unknown Size",
+			url:        "/employee/photo?id=7&size=huge",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "No Photo",
+			url:        "/employee/photo?id=8",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.url, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			rr := httptest.NewRecorder()
+
+			server.handlePhoto(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCache != "" {
+				assert.Equal(t, tt.wantCache, rr.Header().Get("Cache-Control"))
+			}
+			if tt.wantContents {
+				assert.Equal(t, "image/png", rr.Header().Get("Content-Type"))
+				assert.Equal(t, thumb, rr.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestHandleSignedPhoto(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	server.blobs = blob.NewLocalStore(t.TempDir())
+	thumb := testPNG(t)
+	require.NoError(t, server.blobs.Put(photoKey(7, "abc", "medium"), bytes.NewReader(thumb)))
+	emp := models.Employee{ID: 7, PhotoURL: photoURL(7, "abc")}
+	server.signPhoto(&emp)
+	link := emp.PhotoURL
+
+	t.Run("Valid Link", func(t *testing.T) {
+		mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7, PhotoURL: photoURL(7, "abc")}, nil).Once()
+		rr := httptest.NewRecorder()
+
+		server.handleSignedPhoto(rr, httptest.NewRequest("GET", link, nil))
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, "private, max-age=31536000, immutable", rr.Header().Get("Cache-Control"))
+		assert.Equal(t, thumb, rr.Body.Bytes())
+	})
+
+	t.Run("Other Employee", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		server.handleSignedPhoto(rr, httptest.NewRequest("GET", strings.Replace(link, "id=7", "id=8", 1), nil))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		server.handleSignedPhoto(rr, httptest.NewRequest("GET", "/photos/signed?id=7&v=abc", nil))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		params := server.signer.Sign(photoResource(7, "abc"), time.Now().Add(-time.Minute))
+		params.Set("id", "7")
+		params.Set("v", "abc")
+		rr := httptest.NewRecorder()
+
+		server.handleSignedPhoto(rr, httptest.NewRequest("GET", "/photos/signed?"+params.Encode(), nil))
+
+		assert.Equal(t, http.StatusGone, rr.Code)
+	})
+}
+
+func TestHandleDeletePhoto(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	server.blobs = blob.NewLocalStore(t.TempDir())
+	require.NoError(t, server.blobs.Put(photoKey(7, "abc", "medium"), bytes.NewReader([]byte("x"))))
+	mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7, PhotoURL: photoURL(7, "abc")}, nil)
+	mockDB.On("SetEmployeePhoto", 7, "").Return(nil)
+
+	rr := httptest.NewRecorder()
+	server.handlePhoto(rr, httptest.NewRequest("DELETE", "/employee/photo?id=7", nil))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	_, err := server.blobs.Get(photoKey(7, "abc", "medium"))
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestGetEmployeeSignsPhoto(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7, PhotoURL: photoURL(7, "abc")}, nil)
+
+	rr := httptest.NewRecorder()
+	server.handleGetEmployee(rr, httptest.NewRequest("GET", "/employee?id=7", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var emp models.Employee
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&emp))
+	assert.True(t, strings.HasPrefix(emp.PhotoURL, "/photos/signed?"), emp.PhotoURL)
+	assert.Equal(t, "abc", photoVersion(emp.PhotoURL))
+}
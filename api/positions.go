@@ -0,0 +1,82 @@
+package api
+
+import (
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) handlePositions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleAddPosition(w, r)
+	case "GET":
+		s.handleGetPositions(w, r)
+	}
+}
+
+func (s *Server) handleGetPositions(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	positions, err := s.db.GetPositions(id)
+	if err != nil {
+		s.logger.Error("Failed to get positions", zap.Error(err))
+		http.Error(w, "Failed to get positions", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Positions retrieved", zap.Int("employeeId", id), zap.Int("count", len(positions)))
+	s.writeJSON(w, http.StatusOK, positions)
+}
+
+// handleAddPosition records a change of job title, department or manager
+// starting on effectiveFrom, which may lie in the past or the future.
+func (s *Server) handleAddPosition(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var pos models.Position
+	if err := json.NewDecoder(r.Body).Decode(&pos); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if pos.EffectiveFrom.IsZero() {
+		http.Error(w, "effectiveFrom is required", http.StatusBadRequest)
+		s.logger.Error("effectiveFrom is required")
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+
+	pos.EmployeeID = id
+	candidate := models.Employee{ID: id}
+	pos.ApplyTo(&candidate)
+	if err := s.validateReferences(&candidate); err != nil {
+		s.logger.Error("Invalid employee references", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.AddPosition(&pos); err != nil {
+		s.logger.Error("Position creation failed", zap.Error(err))
+		http.Error(w, "Failed to add position", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Position added", zap.Any("position", pos))
+	s.writeJSON(w, http.StatusCreated, pos)
+}
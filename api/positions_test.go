@@ -0,0 +1,151 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGetEmployeeAsOf(t *testing.T) {
+	current := func() *models.Employee {
+		return &models.Employee{ID: 1, FirstName: "Bill", JobTitle: "Director", ManagerID: intPtr(9)}
+	}
+
+	tests := []struct {
+		name        string
+		asOf        string
+		setupMock   func(*mocks.Database)
+		wantStatus  int
+		wantTitle   string
+		wantManager *int
+	}{
+		{
+			name: "Past Position",
+			asOf: "2025-03-15",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(current(), nil)
+				db.On("GetPositionAsOf", 1, models.NewDate(2025, time.March, 15)).
+					Return(&models.Position{JobTitle: "Engineer", ManagerID: intPtr(4)}, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantTitle:   "Engineer",
+			wantManager: intPtr(4),
+		},
+		{
+			name: "Before History",
+			asOf: "1999-01-01",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(current(), nil)
+				db.On("GetPositionAsOf", 1, models.NewDate(1999, time.January, 1)).
+					Return(nil, errors.New("not found"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Invalid Date",
+			asOf: "March",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(current(), nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/employee?id=1&asOf="+tt.asOf, nil)
+			rr := httptest.NewRecorder()
+
+			server.handleGetEmployee(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got models.Employee
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Equal(t, tt.wantTitle, got.JobTitle)
+				assert.Equal(t, tt.wantManager, got.ManagerID)
+			}
+		})
+	}
+}
+
+func TestHandlePositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Add Future Position",
+			method: "POST",
+			body:   `{"jobTitle":"Lead","effectiveFrom":"2030-01-01"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				db.On("AddPosition", mock.MatchedBy(func(p *models.Position) bool {
+					return p.EmployeeID == 1 && p.EffectiveFrom == models.NewDate(2030, time.January, 1)
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Missing Effective Date",
+			method:     "POST",
+			body:       `{"jobTitle":"Lead"}`,
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Malformed Date",
+			method:     "POST",
+			body:       `{"effectiveFrom":"01/01/2030"}`,
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Self Managed",
+			method: "POST",
+			body:   `{"managerId":1,"effectiveFrom":"2030-01-01"}`,
+			setupMock: func(db *mocks.Database) {
+				db.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "List History",
+			method: "GET",
+			setupMock: func(db *mocks.Database) {
+				db.On("GetPositions", 1).Return([]models.Position{{ID: 1}, {ID: 2}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest(tt.method, "/employee/positions?id=1", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handlePositions(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
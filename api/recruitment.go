@@ -0,0 +1,498 @@
+package api
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"employees/internal/recruitment"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errRequisitionNotFound = errors.New("requisition not found")
+	errStageNotFound       = errors.New("pipeline stage not found")
+)
+
+func (s *Server) handleRequisitions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		var req models.Requisition
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := recruitment.ValidateRequisition(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid requisition", zap.Error(err))
+			return
+		}
+		if err := s.checkRequisition(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid requisition", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			req.ID = 0
+			if err := s.db.CreateRequisition(&req); err != nil {
+				s.logger.Error("Requisition creation failed", zap.Error(err))
+				http.Error(w, "Failed to create requisition", http.StatusBadRequest)
+				return
+			}
+			s.logger.Info("Requisition created", zap.Any("requisition", req))
+			s.writeJSON(w, http.StatusCreated, req)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		req.ID = id
+		if err := s.db.UpdateRequisition(&req); err != nil {
+			http.Error(w, "Requisition not found", http.StatusNotFound)
+			s.logger.Error("Requisition update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Requisition updated", zap.Any("requisition", req))
+		s.writeJSON(w, http.StatusOK, req)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			reqs, err := s.db.ListRequisitions(models.RequisitionStatus(r.URL.Query().Get("status")))
+			if err != nil {
+				s.logger.Error("Failed to list requisitions", zap.Error(err))
+				http.Error(w, "Failed to list requisitions", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, reqs)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		req, err := s.db.GetRequisition(id)
+		if err != nil {
+			http.Error(w, "Requisition not found", http.StatusNotFound)
+			s.logger.Error("Requisition not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, req)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteRequisition(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Requisition has candidates; cancel it instead", http.StatusConflict)
+			} else {
+				http.Error(w, "Requisition not found", http.StatusNotFound)
+			}
+			s.logger.Error("Requisition deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Requisition deleted", zap.Int("requisitionId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// checkRequisition checks that the requisition's department, location and
+// hiring manager exist.
+func (s *Server) checkRequisition(req *models.Requisition) error {
+	if req.DepartmentID != nil {
+		if _, err := s.db.GetDepartment(*req.DepartmentID); err != nil {
+			return errDepartmentNotFound
+		}
+	}
+	if req.LocationID != nil {
+		if _, err := s.db.GetLocation(*req.LocationID); err != nil {
+			return errLocationNotFound
+		}
+	}
+	if req.HiringManagerID != nil {
+		if _, err := s.db.GetEmployee(strconv.Itoa(*req.HiringManagerID)); err != nil {
+			return errors.New("hiring manager not found")
+		}
+	}
+	return nil
+}
+
+// handlePipelineStages configures the stages candidates move through. A
+// new stage without a position goes after the last one.
+func (s *Server) handlePipelineStages(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		stages, err := s.db.ListPipelineStages()
+		if err != nil {
+			s.logger.Error("Failed to list pipeline stages", zap.Error(err))
+			http.Error(w, "Failed to list pipeline stages", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, stages)
+	case "POST", "PUT":
+		var stage models.PipelineStage
+		if err := json.NewDecoder(r.Body).Decode(&stage); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := recruitment.ValidateStage(&stage); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid pipeline stage", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			stage.ID = 0
+			if stage.Position == 0 {
+				stages, err := s.db.ListPipelineStages()
+				if err != nil {
+					s.logger.Error("Failed to list pipeline stages", zap.Error(err))
+					http.Error(w, "Failed to create pipeline stage", http.StatusInternalServerError)
+					return
+				}
+				stage.Position = 1
+				if len(stages) > 0 {
+					stage.Position = stages[len(stages)-1].Position + 1
+				}
+			}
+			if err := s.db.CreatePipelineStage(&stage); err != nil {
+				s.logger.Error("Pipeline stage creation failed", zap.Error(err))
+				http.Error(w, "Failed to create pipeline stage", http.StatusBadRequest)
+				return
+			}
+			s.logger.Info("Pipeline stage created", zap.Any("stage", stage))
+			s.writeJSON(w, http.StatusCreated, stage)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		stage.ID = id
+		if err := s.db.UpdatePipelineStage(&stage); err != nil {
+			http.Error(w, "Pipeline stage not found", http.StatusNotFound)
+			s.logger.Error("Pipeline stage update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Pipeline stage updated", zap.Any("stage", stage))
+		s.writeJSON(w, http.StatusOK, stage)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeletePipelineStage(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Candidates are still at this stage", http.StatusConflict)
+			} else {
+				http.Error(w, "Pipeline stage not found", http.StatusNotFound)
+			}
+			s.logger.Error("Pipeline stage deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Pipeline stage deleted", zap.Int("stageId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleCandidates manages applicants. GET lists them by ?requisitionId=,
+// ?stageId= and ?status=. New candidates start at the first stage of the
+// pipeline; PUT moves them between stages or rejects them.
+func (s *Server) handleCandidates(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var c models.Candidate
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		c.ID = 0
+		c.Status = models.CandidateStatusActive
+		c.EmployeeID = nil
+		c.StartDate = nil
+		if err := recruitment.ValidateCandidate(&c); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid candidate", zap.Error(err))
+			return
+		}
+
+		req, err := s.db.GetRequisition(c.RequisitionID)
+		if err != nil {
+			http.Error(w, errRequisitionNotFound.Error(), http.StatusBadRequest)
+			s.logger.Error("Requisition not found", zap.Error(err))
+			return
+		}
+		if req.Status != models.RequisitionStatusOpen {
+			http.Error(w, "Requisition is not open", http.StatusConflict)
+			s.logger.Error("Candidate for closed requisition", zap.Int("requisitionId", req.ID))
+			return
+		}
+		if c.StageID == nil {
+			stages, err := s.db.ListPipelineStages()
+			if err != nil {
+				s.logger.Error("Failed to list pipeline stages", zap.Error(err))
+				http.Error(w, "Failed to create candidate", http.StatusInternalServerError)
+				return
+			}
+			if len(stages) > 0 {
+				c.StageID = &stages[0].ID
+			}
+		} else if _, err := s.db.GetPipelineStage(*c.StageID); err != nil {
+			http.Error(w, errStageNotFound.Error(), http.StatusBadRequest)
+			s.logger.Error("Pipeline stage not found", zap.Error(err))
+			return
+		}
+
+		if err := s.db.CreateCandidate(&c); err != nil {
+			s.logger.Error("Candidate creation failed", zap.Error(err))
+			http.Error(w, "Failed to create candidate", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Candidate created", zap.Int("candidateId", c.ID), zap.Int("requisitionId", c.RequisitionID))
+		s.writeJSON(w, http.StatusCreated, c)
+	case "GET":
+		if r.URL.Query().Get("id") != "" {
+			c, ok := s.candidate(w, r)
+			if !ok {
+				return
+			}
+			s.writeJSON(w, http.StatusOK, c)
+			return
+		}
+		filter := models.CandidateFilter{Status: models.CandidateStatus(r.URL.Query().Get("status"))}
+		for name, field := range map[string]**int{
+			"requisitionId": &filter.RequisitionID,
+			"stageId":       &filter.StageID,
+		} {
+			if r.URL.Query().Get(name) == "" {
+				continue
+			}
+			id, ok := s.queryID(w, r, name)
+			if !ok {
+				return
+			}
+			*field = &id
+		}
+		candidates, err := s.db.ListCandidates(filter)
+		if err != nil {
+			s.logger.Error("Failed to list candidates", zap.Error(err))
+			http.Error(w, "Failed to list candidates", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, candidates)
+	case "PUT":
+		existing, ok := s.candidate(w, r)
+		if !ok {
+			return
+		}
+		var c models.Candidate
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		c.ID = existing.ID
+		c.RequisitionID = existing.RequisitionID
+		c.EmployeeID = nil
+		c.StartDate = nil
+		c.CreatedAt = existing.CreatedAt
+		if err := recruitment.ValidateCandidate(&c); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid candidate", zap.Error(err))
+			return
+		}
+		if c.StageID != nil {
+			if _, err := s.db.GetPipelineStage(*c.StageID); err != nil {
+				http.Error(w, errStageNotFound.Error(), http.StatusBadRequest)
+				s.logger.Error("Pipeline stage not found", zap.Error(err))
+				return
+			}
+		}
+		if err := s.db.UpdateCandidate(&c); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Candidate is no longer active", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to update candidate", http.StatusBadRequest)
+			}
+			s.logger.Error("Candidate update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Candidate updated", zap.Int("candidateId", c.ID), zap.String("status", string(c.Status)))
+		s.writeJSON(w, http.StatusOK, c)
+	}
+}
+
+// candidate loads the candidate named by ?id=, writing a 404 when there is
+// none.
+func (s *Server) candidate(w http.ResponseWriter, r *http.Request) (*models.Candidate, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	c, err := s.db.GetCandidate(id)
+	if err != nil {
+		http.Error(w, "Candidate not found", http.StatusNotFound)
+		s.logger.Error("Candidate not found", zap.Error(err))
+		return nil, false
+	}
+	return c, true
+}
+
+// handleInterviewFeedback lists or records feedback on candidate ?id=.
+// Feedback is filed against the candidate's current stage unless it names
+// another.
+func (s *Server) handleInterviewFeedback(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.candidate(w, r)
+	if !ok {
+		return
+	}
+	switch r.Method {
+	case "GET":
+		feedback, err := s.db.ListInterviewFeedback(c.ID)
+		if err != nil {
+			s.logger.Error("Failed to list interview feedback", zap.Error(err))
+			http.Error(w, "Failed to list interview feedback", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, feedback)
+	case "POST":
+		var f models.InterviewFeedback
+		if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		f.ID = 0
+		f.CandidateID = c.ID
+		f.AdminID = adminID(r)
+		if err := recruitment.ValidateFeedback(&f); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid interview feedback", zap.Error(err))
+			return
+		}
+		if f.StageID == nil {
+			f.StageID = c.StageID
+		} else if _, err := s.db.GetPipelineStage(*f.StageID); err != nil {
+			http.Error(w, errStageNotFound.Error(), http.StatusBadRequest)
+			s.logger.Error("Pipeline stage not found", zap.Error(err))
+			return
+		}
+		if f.InterviewerID != nil {
+			if _, err := s.db.GetEmployee(strconv.Itoa(*f.InterviewerID)); err != nil {
+				http.Error(w, "Interviewer not found", http.StatusBadRequest)
+				s.logger.Error("Interviewer not found", zap.Error(err))
+				return
+			}
+		}
+
+		if err := s.db.CreateInterviewFeedback(&f); err != nil {
+			s.logger.Error("Interview feedback creation failed", zap.Error(err))
+			http.Error(w, "Failed to record interview feedback", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Interview feedback recorded", zap.Int("candidateId", c.ID), zap.Int("feedbackId", f.ID))
+		s.writeJSON(w, http.StatusCreated, f)
+	}
+}
+
+// hire is the result of a candidate accepting an offer.
+type hire struct {
+	Candidate  models.Candidate        `json:"candidate"`
+	Employee   models.Employee         `json:"employee"`
+	Transition models.StatusTransition `json:"transition"`
+}
+
+// handleHireCandidate records that candidate ?id= accepted the offer in
+// the body. It creates their employee record from the candidate and the
+// requisition, as a candidate who moves to onboarding on the start date.
+// A start date of today or earlier applies at once and runs the
+// onboarding hooks; a later one is picked up by applyStatusTransitions.
+func (s *Server) handleHireCandidate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	c, ok := s.candidate(w, r)
+	if !ok {
+		return
+	}
+	var offer models.Offer
+	if err := json.NewDecoder(r.Body).Decode(&offer); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if c.Status != models.CandidateStatusActive {
+		http.Error(w, "Candidate is no longer active", http.StatusConflict)
+		s.logger.Error("Hire of inactive candidate", zap.Int("candidateId", c.ID), zap.String("status", string(c.Status)))
+		return
+	}
+	req, err := s.db.GetRequisition(c.RequisitionID)
+	if err != nil {
+		s.logger.Error("Requisition not found", zap.Error(err))
+		http.Error(w, errRequisitionNotFound.Error(), http.StatusInternalServerError)
+		return
+	}
+	if req.Status != models.RequisitionStatusOpen {
+		http.Error(w, "Requisition is not open", http.StatusConflict)
+		s.logger.Error("Hire against closed requisition", zap.Int("requisitionId", req.ID))
+		return
+	}
+
+	emp, err := recruitment.NewHire(*c, *req, offer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid offer", zap.Error(err))
+		return
+	}
+	if err := s.validateReferences(&emp); err != nil {
+		s.logger.Error("Invalid employee references", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.checkCustomFields(&emp); err != nil {
+		s.writeCustomFieldError(w, err)
+		return
+	}
+
+	now := time.Now()
+	tr := models.StatusTransition{
+		From:          models.EmployeeStatusCandidate,
+		To:            models.EmployeeStatusOnboarding,
+		Reason:        "Offer accepted for " + req.Title,
+		EffectiveDate: offer.StartDate,
+		AdminID:       adminID(r),
+	}
+	if !tr.EffectiveDate.After(models.DateOf(now).Time) {
+		tr.AppliedAt = &now
+	}
+
+	if err := s.db.HireCandidate(c, &emp, &tr); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Candidate or requisition changed concurrently", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to hire candidate", http.StatusBadRequest)
+		}
+		s.logger.Error("Candidate hire failed", zap.Error(err))
+		return
+	}
+
+	if tr.AppliedAt != nil {
+		emp.Status = tr.To
+		s.runHooks(emp, tr)
+	}
+	s.logger.Info("Candidate hired", zap.Int("candidateId", c.ID), zap.Int("employeeId", emp.ID),
+		zap.String("startDate", tr.EffectiveDate.String()))
+	s.writeJSON(w, http.StatusCreated, hire{Candidate: *c, Employee: emp, Transition: tr})
+}
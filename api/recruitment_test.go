@@ -0,0 +1,185 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleCreateCandidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Starts At First Stage",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetRequisition", 4).Return(&models.Requisition{ID: 4, Status: models.RequisitionStatusOpen}, nil)
+				m.On("ListPipelineStages").Return([]models.PipelineStage{{ID: 2, Position: 1}, {ID: 5, Position: 2}}, nil)
+				m.On("CreateCandidate", mock.MatchedBy(func(c *models.Candidate) bool {
+					return *c.StageID == 2 && c.Status == models.CandidateStatusActive && c.Email == "ada@example.com"
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Requisition On Hold",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetRequisition", 4).Return(&models.Requisition{ID: 4, Status: models.RequisitionStatusOnHold}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			body := `{"requisitionId":4,"firstName":"Ada","lastName":"King","email":" ada@example.com ","status":"hired"}`
+			req := httptest.NewRequest("POST", "/candidates", bytes.NewBufferString(body))
+			rr := httptest.NewRecorder()
+
+			server.handleCandidates(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleHireCandidate(t *testing.T) {
+	candidate := func(status models.CandidateStatus) *models.Candidate {
+		return &models.Candidate{ID: 8, RequisitionID: 4, FirstName: "Ada", LastName: "King",
+			Email: "ada@example.com", Status: status}
+	}
+	requisition := func(status models.RequisitionStatus) *models.Requisition {
+		return &models.Requisition{ID: 4, Title: "Backend Engineer", DepartmentID: intPtr(2),
+			HiringManagerID: intPtr(7), Openings: 1, Status: status}
+	}
+	references := func(m *mocks.Database) {
+		m.On("GetDepartment", 2).Return(&models.Department{ID: 2}, nil)
+		m.On("GetEmployee", "7").Return(&models.Employee{ID: 7}, nil)
+		m.On("ListCustomFields").Return([]models.CustomFieldDefinition{}, nil)
+	}
+	today := models.DateOf(time.Now())
+
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Starting Today Runs Onboarding",
+			body: `{"startDate":"` + today.String() + `"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusActive), nil)
+				m.On("GetRequisition", 4).Return(requisition(models.RequisitionStatusOpen), nil)
+				references(m)
+				m.On("HireCandidate", mock.AnythingOfType("*models.Candidate"), mock.MatchedBy(func(e *models.Employee) bool {
+					return e.Email == "ada@example.com" && e.JobTitle == "Backend Engineer" && *e.ManagerID == 7 &&
+						e.Status == models.EmployeeStatusCandidate
+				}), mock.MatchedBy(func(tr *models.StatusTransition) bool {
+					return tr.To == models.EmployeeStatusOnboarding && tr.AppliedAt != nil
+				})).Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Employee).ID = 12
+				}).Return(nil)
+				m.On("StartChecklists", mock.MatchedBy(func(e *models.Employee) bool { return e.ID == 12 }),
+					models.ChecklistKindOnboarding, today).Return([]models.Checklist{}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Future Start Is Scheduled",
+			body: `{"startDate":"` + today.AddDays(14).String() + `","jobTitle":"Senior Backend Engineer"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusActive), nil)
+				m.On("GetRequisition", 4).Return(requisition(models.RequisitionStatusOpen), nil)
+				references(m)
+				m.On("HireCandidate", mock.AnythingOfType("*models.Candidate"), mock.MatchedBy(func(e *models.Employee) bool {
+					return e.JobTitle == "Senior Backend Engineer"
+				}), mock.MatchedBy(func(tr *models.StatusTransition) bool {
+					return tr.AppliedAt == nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Missing Start Date",
+			body: `{}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusActive), nil)
+				m.On("GetRequisition", 4).Return(requisition(models.RequisitionStatusOpen), nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Candidate Rejected",
+			body: `{"startDate":"` + today.String() + `"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusRejected), nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Requisition Filled",
+			body: `{"startDate":"` + today.String() + `"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusActive), nil)
+				m.On("GetRequisition", 4).Return(requisition(models.RequisitionStatusFilled), nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Hired Concurrently",
+			body: `{"startDate":"` + today.String() + `"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetCandidate", 8).Return(candidate(models.CandidateStatusActive), nil)
+				m.On("GetRequisition", 4).Return(requisition(models.RequisitionStatusOpen), nil)
+				references(m)
+				m.On("HireCandidate", mock.Anything, mock.Anything, mock.Anything).Return(db.ErrConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/candidates/hire?id=8", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleHireCandidate(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleInterviewFeedback(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetCandidate", 8).Return(&models.Candidate{ID: 8, StageID: intPtr(5)}, nil)
+	mockDB.On("GetEmployee", "7").Return(&models.Employee{ID: 7}, nil)
+	mockDB.On("CreateInterviewFeedback", mock.MatchedBy(func(f *models.InterviewFeedback) bool {
+		return f.CandidateID == 8 && *f.StageID == 5 && f.Rating == 4
+	})).Return(nil)
+
+	body := `{"interviewerId":7,"rating":4,"recommendation":"yes","notes":"Strong system design"}`
+	req := httptest.NewRequest("POST", "/candidates/feedback?id=8", bytes.NewBufferString(body))
+	rr := httptest.NewRecorder()
+
+	server.handleInterviewFeedback(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+}
@@ -0,0 +1,498 @@
+package api
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"employees/internal/review"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) handleReviewTemplates(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		var tpl models.ReviewTemplate
+		if err := json.NewDecoder(r.Body).Decode(&tpl); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if err := review.ValidateTemplate(tpl); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid review template", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			tpl.ID = 0
+			if err := s.db.CreateReviewTemplate(&tpl); err != nil {
+				s.logger.Error("Review template creation failed", zap.Error(err))
+				http.Error(w, "Failed to create review template", http.StatusInternalServerError)
+				return
+			}
+			s.logger.Info("Review template created", zap.Any("template", tpl))
+			s.writeJSON(w, http.StatusCreated, tpl)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		tpl.ID = id
+		if err := s.db.UpdateReviewTemplate(&tpl); err != nil {
+			http.Error(w, "Review template not found", http.StatusNotFound)
+			s.logger.Error("Review template update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Review template updated", zap.Any("template", tpl))
+		s.writeJSON(w, http.StatusOK, tpl)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			templates, err := s.db.ListReviewTemplates()
+			if err != nil {
+				s.logger.Error("Failed to list review templates", zap.Error(err))
+				http.Error(w, "Failed to list review templates", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, templates)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		tpl, err := s.db.GetReviewTemplate(id)
+		if err != nil {
+			http.Error(w, "Review template not found", http.StatusNotFound)
+			s.logger.Error("Review template not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, tpl)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteReviewTemplate(id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Review template deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Review template deleted", zap.Int("templateId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleReviewCycles creates a draft cycle from ?templateId=, lists cycles
+// (optionally by ?status=), gets one by ?id= or deletes a draft.
+func (s *Server) handleReviewCycles(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleCreateReviewCycle(w, r)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			cycles, err := s.db.ListReviewCycles(models.ReviewCycleStatus(r.URL.Query().Get("status")))
+			if err != nil {
+				s.logger.Error("Failed to list review cycles", zap.Error(err))
+				http.Error(w, "Failed to list review cycles", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, cycles)
+			return
+		}
+		cycle, ok := s.reviewCycle(w, r)
+		if !ok {
+			return
+		}
+		s.writeJSON(w, http.StatusOK, cycle)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteReviewCycle(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Only draft review cycles can be deleted", http.StatusConflict)
+			} else {
+				http.Error(w, "Failed to delete review cycle", http.StatusInternalServerError)
+			}
+			s.logger.Error("Review cycle deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Review cycle deleted", zap.Int("cycleId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// reviewCycle loads the cycle named by ?id=, writing a 404 when there is
+// none.
+func (s *Server) reviewCycle(w http.ResponseWriter, r *http.Request) (*models.ReviewCycle, bool) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	cycle, err := s.db.GetReviewCycle(id)
+	if err != nil {
+		http.Error(w, "Review cycle not found", http.StatusNotFound)
+		s.logger.Error("Review cycle not found", zap.Error(err))
+		return nil, false
+	}
+	return cycle, true
+}
+
+func (s *Server) handleCreateReviewCycle(w http.ResponseWriter, r *http.Request) {
+	var cycle models.ReviewCycle
+	if err := json.NewDecoder(r.Body).Decode(&cycle); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := review.ValidateCycle(cycle); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid review cycle", zap.Error(err))
+		return
+	}
+
+	tpl, err := s.db.GetReviewTemplate(cycle.TemplateID)
+	if err != nil {
+		http.Error(w, "Review template not found", http.StatusBadRequest)
+		s.logger.Error("Review template not found", zap.Error(err))
+		return
+	}
+	if cycle.DepartmentID != nil {
+		if _, err := s.db.GetDepartment(*cycle.DepartmentID); err != nil {
+			http.Error(w, errDepartmentNotFound.Error(), http.StatusBadRequest)
+			s.logger.Error("Department not found", zap.Error(err))
+			return
+		}
+	}
+
+	cycle.ID = 0
+	cycle.Questions = tpl.Questions
+	cycle.RatingScale = tpl.RatingScale
+	cycle.RatingLabels = tpl.RatingLabels
+	cycle.Status = models.ReviewCycleDraft
+	cycle.ReleasedAt = nil
+	if err := s.db.CreateReviewCycle(&cycle); err != nil {
+		s.logger.Error("Review cycle creation failed", zap.Error(err))
+		http.Error(w, "Failed to create review cycle", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Review cycle created", zap.Int("cycleId", cycle.ID), zap.Int("templateId", tpl.ID))
+	s.writeJSON(w, http.StatusCreated, cycle)
+}
+
+// handleReviewCycleStatus moves cycle ?id= on to the status in the body.
+// Opening a cycle assigns its reviews from the current reporting lines;
+// releasing it unseals the reviews. Only HR may run a cycle.
+func (s *Server) handleReviewCycleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.requirePermission(w, r, models.PermissionHR) {
+		return
+	}
+
+	var body struct {
+		To models.ReviewCycleStatus `json:"to"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	cycle, ok := s.reviewCycle(w, r)
+	if !ok {
+		return
+	}
+	if err := review.Advance(cycle.Status, body.To); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid review cycle transition", zap.Error(err))
+		return
+	}
+
+	var err error
+	if body.To == models.ReviewCycleOpen {
+		var emps []models.Employee
+		emps, err = s.db.ListEmployees(models.EmployeeFilter{DepartmentID: cycle.DepartmentID,
+			Statuses: []models.EmployeeStatus{models.EmployeeStatusActive}})
+		if err != nil {
+			s.logger.Error("Failed to list employees", zap.Error(err))
+			http.Error(w, "Failed to open review cycle", http.StatusInternalServerError)
+			return
+		}
+		err = s.db.OpenReviewCycle(cycle.ID, review.Assign(*cycle, emps))
+	} else {
+		err = s.db.AdvanceReviewCycle(cycle.ID, cycle.Status, body.To)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Review cycle status changed concurrently", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to change review cycle status", http.StatusInternalServerError)
+		}
+		s.logger.Error("Review cycle transition failed", zap.Error(err))
+		return
+	}
+
+	cycle.Status = body.To
+	if body.To == models.ReviewCycleReleased {
+		now := time.Now()
+		cycle.ReleasedAt = &now
+	}
+	s.logger.Info("Review cycle status changed", zap.Int("cycleId", cycle.ID), zap.String("status", string(cycle.Status)))
+	s.writeJSON(w, http.StatusOK, cycle)
+}
+
+func (s *Server) handleReviews(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		s.handleListReviews(w, r)
+	case "PUT":
+		s.handleAnswerReview(w, r)
+	}
+}
+
+// handleListReviews lists reviews by ?cycleId=, ?subjectId= and
+// ?reviewerId=. What a review says stays sealed until its cycle is
+// released, except to HR.
+func (s *Server) handleListReviews(w http.ResponseWriter, r *http.Request) {
+	var filter models.ReviewFilter
+	for name, field := range map[string]**int{
+		"cycleId":    &filter.CycleID,
+		"subjectId":  &filter.SubjectID,
+		"reviewerId": &filter.ReviewerID,
+	} {
+		if r.URL.Query().Get(name) == "" {
+			continue
+		}
+		id, ok := s.queryID(w, r, name)
+		if !ok {
+			return
+		}
+		*field = &id
+	}
+
+	reviews, err := s.db.ListReviews(filter)
+	if err != nil {
+		s.logger.Error("Failed to list reviews", zap.Error(err))
+		http.Error(w, "Failed to list reviews", http.StatusInternalServerError)
+		return
+	}
+
+	if !s.hasPermission(r, models.PermissionHR) {
+		released := map[int]bool{}
+		for i, rev := range reviews {
+			isReleased, ok := released[rev.CycleID]
+			if !ok {
+				cycle, err := s.db.GetReviewCycle(rev.CycleID)
+				if err != nil {
+					s.logger.Error("Failed to get review cycle", zap.Error(err))
+					http.Error(w, "Failed to list reviews", http.StatusInternalServerError)
+					return
+				}
+				isReleased = cycle.Status == models.ReviewCycleReleased
+				released[rev.CycleID] = isReleased
+			}
+			if !isReleased {
+				reviews[i] = review.Seal(rev)
+			}
+		}
+	}
+	s.writeJSON(w, http.StatusOK, reviews)
+}
+
+// handleAnswerReview saves the answers and overall rating of review ?id=
+// while its cycle is open, recording the caller. With "submit": true the
+// review must be complete and can no longer be changed.
+func (s *Server) handleAnswerReview(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Answers []models.ReviewAnswer `json:"answers"`
+		Rating  *int                  `json:"rating"`
+		Submit  bool                  `json:"submit"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	rev, err := s.db.GetReview(id)
+	if err != nil {
+		http.Error(w, "Review not found", http.StatusNotFound)
+		s.logger.Error("Review not found", zap.Error(err))
+		return
+	}
+	cycle, err := s.db.GetReviewCycle(rev.CycleID)
+	if err != nil {
+		s.logger.Error("Failed to get review cycle", zap.Error(err))
+		http.Error(w, "Failed to update review", http.StatusInternalServerError)
+		return
+	}
+	if cycle.Status != models.ReviewCycleOpen || rev.SubmittedAt != nil {
+		http.Error(w, "Review can no longer be changed", http.StatusConflict)
+		s.logger.Error("Review closed", zap.Int("reviewId", id), zap.String("cycleStatus", string(cycle.Status)))
+		return
+	}
+
+	rev.Answers = body.Answers
+	rev.Rating = body.Rating
+	rev.AnsweredByAdminID = adminID(r)
+	if err := review.ValidateAnswers(*cycle, *rev, body.Submit); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid review answers", zap.Int("reviewId", id), zap.Error(err))
+		return
+	}
+	if body.Submit {
+		now := time.Now()
+		rev.SubmittedAt = &now
+	}
+
+	if err := s.db.UpdateReview(rev); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			http.Error(w, "Review can no longer be changed", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to update review", http.StatusInternalServerError)
+		}
+		s.logger.Error("Review update failed", zap.Error(err))
+		return
+	}
+	s.logger.Info("Review saved", zap.Int("reviewId", id), zap.Bool("submitted", body.Submit))
+	s.writeJSON(w, http.StatusOK, rev)
+}
+
+type calibrationView struct {
+	Cycle models.ReviewCycle      `json:"cycle"`
+	Rows  []models.CalibrationRow `json:"rows"`
+	// Distribution counts the final ratings given so far, lowest first.
+	Distribution []int `json:"distribution"`
+}
+
+// handleReviewCalibration shows HR every employee's ratings in cycle ?id=
+// side by side (GET), and records the final rating of ?employeeId= while
+// the cycle is in calibration (PUT).
+func (s *Server) handleReviewCalibration(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionHR) {
+		return
+	}
+	cycle, ok := s.reviewCycle(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		reviews, err := s.db.ListReviews(models.ReviewFilter{CycleID: &cycle.ID})
+		if err != nil {
+			s.logger.Error("Failed to list reviews", zap.Error(err))
+			http.Error(w, "Failed to get calibration", http.StatusInternalServerError)
+			return
+		}
+		calibrations, err := s.db.ListReviewCalibrations(cycle.ID)
+		if err != nil {
+			s.logger.Error("Failed to list calibrations", zap.Error(err))
+			http.Error(w, "Failed to get calibration", http.StatusInternalServerError)
+			return
+		}
+		all, err := s.db.ListEmployees(models.EmployeeFilter{})
+		if err != nil {
+			s.logger.Error("Failed to list employees", zap.Error(err))
+			http.Error(w, "Failed to get calibration", http.StatusInternalServerError)
+			return
+		}
+		subjects := map[int]bool{}
+		for _, rev := range reviews {
+			subjects[rev.SubjectID] = true
+		}
+		var emps []models.Employee
+		for _, emp := range all {
+			if subjects[emp.ID] {
+				emps = append(emps, emp)
+			}
+		}
+
+		view := calibrationView{Cycle: *cycle, Rows: review.Calibrate(emps, reviews, calibrations),
+			Distribution: make([]int, cycle.RatingScale)}
+		for _, row := range view.Rows {
+			if row.FinalRating != nil && *row.FinalRating >= 1 && *row.FinalRating <= cycle.RatingScale {
+				view.Distribution[*row.FinalRating-1]++
+			}
+		}
+		s.writeJSON(w, http.StatusOK, view)
+	case "PUT":
+		employeeID, ok := s.queryID(w, r, "employeeId")
+		if !ok {
+			return
+		}
+		var c models.ReviewCalibration
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if cycle.Status != models.ReviewCycleCalibration {
+			http.Error(w, "Review cycle is not in calibration", http.StatusConflict)
+			s.logger.Error("Review cycle is not in calibration", zap.Int("cycleId", cycle.ID))
+			return
+		}
+		if c.Rating < 1 || c.Rating > cycle.RatingScale {
+			http.Error(w, "rating must be on the cycle's scale", http.StatusBadRequest)
+			s.logger.Error("Invalid calibrated rating", zap.Int("rating", c.Rating))
+			return
+		}
+		reviews, err := s.db.ListReviews(models.ReviewFilter{CycleID: &cycle.ID, SubjectID: &employeeID})
+		if err != nil {
+			s.logger.Error("Failed to list reviews", zap.Error(err))
+			http.Error(w, "Failed to calibrate", http.StatusInternalServerError)
+			return
+		}
+		if len(reviews) == 0 {
+			http.Error(w, "Employee is not reviewed in this cycle", http.StatusNotFound)
+			s.logger.Error("Employee not in review cycle", zap.Int("cycleId", cycle.ID), zap.Int("employeeId", employeeID))
+			return
+		}
+
+		c.ID = 0
+		c.CycleID = cycle.ID
+		c.EmployeeID = employeeID
+		c.AdminID = adminID(r)
+		if err := s.db.SetReviewCalibration(&c); err != nil {
+			s.logger.Error("Calibration failed", zap.Error(err))
+			http.Error(w, "Failed to calibrate", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Review calibrated", zap.Int("cycleId", cycle.ID), zap.Int("employeeId", employeeID))
+		s.writeJSON(w, http.StatusOK, c)
+	}
+}
+
+// remindReviewers logs a reminder once for each review still outstanding
+// when its cycle's reminder period starts.
+func (s *Server) remindReviewers(now time.Time) error {
+	today := models.DateOf(now)
+	reviews, err := s.db.ListReviewsToRemind(today)
+	if err != nil {
+		return err
+	}
+	ids := make([]int, 0, len(reviews))
+	for _, rev := range reviews {
+		s.logger.Warn("Review due",
+			zap.Int("cycleId", rev.CycleID),
+			zap.Int("reviewerId", rev.ReviewerID),
+			zap.Int("subjectId", rev.SubjectID),
+			zap.String("kind", string(rev.Kind)))
+		ids = append(ids, rev.ID)
+	}
+	return s.db.MarkReviewsReminded(ids, today)
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func reviewCycle(status models.ReviewCycleStatus) *models.ReviewCycle {
+	return &models.ReviewCycle{ID: 7, Name: "H1", RatingScale: 5, Status: status, PeerCount: 1,
+		DueDate: models.NewDate(2025, time.June, 30),
+		Questions: []models.ReviewQuestion{
+			{Key: "impact", Text: "Impact", Type: models.QuestionTypeRating, Required: true},
+		}}
+}
+
+func TestOpenReviewCycle(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(&models.Admin{ID: 1, Permissions: []string{models.PermissionHR}}, nil)
+	mockDB.On("GetReviewCycle", 7).Return(reviewCycle(models.ReviewCycleDraft), nil)
+	mockDB.On("ListEmployees", models.EmployeeFilter{Statuses: []models.EmployeeStatus{models.EmployeeStatusActive}}).
+		Return([]models.Employee{{ID: 1}, {ID: 2, ManagerID: intPtr(1)}, {ID: 3, ManagerID: intPtr(1)}}, nil)
+	mockDB.On("OpenReviewCycle", 7, mock.MatchedBy(func(reviews []models.Review) bool {
+		// Three self reviews, two by the manager and one peer review each.
+		return len(reviews) == 7
+	})).Return(nil)
+
+	req := httptest.NewRequest("POST", "/review-cycles/status?id=7", bytes.NewBufferString(`{"to":"open"}`))
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+
+	server.handleReviewCycleStatus(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got models.ReviewCycle
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	assert.Equal(t, models.ReviewCycleOpen, got.Status)
+}
+
+func TestReviewCycleStatusRequiresHR(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 2).Return(&models.Admin{ID: 2}, nil)
+
+	req := httptest.NewRequest("POST", "/review-cycles/status?id=7", bytes.NewBufferString(`{"to":"released"}`))
+	authorize(t, req, 2)
+	rr := httptest.NewRecorder()
+
+	server.handleReviewCycleStatus(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestHandleAnswerReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     models.ReviewCycleStatus
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Submit",
+			status: models.ReviewCycleOpen,
+			body:   `{"answers":[{"key":"impact","rating":4}],"rating":4,"submit":true}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("UpdateReview", mock.MatchedBy(func(r *models.Review) bool {
+					return r.SubmittedAt != nil && *r.Rating == 4 && *r.AnsweredByAdminID == 1
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Submit Incomplete",
+			status:     models.ReviewCycleOpen,
+			body:       `{"answers":[],"submit":true}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Cycle Closed",
+			status:     models.ReviewCycleCalibration,
+			body:       `{"answers":[{"key":"impact","rating":4}]}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetReview", 11).Return(&models.Review{ID: 11, CycleID: 7, SubjectID: 2, ReviewerID: 3,
+				Kind: models.ReviewKindPeer}, nil)
+			mockDB.On("GetReviewCycle", 7).Return(reviewCycle(tt.status), nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("PUT", "/reviews?id=11", bytes.NewBufferString(tt.body))
+			authorize(t, req, 1)
+			rr := httptest.NewRecorder()
+
+			server.handleReviews(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestListReviewsSealedUntilReleased(t *testing.T) {
+	submitted := func() []models.Review {
+		now := time.Now()
+		return []models.Review{{ID: 11, CycleID: 7, SubjectID: 2, ReviewerID: 3, Kind: models.ReviewKindPeer,
+			Answers: []models.ReviewAnswer{{Key: "impact", Rating: intPtr(2)}}, Rating: intPtr(2), SubmittedAt: &now}}
+	}
+	tests := []struct {
+		name       string
+		admin      *models.Admin
+		status     models.ReviewCycleStatus
+		wantSealed bool
+	}{
+		{"Sealed While Open", &models.Admin{ID: 2}, models.ReviewCycleCalibration, true},
+		{"HR Sees Everything", &models.Admin{ID: 1, Permissions: []string{models.PermissionHR}}, models.ReviewCycleOpen, false},
+		{"Released", &models.Admin{ID: 2}, models.ReviewCycleReleased, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetAdminByID", tt.admin.ID).Return(tt.admin, nil)
+			mockDB.On("ListReviews", models.ReviewFilter{SubjectID: intPtr(2)}).Return(submitted(), nil)
+			mockDB.On("GetReviewCycle", 7).Return(reviewCycle(tt.status), nil).Maybe()
+
+			req := httptest.NewRequest("GET", "/reviews?subjectId=2", nil)
+			authorize(t, req, uint32(tt.admin.ID))
+			rr := httptest.NewRecorder()
+
+			server.handleReviews(rr, req)
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			var got []models.Review
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.wantSealed, got[0].Rating == nil)
+			assert.NotNil(t, got[0].SubmittedAt)
+		})
+	}
+}
+
+func TestHandleReviewCalibration(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(&models.Admin{ID: 1, Permissions: []string{models.PermissionHR}}, nil)
+	mockDB.On("GetReviewCycle", 7).Return(reviewCycle(models.ReviewCycleCalibration), nil)
+	mockDB.On("ListReviews", models.ReviewFilter{CycleID: intPtr(7), SubjectID: intPtr(2)}).
+		Return([]models.Review{{ID: 11, CycleID: 7, SubjectID: 2}}, nil)
+	mockDB.On("SetReviewCalibration", mock.MatchedBy(func(c *models.ReviewCalibration) bool {
+		return c.CycleID == 7 && c.EmployeeID == 2 && c.Rating == 3 && *c.AdminID == 1
+	})).Return(nil)
+
+	req := httptest.NewRequest("PUT", "/review-cycles/calibration?id=7&employeeId=2", bytes.NewBufferString(`{"rating":3}`))
+	authorize(t, req, 1)
+	rr := httptest.NewRecorder()
+
+	server.handleReviewCalibration(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRemindReviewers(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	now := time.Date(2025, time.June, 23, 9, 0, 0, 0, time.UTC)
+	mockDB.On("ListReviewsToRemind", models.DateOf(now)).Return([]models.Review{{ID: 11}, {ID: 12}}, nil)
+	mockDB.On("MarkReviewsReminded", []int{11, 12}, models.DateOf(now)).Return(nil)
+
+	require.NoError(t, server.remindReviewers(now))
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *Server) handleSearchEmployees(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		http.Error(w, "Query is required", http.StatusBadRequest)
+		s.logger.Error("Query is required")
+		return
+	}
+
+	limit := defaultSearchLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		intLimit, ok := validateId(l)
+		if !ok || intLimit <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			s.logger.Error("Invalid limit", zap.String("limit", l))
+			return
+		}
+		limit = min(intLimit, maxSearchLimit)
+	}
+
+	results, err := s.db.SearchEmployees(query, limit)
+	if err != nil {
+		s.logger.Error("Employee search failed", zap.Error(err))
+		http.Error(w, "Failed to search employees", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Employees searched", zap.String("query", query), zap.Int("results", len(results)))
+	for i := range results {
+		s.signPhoto(&results[i].Employee)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		s.logger.Error("Failed to encode response", zap.Error(err))
+	}
+}
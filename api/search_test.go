@@ -0,0 +1,93 @@
+package api
+
+import (
+	"employees/internal/db/memory"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSearchEmployees(t *testing.T) {
+	index := memory.NewEmployeeIndex(
+		models.Employee{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com", AddressText: "12 Main Street"},
+		models.Employee{ID: 2, FirstName: "Jane", LastName: "Smith", Email: "jane@example.com", AddressText: "4 Elm Road"},
+	)
+
+	tests := []struct {
+		name       string
+		query      string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+		wantIDs    []int
+	}{
+		{
+			name:  "Prefix Match",
+			query: "?q=jo",
+			setupMock: func(db *mocks.Database) {
+				db.On("SearchEmployees", "jo", defaultSearchLimit).Return(index.SearchEmployees)
+			},
+			wantStatus: http.StatusOK,
+			wantIDs:    []int{1},
+		},
+		{
+			name:  "Misspelled Name",
+			query: "?q=" + url.QueryEscape("Jane Smiht") + "&limit=5",
+			setupMock: func(db *mocks.Database) {
+				db.On("SearchEmployees", "Jane Smiht", 5).Return(index.SearchEmployees)
+			},
+			wantStatus: http.StatusOK,
+			wantIDs:    []int{2},
+		},
+		{
+			name:       "Missing Query",
+			query:      "",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid Limit",
+			query:      "?q=jo&limit=abc",
+			setupMock:  func(db *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Database Error",
+			query: "?q=jo",
+			setupMock: func(db *mocks.Database) {
+				db.On("SearchEmployees", "jo", defaultSearchLimit).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("GET", "/employee/search"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			server.handleSearchEmployees(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantIDs != nil {
+				var got []models.EmployeeSearchResult
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				var ids []int
+				for _, res := range got {
+					ids = append(ids, res.Employee.ID)
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+		})
+	}
+}
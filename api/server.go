@@ -3,36 +3,168 @@ package api
 import (
 	"employees/api/auth"
 	"employees/api/middlewares"
+	"employees/internal/blob"
 	"employees/internal/db"
+	"employees/internal/lifecycle"
 	"employees/internal/models"
+	"employees/internal/signedurl"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"os"
 	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type Server struct {
-	logger     *zap.Logger
-	router     *http.ServeMux
-	listenAddr string
-	db         db.Database
+	logger          *zap.Logger
+	router          *http.ServeMux
+	listenAddr      string
+	db              db.Database
+	payrollRulesDir string
+	hooks           lifecycle.Hooks
+	blobs           blob.Store
+	signer          *signedurl.Signer
 }
 
 func NewServer(listenAddr string, logger *zap.Logger, db db.Database) *Server {
-	return &Server{
-		logger:     logger,
-		listenAddr: listenAddr,
-		router:     http.NewServeMux(),
-		db:         db,
-	}
+	rulesDir := os.Getenv("PAYROLL_RULES_DIR")
+	if rulesDir == "" {
+		rulesDir = "payroll-rules"
+	}
+	documentsDir := os.Getenv("DOCUMENT_STORE_DIR")
+	if documentsDir == "" {
+		documentsDir = "documents"
+	}
+	s := &Server{
+		logger:          logger,
+		listenAddr:      listenAddr,
+		router:          http.NewServeMux(),
+		db:              db,
+		payrollRulesDir: rulesDir,
+		blobs:           blob.NewLocalStore(documentsDir),
+		signer:          signedurl.NewSigner([]byte(os.Getenv("API_SECRET"))),
+	}
+	s.OnTransition(models.EmployeeStatusTerminated, s.reassignReports)
+	s.OnTransition(models.EmployeeStatusTerminated, s.startChecklists(models.ChecklistKindOffboarding))
+	s.OnTransition(models.EmployeeStatusTerminated, s.collectAssets)
+	s.OnTransition(models.EmployeeStatusTerminated, s.vacatePlannedPositions)
+	s.OnTransition(models.EmployeeStatusOnboarding, s.startChecklists(models.ChecklistKindOnboarding))
+	s.OnTransition(models.EmployeeStatusActive, s.startChecklists(models.ChecklistKindOnboarding))
+	return s
 }
 
 func (s *Server) Start() error {
 	s.router.HandleFunc("/employee", middlewares.SetMiddlewareAuthentication(s.handleEmployee))
-	s.router.HandleFunc("/admin", s.handleAdmin)
+	s.router.HandleFunc("/employees", middlewares.SetMiddlewareAuthentication(s.handleListEmployees))
+	s.router.HandleFunc("/employee/status", middlewares.SetMiddlewareAuthentication(s.handleEmployeeStatus))
+	s.router.HandleFunc("/employee/search", middlewares.SetMiddlewareAuthentication(s.handleSearchEmployees))
+	s.router.HandleFunc("/employee/reports", middlewares.SetMiddlewareAuthentication(s.handleDirectReports))
+	s.router.HandleFunc("/employee/chain", middlewares.SetMiddlewareAuthentication(s.handleReportingChain))
+	s.router.HandleFunc("/employee/subordinates", middlewares.SetMiddlewareAuthentication(s.handleSubordinates))
+	s.router.HandleFunc("/employee/positions", middlewares.SetMiddlewareAuthentication(s.handlePositions))
+	s.router.HandleFunc("/orgchart", middlewares.SetMiddlewareAuthentication(s.handleOrgChart))
+	s.router.HandleFunc("/department", middlewares.SetMiddlewareAuthentication(s.handleDepartment))
+	s.router.HandleFunc("/leave/types", middlewares.SetMiddlewareAuthentication(s.handleLeaveTypes))
+	s.router.HandleFunc("/leave/policies", middlewares.SetMiddlewareAuthentication(s.handleAccrualPolicies))
+	s.router.HandleFunc("/leave/requests", middlewares.SetMiddlewareAuthentication(s.handleLeaveRequests))
+	s.router.HandleFunc("/leave/requests/decision", middlewares.SetMiddlewareAuthentication(s.handleLeaveDecision))
+	s.router.HandleFunc("/leave/balance", middlewares.SetMiddlewareAuthentication(s.handleLeaveBalance))
+	s.router.HandleFunc("/leave/adjustments", middlewares.SetMiddlewareAuthentication(s.handleBalanceAdjustments))
+	s.router.HandleFunc("/employee/workingdays", middlewares.SetMiddlewareAuthentication(s.handleWorkingDays))
+	s.router.HandleFunc("/location", middlewares.SetMiddlewareAuthentication(s.handleLocation))
+	s.router.HandleFunc("/holiday/calendar", middlewares.SetMiddlewareAuthentication(s.handleHolidayCalendar))
+	s.router.HandleFunc("/holiday/calendar/holidays", middlewares.SetMiddlewareAuthentication(s.handleCalendarHolidays))
+	s.router.HandleFunc("/holiday/calendar/rules", middlewares.SetMiddlewareAuthentication(s.handleHolidayRules))
+	s.router.HandleFunc("/holiday/calendar/import", middlewares.SetMiddlewareAuthentication(s.handleImportHolidays))
+	s.router.HandleFunc("/project", middlewares.SetMiddlewareAuthentication(s.handleProject))
+	s.router.HandleFunc("/timesheet", middlewares.SetMiddlewareAuthentication(s.handleTimesheet))
+	s.router.HandleFunc("/timesheet/clockin", middlewares.SetMiddlewareAuthentication(s.handleClockIn))
+	s.router.HandleFunc("/timesheet/clockout", middlewares.SetMiddlewareAuthentication(s.handleClockOut))
+	s.router.HandleFunc("/timesheet/entries", middlewares.SetMiddlewareAuthentication(s.handleTimeEntries))
+	s.router.HandleFunc("/timesheet/submit", middlewares.SetMiddlewareAuthentication(s.handleSubmitTimesheet))
+	s.router.HandleFunc("/timesheet/decision", middlewares.SetMiddlewareAuthentication(s.handleTimesheetDecision))
+	s.router.HandleFunc("/timesheet/overtime-policy", middlewares.SetMiddlewareAuthentication(s.handleOvertimePolicy))
+	s.router.HandleFunc("/timesheet/summary/employee", middlewares.SetMiddlewareAuthentication(s.handleTimeSummary("employee")))
+	s.router.HandleFunc("/timesheet/summary/project", middlewares.SetMiddlewareAuthentication(s.handleTimeSummary("project")))
+	s.router.HandleFunc("/timesheet/summary/period", middlewares.SetMiddlewareAuthentication(s.handleTimeSummary("period")))
+	s.router.HandleFunc("/employee/compensation", middlewares.SetMiddlewareAuthentication(s.handleCompensation))
+	s.router.HandleFunc("/employee/bonuses", middlewares.SetMiddlewareAuthentication(s.handleBonuses))
+	s.router.HandleFunc("/payroll/runs", middlewares.SetMiddlewareAuthentication(s.handlePayrollRuns))
+	s.router.HandleFunc("/payroll/runs/recalculate", middlewares.SetMiddlewareAuthentication(s.handleRecalculatePayrollRun))
+	s.router.HandleFunc("/payroll/runs/lock", middlewares.SetMiddlewareAuthentication(s.handleLockPayrollRun))
+	s.router.HandleFunc("/payroll/payslip", middlewares.SetMiddlewareAuthentication(s.handlePayslip))
+	s.router.HandleFunc("/checklist/templates", middlewares.SetMiddlewareAuthentication(s.handleChecklistTemplates))
+	s.router.HandleFunc("/checklists", middlewares.SetMiddlewareAuthentication(s.handleChecklists))
+	s.router.HandleFunc("/checklist/tasks", middlewares.SetMiddlewareAuthentication(s.handleChecklistTasks))
+	s.router.HandleFunc("/checklist/task", middlewares.SetMiddlewareAuthentication(s.handleChecklistTask))
+	s.router.HandleFunc("/employee/documents", middlewares.SetMiddlewareAuthentication(s.handleDocuments))
+	s.router.HandleFunc("/employee/documents/download", middlewares.SetMiddlewareAuthentication(s.handleDownloadDocument))
+	s.router.HandleFunc("/employee/documents/url", middlewares.SetMiddlewareAuthentication(s.handleDocumentURL))
+	s.router.HandleFunc("/documents/expiring", middlewares.SetMiddlewareAuthentication(s.handleExpiringDocuments))
+	s.router.HandleFunc("/documents/signed", s.handleSignedDocument)
+	s.router.HandleFunc("/employee/photo", middlewares.SetMiddlewareAuthentication(s.handlePhoto))
+	s.router.HandleFunc("/photos/signed", s.handleSignedPhoto)
+	s.router.HandleFunc("/employee/addresses", middlewares.SetMiddlewareAuthentication(s.handleAddresses))
+	s.router.HandleFunc("/addresses/review", middlewares.SetMiddlewareAuthentication(s.handleAddressesNeedingReview))
+	s.router.HandleFunc("/employee/emergency-contacts", middlewares.SetMiddlewareAuthentication(s.handleEmergencyContacts))
+	s.router.HandleFunc("/employee/emergency-contacts/order", middlewares.SetMiddlewareAuthentication(s.handleReorderEmergencyContacts))
+	s.router.HandleFunc("/custom-fields", middlewares.SetMiddlewareAuthentication(s.handleCustomFields))
+	s.router.HandleFunc("/employees/export", middlewares.SetMiddlewareAuthentication(s.handleExportEmployees))
+	s.router.HandleFunc("/employees/import", middlewares.SetMiddlewareAuthentication(s.handleImportEmployees))
+	s.router.HandleFunc("/skills", middlewares.SetMiddlewareAuthentication(s.handleSkills))
+	s.router.HandleFunc("/employee/skills", middlewares.SetMiddlewareAuthentication(s.handleEmployeeSkills))
+	s.router.HandleFunc("/employees/by-skills", middlewares.SetMiddlewareAuthentication(s.handleFindBySkills))
+	s.router.HandleFunc("/employee/certifications", middlewares.SetMiddlewareAuthentication(s.handleCertifications))
+	s.router.HandleFunc("/certifications/expiring", middlewares.SetMiddlewareAuthentication(s.handleExpiringCertifications))
+	s.router.HandleFunc("/review-templates", middlewares.SetMiddlewareAuthentication(s.handleReviewTemplates))
+	s.router.HandleFunc("/review-cycles", middlewares.SetMiddlewareAuthentication(s.handleReviewCycles))
+	s.router.HandleFunc("/review-cycles/status", middlewares.SetMiddlewareAuthentication(s.handleReviewCycleStatus))
+	s.router.HandleFunc("/review-cycles/calibration", middlewares.SetMiddlewareAuthentication(s.handleReviewCalibration))
+	s.router.HandleFunc("/reviews", middlewares.SetMiddlewareAuthentication(s.handleReviews))
+	s.router.HandleFunc("/objectives", middlewares.SetMiddlewareAuthentication(s.handleObjectives))
+	s.router.HandleFunc("/objectives/key-results", middlewares.SetMiddlewareAuthentication(s.handleKeyResults))
+	s.router.HandleFunc("/objectives/check-ins", middlewares.SetMiddlewareAuthentication(s.handleCheckIns))
+	s.router.HandleFunc("/objectives/progress", middlewares.SetMiddlewareAuthentication(s.handleObjectiveProgress))
+	s.router.HandleFunc("/teams", middlewares.SetMiddlewareAuthentication(s.handleTeams))
+	s.router.HandleFunc("/assignments", middlewares.SetMiddlewareAuthentication(s.handleAssignments))
+	s.router.HandleFunc("/allocations/overallocated", middlewares.SetMiddlewareAuthentication(s.handleOverallocations))
+	s.router.HandleFunc("/allocations/capacity", middlewares.SetMiddlewareAuthentication(s.handleCapacity))
+	s.router.HandleFunc("/assets", middlewares.SetMiddlewareAuthentication(s.handleAssets))
+	s.router.HandleFunc("/assets/checkout", middlewares.SetMiddlewareAuthentication(s.handleCheckOutAsset))
+	s.router.HandleFunc("/assets/checkin", middlewares.SetMiddlewareAuthentication(s.handleCheckInAsset))
+	s.router.HandleFunc("/assets/history", middlewares.SetMiddlewareAuthentication(s.handleAssetHistory))
+	s.router.HandleFunc("/assets/outstanding", middlewares.SetMiddlewareAuthentication(s.handleOutstandingAssets))
+	s.router.HandleFunc("/assets/export", middlewares.SetMiddlewareAuthentication(s.handleExportAssets))
+	s.router.HandleFunc("/requisitions", middlewares.SetMiddlewareAuthentication(s.handleRequisitions))
+	s.router.HandleFunc("/pipeline-stages", middlewares.SetMiddlewareAuthentication(s.handlePipelineStages))
+	s.router.HandleFunc("/candidates", middlewares.SetMiddlewareAuthentication(s.handleCandidates))
+	s.router.HandleFunc("/candidates/feedback", middlewares.SetMiddlewareAuthentication(s.handleInterviewFeedback))
+	s.router.HandleFunc("/candidates/hire", middlewares.SetMiddlewareAuthentication(s.handleHireCandidate))
+	s.router.HandleFunc("/headcount/positions", middlewares.SetMiddlewareAuthentication(s.handlePlannedPositions))
+	s.router.HandleFunc("/headcount/positions/fill", middlewares.SetMiddlewareAuthentication(s.handleFillPlannedPosition))
+	s.router.HandleFunc("/headcount/positions/vacate", middlewares.SetMiddlewareAuthentication(s.handleVacatePlannedPosition))
+	s.router.HandleFunc("/headcount/report", middlewares.SetMiddlewareAuthentication(s.handleHeadcountReport))
+	s.router.HandleFunc("/expense-categories", middlewares.SetMiddlewareAuthentication(s.handleExpenseCategories))
+	s.router.HandleFunc("/expenses", middlewares.SetMiddlewareAuthentication(s.handleExpenseClaims))
+	s.router.HandleFunc("/expenses/decision", middlewares.SetMiddlewareAuthentication(s.handleExpenseDecision))
+	s.router.HandleFunc("/expenses/export", middlewares.SetMiddlewareAuthentication(s.handleExportExpenses))
+	s.router.HandleFunc("/employee/contracts", middlewares.SetMiddlewareAuthentication(s.handleContracts))
+	s.router.HandleFunc("/contracts/ending", middlewares.SetMiddlewareAuthentication(s.handleEndingContracts))
+	s.router.HandleFunc("/contracts/probation", middlewares.SetMiddlewareAuthentication(s.handleEndingProbations))
+	s.router.HandleFunc("/admin", middlewares.SetMiddlewareAuthentication(s.handleAdmin))
 	s.router.HandleFunc("/login", s.LogIn)
+
+	go s.runPeriodically("activate positions", time.Hour, s.activatePositions)
+	go s.runPeriodically("apply status transitions", time.Hour, s.applyStatusTransitions)
+	go s.runPeriodically("certification expiry alerts", 24*time.Hour, s.alertExpiringCertifications)
+	go s.runPeriodically("review reminders", 24*time.Hour, s.remindReviewers)
+	go s.runPeriodically("end contracts", time.Hour, s.endContracts)
+	go s.runPeriodically("contract alerts", 24*time.Hour, s.alertContracts)
+
 	return http.ListenAndServe(s.listenAddr, s.router)
 }
 
@@ -70,6 +202,31 @@ func (s *Server) handleCreateEmployee(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := s.validateReferences(&emp); err != nil {
+		s.logger.Error("Invalid employee references", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := prepareAddresses(emp.Addresses); err != nil {
+		s.logger.Error("Invalid employee address", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	emp.PhotoURL = ""
+	if emp.Status == "" {
+		emp.Status = models.EmployeeStatusActive
+	}
+	if !lifecycle.ValidInitial(emp.Status) {
+		http.Error(w, "New employees must start as candidate, onboarding or active", http.StatusBadRequest)
+		s.logger.Error("Invalid initial status", zap.String("status", string(emp.Status)))
+		return
+	}
+	if err := s.checkCustomFields(&emp); err != nil {
+		s.writeCustomFieldError(w, err)
+		return
+	}
+
 	if err := s.db.CreateEmployee(&emp); err != nil {
 		s.logger.Error("Employee creation failed", zap.Error(err))
 		http.Error(w, "Failed to create employee", http.StatusBadRequest)
@@ -100,7 +257,45 @@ func (s *Server) handleGetEmployee(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// asOf reconstructs the job title, department and manager the employee
+	// had on a past or future date from their employment history.
+	if asOf := r.URL.Query().Get("asOf"); asOf != "" {
+		date, err := models.ParseDate(asOf)
+		if err != nil {
+			http.Error(w, "Invalid asOf date", http.StatusBadRequest)
+			s.logger.Error("Invalid asOf date", zap.Error(err))
+			return
+		}
+
+		pos, err := s.db.GetPositionAsOf(emp.ID, date)
+		if err != nil {
+			http.Error(w, "No employment record as of "+asOf, http.StatusNotFound)
+			s.logger.Error("Position not found", zap.Error(err))
+			return
+		}
+		pos.ApplyTo(emp)
+	}
+
 	s.logger.Info("Employee retrieved", zap.Any("employee", emp))
+	s.signPhoto(emp)
+
+	// include=compensation adds the pay in effect today, or on asOf, for
+	// callers with the compensation permission.
+	if r.URL.Query().Get("include") == "compensation" {
+		if !s.requirePermission(w, r, models.PermissionCompensation) {
+			return
+		}
+		date, ok := s.queryDate(w, r, "asOf")
+		if !ok {
+			return
+		}
+		resp := employeeWithCompensation{Employee: emp}
+		if comp, err := s.db.GetCompensationAsOf(emp.ID, date); err == nil {
+			resp.Compensation = comp
+		}
+		s.writeJSON(w, http.StatusOK, resp)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -131,6 +326,23 @@ func (s *Server) handleUpdateEmployee(w http.ResponseWriter, r *http.Request) {
 	}
 
 	emp.ID = intId // Ensure ID matches URL parameter
+	if err := s.validateReferences(&emp); err != nil {
+		s.logger.Error("Invalid employee references", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := prepareAddresses(emp.Addresses); err != nil {
+		s.logger.Error("Invalid employee address", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if emp.CustomFields != nil {
+		if err := s.checkCustomFields(&emp); err != nil {
+			s.writeCustomFieldError(w, err)
+			return
+		}
+	}
+
 	err := s.db.UpdateEmployee(&emp)
 	if err != nil {
 		s.logger.Error("Employee update failed", zap.Error(err))
@@ -139,6 +351,7 @@ func (s *Server) handleUpdateEmployee(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s.logger.Info("Employee updated", zap.Any("employee", emp))
+	s.signPhoto(&emp)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(emp); err != nil {
@@ -186,6 +399,12 @@ func (s *Server) handleCreateAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !s.mayGrant(r, admin.Permissions) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		s.logger.Error("Permission grant denied", zap.Strings("permissions", admin.Permissions))
+		return
+	}
+
 	if err := admin.BeforeSave(); err != nil {
 		s.logger.Error("Failed to hash password", zap.Error(err))
 		http.Error(w, "Failed to create admin", http.StatusInternalServerError)
@@ -247,7 +466,8 @@ func (s *Server) handleUpdateAdmin(w http.ResponseWriter, r *http.Request) {
 
 	// Only update password if provided in request
 	var updateData struct {
-		Password string `json:"password"`
+		Password    string    `json:"password"`
+		Permissions *[]string `json:"permissions"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		s.logger.Error("Invalid request body", zap.Error(err))
@@ -255,9 +475,30 @@ func (s *Server) handleUpdateAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Update only the password if provided
+	// Update only the password if provided. Admins change their own;
+	// anyone else's takes a superadmin.
 	if updateData.Password != "" {
+		if id := adminID(r); (id == nil || *id != existingAdmin.ID) && !s.hasPermission(r, models.PermissionSuperadmin) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			s.logger.Error("Password change denied", zap.String("email", email), zap.Any("adminId", id))
+			return
+		}
 		existingAdmin.Password = updateData.Password
+		if err := existingAdmin.BeforeSave(); err != nil {
+			s.logger.Error("Failed to hash password", zap.Error(err))
+			http.Error(w, "Failed to update admin", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	// Replace permissions when given; granting one requires holding it
+	if updateData.Permissions != nil {
+		if !s.mayGrant(r, *updateData.Permissions) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			s.logger.Error("Permission grant denied", zap.Strings("permissions", *updateData.Permissions))
+			return
+		}
+		existingAdmin.Permissions = *updateData.Permissions
 	}
 
 	err = s.db.UpdateAdmin(existingAdmin)
@@ -276,6 +517,10 @@ func (s *Server) handleUpdateAdmin(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) handleDeleteAdmin(w http.ResponseWriter, r *http.Request) {
+	if !s.requirePermission(w, r, models.PermissionSuperadmin) {
+		return
+	}
+
 	email := r.URL.Query().Get("email")
 
 	if email == "" {
@@ -295,6 +540,40 @@ func (s *Server) handleDeleteAdmin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// SeedSuperadmin makes sure the admin with email exists and is a
+// superadmin, creating it with password if needed, so that a fresh install
+// has someone able to grant permissions. An existing admin keeps its
+// password. It does nothing when email is empty.
+func (s *Server) SeedSuperadmin(email, password string) error {
+	if email == "" {
+		return nil
+	}
+	if admin, err := s.db.GetAdminByEmail(email); err == nil {
+		if admin.HasPermission(models.PermissionSuperadmin) {
+			return nil
+		}
+		admin.Permissions = append(admin.Permissions, models.PermissionSuperadmin)
+		if err := s.db.UpdateAdmin(admin); err != nil {
+			return err
+		}
+		s.logger.Info("Superadmin permission granted", zap.String("email", email))
+		return nil
+	}
+
+	if password == "" {
+		return errors.New("a password is required to create the first superadmin")
+	}
+	admin := models.Admin{Email: email, Password: password, Permissions: []string{models.PermissionSuperadmin}}
+	if err := admin.BeforeSave(); err != nil {
+		return err
+	}
+	if err := s.db.CreateAdmin(&admin); err != nil {
+		return err
+	}
+	s.logger.Info("Superadmin created", zap.String("email", email))
+	return nil
+}
+
 func (s *Server) LogIn(w http.ResponseWriter, r *http.Request) {
 	var loginRequest struct {
 		Email    string `json:"email"`
@@ -40,6 +40,7 @@ func TestHandleCreateEmployee(t *testing.T) {
 				Email:     "john@example.com",
 			},
 			setupMock: func(db *mocks.Database) {
+				db.On("ListCustomFields").Return(nil, nil)
 				db.On("CreateEmployee", mock.AnythingOfType("*models.Employee")).
 					Return(nil)
 			},
@@ -53,6 +54,7 @@ func TestHandleCreateEmployee(t *testing.T) {
 				Email:     "john@example.com",
 			},
 			setupMock: func(db *mocks.Database) {
+				db.On("ListCustomFields").Return(nil, nil)
 				db.On("CreateEmployee", mock.AnythingOfType("*models.Employee")).
 					Return(errors.New("database error"))
 			},
@@ -455,6 +457,7 @@ func TestHandleUpdateAdmin(t *testing.T) {
 			require.NoError(t, err)
 
 			req := httptest.NewRequest("PUT", "/admin?email="+tt.email, bytes.NewBuffer(payload))
+			authorize(t, req, uint32(testAdmin.ID))
 			rr := httptest.NewRecorder()
 
 			server.handleUpdateAdmin(rr, req)
@@ -467,6 +470,7 @@ func TestHandleUpdateAdmin(t *testing.T) {
 
 func TestHandleDeleteAdmin(t *testing.T) {
 	server, mockDB := setupTestServer(t)
+	mockDB.On("GetAdminByID", 1).Return(&models.Admin{ID: 1, Permissions: []string{models.PermissionSuperadmin}}, nil)
 
 	tests := []struct {
 		name       string
@@ -503,6 +507,7 @@ func TestHandleDeleteAdmin(t *testing.T) {
 			tt.setupMock(mockDB)
 
 			req := httptest.NewRequest("DELETE", "/admin?email="+tt.email, nil)
+			authorize(t, req, 1)
 			rr := httptest.NewRecorder()
 
 			server.handleDeleteAdmin(rr, req)
@@ -0,0 +1,364 @@
+package api
+
+import (
+	"employees/internal/models"
+	"employees/internal/skills"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// certificationAlertDays is how far ahead of expiry an alert goes out.
+const certificationAlertDays = 30
+
+func (s *Server) handleSkills(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var skill models.Skill
+		if err := json.NewDecoder(r.Body).Decode(&skill); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		skill.ID = 0
+		if !s.validSkill(w, &skill) {
+			return
+		}
+		if err := s.db.CreateSkill(&skill); err != nil {
+			s.logger.Error("Skill creation failed", zap.Error(err))
+			http.Error(w, "Failed to create skill", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Skill created", zap.Any("skill", skill))
+		s.writeJSON(w, http.StatusCreated, skill)
+	case "GET":
+		list, err := s.db.ListSkills()
+		if err != nil {
+			s.logger.Error("Failed to list skills", zap.Error(err))
+			http.Error(w, "Failed to list skills", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, list)
+	case "PUT":
+		id, ok := s.queryID(w, r, "skillId")
+		if !ok {
+			return
+		}
+		existing, err := s.db.GetSkill(id)
+		if err != nil {
+			http.Error(w, "Skill not found", http.StatusNotFound)
+			s.logger.Error("Skill not found", zap.Error(err))
+			return
+		}
+		var skill models.Skill
+		if err := json.NewDecoder(r.Body).Decode(&skill); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		skill.ID = id
+		skill.CreatedAt = existing.CreatedAt
+		if !s.validSkill(w, &skill) {
+			return
+		}
+		if err := s.db.UpdateSkill(&skill); err != nil {
+			s.logger.Error("Skill update failed", zap.Error(err))
+			http.Error(w, "Failed to update skill", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Skill updated", zap.Any("skill", skill))
+		s.writeJSON(w, http.StatusOK, skill)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "skillId")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteSkill(id); err != nil {
+			http.Error(w, "Skill not found", http.StatusNotFound)
+			s.logger.Error("Skill deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Skill deleted", zap.Int("skillId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// validSkill checks the skill's name and that its parent exists without
+// creating a loop, writing a 400 response when it does not.
+func (s *Server) validSkill(w http.ResponseWriter, skill *models.Skill) bool {
+	if skill.Name == "" {
+		http.Error(w, "name is required", http.StatusBadRequest)
+		s.logger.Error("name is required")
+		return false
+	}
+	parentOf := func(id int) (*int, error) {
+		parent, err := s.db.GetSkill(id)
+		if err != nil {
+			return nil, err
+		}
+		return parent.ParentID, nil
+	}
+	if err := skills.CheckParent(skill.ID, skill.ParentID, parentOf); err != nil {
+		http.Error(w, "Invalid parentId: "+err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid skill parent", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// handleEmployeeSkills lists (GET), rates (PUT with skillId and level) or
+// removes (DELETE with ?skillId=) the skills of employee ?id=.
+func (s *Server) handleEmployeeSkills(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		list, err := s.db.ListEmployeeSkills(id)
+		if err != nil {
+			s.logger.Error("Failed to list employee skills", zap.Error(err))
+			http.Error(w, "Failed to list employee skills", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, list)
+	case "PUT":
+		var es models.EmployeeSkill
+		if err := json.NewDecoder(r.Body).Decode(&es); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		es.ID = 0
+		es.EmployeeID = id
+		if !skills.ValidLevel(es.Level) {
+			http.Error(w, "level must be between 1 and 5", http.StatusBadRequest)
+			s.logger.Error("Invalid proficiency level", zap.Int("level", es.Level))
+			return
+		}
+		if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Employee not found", zap.Error(err))
+			return
+		}
+		if _, err := s.db.GetSkill(es.SkillID); err != nil {
+			http.Error(w, "Skill not found", http.StatusBadRequest)
+			s.logger.Error("Skill not found", zap.Error(err))
+			return
+		}
+		if err := s.db.SetEmployeeSkill(&es); err != nil {
+			s.logger.Error("Employee skill update failed", zap.Error(err))
+			http.Error(w, "Failed to update employee skill", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Employee skill rated", zap.Any("employeeSkill", es))
+		s.writeJSON(w, http.StatusOK, es)
+	case "DELETE":
+		skillID, ok := s.queryID(w, r, "skillId")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteEmployeeSkill(id, skillID); err != nil {
+			s.logger.Error("Employee skill deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete employee skill", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Employee skill removed", zap.Int("employeeId", id), zap.Int("skillId", skillID))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleFindBySkills lists employees with every skill in ?skills=, a
+// comma-separated list of skill IDs each optionally followed by a minimum
+// level, as in ?skills=3:4,7.
+func (s *Server) handleFindBySkills(w http.ResponseWriter, r *http.Request) {
+	reqs, err := skills.ParseRequirements(r.URL.Query().Get("skills"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid skills query", zap.Error(err))
+		return
+	}
+	emps, err := s.db.FindEmployeesBySkills(reqs)
+	if err != nil {
+		s.logger.Error("Failed to find employees by skill", zap.Error(err))
+		http.Error(w, "Failed to find employees", http.StatusInternalServerError)
+		return
+	}
+	s.signPhotos(emps)
+	s.writeJSON(w, http.StatusOK, emps)
+}
+
+func (s *Server) handleCertifications(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		var cert models.Certification
+		if err := json.NewDecoder(r.Body).Decode(&cert); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		cert.ID = 0
+		cert.EmployeeID = id
+		cert.AlertedOn = nil
+		if !s.validCertification(w, &cert) {
+			return
+		}
+		if err := s.db.CreateCertification(&cert); err != nil {
+			s.logger.Error("Certification creation failed", zap.Error(err))
+			http.Error(w, "Failed to create certification", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Certification created", zap.Any("certification", cert))
+		s.writeJSON(w, http.StatusCreated, cert)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		certs, err := s.db.ListCertifications(id)
+		if err != nil {
+			s.logger.Error("Failed to list certifications", zap.Error(err))
+			http.Error(w, "Failed to list certifications", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, certs)
+	case "PUT":
+		s.handleUpdateCertification(w, r)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "certificationId")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteCertification(id); err != nil {
+			s.logger.Error("Certification deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete certification", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Certification deleted", zap.Int("certificationId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleUpdateCertification(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "certificationId")
+	if !ok {
+		return
+	}
+	existing, err := s.db.GetCertification(id)
+	if err != nil {
+		http.Error(w, "Certification not found", http.StatusNotFound)
+		s.logger.Error("Certification not found", zap.Error(err))
+		return
+	}
+
+	var cert models.Certification
+	if err := json.NewDecoder(r.Body).Decode(&cert); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	cert.ID = existing.ID
+	cert.EmployeeID = existing.EmployeeID
+	cert.CreatedAt = existing.CreatedAt
+	// A renewal moves the expiry date and needs a fresh alert.
+	cert.AlertedOn = nil
+	if sameDate(cert.ExpiresOn, existing.ExpiresOn) {
+		cert.AlertedOn = existing.AlertedOn
+	}
+	if !s.validCertification(w, &cert) {
+		return
+	}
+
+	if err := s.db.UpdateCertification(&cert); err != nil {
+		s.logger.Error("Certification update failed", zap.Error(err))
+		http.Error(w, "Failed to update certification", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Certification updated", zap.Any("certification", cert))
+	s.writeJSON(w, http.StatusOK, cert)
+}
+
+func sameDate(a, b *models.Date) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b.Time)
+}
+
+// validCertification validates cert and checks that its evidence, if any,
+// is one of the employee's documents. It writes the error response itself.
+func (s *Server) validCertification(w http.ResponseWriter, cert *models.Certification) bool {
+	if err := skills.ValidateCertification(cert); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid certification", zap.Error(err))
+		return false
+	}
+	if _, err := s.db.GetEmployee(strconv.Itoa(cert.EmployeeID)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return false
+	}
+	if cert.DocumentID != nil {
+		doc, err := s.db.GetDocument(*cert.DocumentID)
+		if err != nil || doc.EmployeeID != cert.EmployeeID {
+			http.Error(w, "documentId must be one of the employee's documents", http.StatusBadRequest)
+			s.logger.Error("Invalid certification evidence", zap.Intp("documentId", cert.DocumentID))
+			return false
+		}
+	}
+	return true
+}
+
+// handleExpiringCertifications lists certifications expiring within
+// ?within= days (default 30), including any already expired.
+func (s *Server) handleExpiringCertifications(w http.ResponseWriter, r *http.Request) {
+	within := certificationAlertDays
+	if value := r.URL.Query().Get("within"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 0 {
+			http.Error(w, "within must be a number of days", http.StatusBadRequest)
+			s.logger.Error("Invalid within", zap.String("within", value))
+			return
+		}
+		within = days
+	}
+
+	certs, err := s.db.ListExpiringCertifications(models.DateOf(time.Now()).AddDays(within))
+	if err != nil {
+		s.logger.Error("Failed to list expiring certifications", zap.Error(err))
+		http.Error(w, "Failed to list expiring certifications", http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, certs)
+}
+
+// alertExpiringCertifications logs a warning once for each certification
+// that comes within certificationAlertDays of expiring.
+func (s *Server) alertExpiringCertifications(now time.Time) error {
+	today := models.DateOf(now)
+	certs, err := s.db.ListExpiringCertifications(today.AddDays(certificationAlertDays))
+	if err != nil {
+		return err
+	}
+	var alerted []int
+	for _, cert := range certs {
+		if cert.AlertedOn != nil {
+			continue
+		}
+		s.logger.Warn("Certification expiring",
+			zap.Int("employeeId", cert.EmployeeID),
+			zap.Int("certificationId", cert.ID),
+			zap.String("name", cert.Name),
+			zap.Stringer("expiresOn", cert.ExpiresOn))
+		alerted = append(alerted, cert.ID)
+	}
+	return s.db.MarkCertificationsAlerted(alerted, today)
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSkillsRejectsCycle(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	// 2 is a child of 1, so 1 cannot become a child of 2.
+	mockDB.On("GetSkill", 1).Return(&models.Skill{ID: 1, Name: "Programming"}, nil)
+	mockDB.On("GetSkill", 2).Return(&models.Skill{ID: 2, Name: "Go", ParentID: intPtr(1)}, nil)
+
+	req := httptest.NewRequest("PUT", "/skills?skillId=1", bytes.NewBufferString(`{"name":"Programming","parentId":2}`))
+	rr := httptest.NewRecorder()
+
+	server.handleSkills(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleEmployeeSkills(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Rate",
+			body: `{"skillId":2,"level":4}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("GetSkill", 2).Return(&models.Skill{ID: 2, Name: "Go"}, nil)
+				m.On("SetEmployeeSkill", mock.MatchedBy(func(es *models.EmployeeSkill) bool {
+					return es.EmployeeID == 3 && es.SkillID == 2 && es.Level == 4
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Level Out Of Range",
+			body:       `{"skillId":2,"level":6}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown Skill",
+			body: `{"skillId":9,"level":3}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("GetSkill", 9).Return(nil, errors.New("record not found"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("PUT", "/employee/skills?id=3", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleEmployeeSkills(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleFindBySkills(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("FindEmployeesBySkills", []models.SkillRequirement{{SkillID: 3, MinLevel: 4}, {SkillID: 7, MinLevel: 1}}).
+		Return([]models.Employee{{ID: 1}}, nil)
+
+	req := httptest.NewRequest("GET", "/employees/by-skills?skills=3:4,7", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleFindBySkills(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandleCreateCertification(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "With Evidence",
+			body: `{"name":"CKA","issuer":"CNCF","issuedOn":"2025-01-10","expiresOn":"2028-01-10","documentId":5}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("GetDocument", 5).Return(&models.Document{ID: 5, EmployeeID: 3}, nil)
+				m.On("CreateCertification", mock.AnythingOfType("*models.Certification")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Another Employee's Document",
+			body: `{"name":"CKA","issuedOn":"2025-01-10","documentId":6}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil)
+				m.On("GetDocument", 6).Return(&models.Document{ID: 6, EmployeeID: 4}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Expires Before Issue",
+			body:       `{"name":"CKA","issuedOn":"2025-01-10","expiresOn":"2024-01-10"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/employee/certifications?id=3", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleCertifications(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestAlertExpiringCertifications(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	now := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	today := models.DateOf(now)
+	expires := today.AddDays(10)
+	mockDB.On("ListExpiringCertifications", today.AddDays(certificationAlertDays)).Return([]models.Certification{
+		{ID: 1, EmployeeID: 3, Name: "CKA", ExpiresOn: &expires},
+		{ID: 2, EmployeeID: 4, Name: "PMP", ExpiresOn: &expires, AlertedOn: &today},
+	}, nil)
+	mockDB.On("MarkCertificationsAlerted", []int{1}, today).Return(nil)
+
+	require.NoError(t, server.alertExpiringCertifications(now))
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,385 @@
+package api
+
+import (
+	"employees/internal/allocation"
+	"employees/internal/db"
+	"employees/internal/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errTeamNotFound    = errors.New("team not found")
+	errProjectNotFound = errors.New("project not found")
+)
+
+func (s *Server) handleTeams(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST", "PUT":
+		var team models.Team
+		if err := json.NewDecoder(r.Body).Decode(&team); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		team.Name = strings.TrimSpace(team.Name)
+		if err := s.checkTeam(&team); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid team", zap.Error(err))
+			return
+		}
+
+		if r.Method == "POST" {
+			team.ID = 0
+			if err := s.db.CreateTeam(&team); err != nil {
+				s.logger.Error("Team creation failed", zap.Error(err))
+				http.Error(w, "Failed to create team", http.StatusBadRequest)
+				return
+			}
+			s.logger.Info("Team created", zap.Any("team", team))
+			s.writeJSON(w, http.StatusCreated, team)
+			return
+		}
+
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		team.ID = id
+		if err := s.db.UpdateTeam(&team); err != nil {
+			http.Error(w, "Team not found", http.StatusNotFound)
+			s.logger.Error("Team update failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Team updated", zap.Any("team", team))
+		s.writeJSON(w, http.StatusOK, team)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			teams, err := s.db.ListTeams()
+			if err != nil {
+				s.logger.Error("Failed to list teams", zap.Error(err))
+				http.Error(w, "Failed to list teams", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, teams)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		team, err := s.db.GetTeam(id)
+		if err != nil {
+			http.Error(w, "Team not found", http.StatusNotFound)
+			s.logger.Error("Team not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, team)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteTeam(id); err != nil {
+			if errors.Is(err, db.ErrConflict) {
+				http.Error(w, "Team still owns objectives", http.StatusConflict)
+			} else {
+				http.Error(w, "Team not found", http.StatusNotFound)
+			}
+			s.logger.Error("Team deletion failed", zap.Error(err))
+			return
+		}
+		s.logger.Info("Team deleted", zap.Int("teamId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// checkTeam checks that the team has a name and that its department and
+// lead exist.
+func (s *Server) checkTeam(team *models.Team) error {
+	if team.Name == "" {
+		return errors.New("name is required")
+	}
+	if team.DepartmentID != nil {
+		if _, err := s.db.GetDepartment(*team.DepartmentID); err != nil {
+			return errDepartmentNotFound
+		}
+	}
+	if team.LeadID != nil {
+		if _, err := s.db.GetEmployee(strconv.Itoa(*team.LeadID)); err != nil {
+			return errors.New("team lead not found")
+		}
+	}
+	return nil
+}
+
+// handleAssignments manages who works on which team or project. GET lists
+// assignments by ?employeeId=, ?teamId= or ?projectId=, optionally only
+// those overlapping ?from= to ?to=. An assignment that would take its
+// employee over 100% is refused with the clashes unless ?force=true.
+func (s *Server) handleAssignments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		filter, ok := s.assignmentFilter(w, r)
+		if !ok {
+			return
+		}
+		assignments, err := s.db.ListAssignments(filter)
+		if err != nil {
+			s.logger.Error("Failed to list assignments", zap.Error(err))
+			http.Error(w, "Failed to list assignments", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, assignments)
+	case "POST", "PUT":
+		s.handleSaveAssignment(w, r)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		if err := s.db.DeleteAssignment(id); err != nil {
+			s.logger.Error("Assignment deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete assignment", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Assignment deleted", zap.Int("assignmentId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) assignmentFilter(w http.ResponseWriter, r *http.Request) (models.AssignmentFilter, bool) {
+	var filter models.AssignmentFilter
+	q := r.URL.Query()
+	if q.Get("employeeId") != "" {
+		id, ok := s.queryID(w, r, "employeeId")
+		if !ok {
+			return filter, false
+		}
+		filter.EmployeeIDs = []int{id}
+	}
+	for name, field := range map[string]**int{
+		"teamId":    &filter.TeamID,
+		"projectId": &filter.ProjectID,
+	} {
+		if q.Get(name) == "" {
+			continue
+		}
+		id, ok := s.queryID(w, r, name)
+		if !ok {
+			return filter, false
+		}
+		*field = &id
+	}
+	if q.Get("from") != "" || q.Get("to") != "" {
+		from, to, ok := s.dateRange(w, r)
+		if !ok {
+			return filter, false
+		}
+		filter.From, filter.To = &from, &to
+	}
+	return filter, true
+}
+
+func (s *Server) handleSaveAssignment(w http.ResponseWriter, r *http.Request) {
+	var a models.Assignment
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == "PUT" {
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		existing, err := s.db.GetAssignment(id)
+		if err != nil {
+			http.Error(w, "Assignment not found", http.StatusNotFound)
+			s.logger.Error("Assignment not found", zap.Error(err))
+			return
+		}
+		// Only the terms of an assignment change; moving someone elsewhere
+		// is a new assignment.
+		a.ID = existing.ID
+		a.EmployeeID = existing.EmployeeID
+		a.TeamID = existing.TeamID
+		a.ProjectID = existing.ProjectID
+		a.CreatedAt = existing.CreatedAt
+	} else {
+		a.ID = 0
+	}
+
+	if err := allocation.Validate(&a); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.logger.Error("Invalid assignment", zap.Error(err))
+		return
+	}
+	if r.Method == "POST" {
+		if err := s.checkAssignment(&a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			s.logger.Error("Invalid assignment", zap.Error(err))
+			return
+		}
+	}
+
+	others, err := s.db.ListAssignments(models.AssignmentFilter{EmployeeIDs: []int{a.EmployeeID},
+		From: &a.StartDate, To: a.EndDate})
+	if err != nil {
+		s.logger.Error("Failed to list assignments", zap.Error(err))
+		http.Error(w, "Failed to check allocation", http.StatusInternalServerError)
+		return
+	}
+	combined := []models.Assignment{a}
+	for _, other := range others {
+		if other.ID != a.ID {
+			combined = append(combined, other)
+		}
+	}
+	if over := allocation.Overallocations(combined); len(over) > 0 && r.URL.Query().Get("force") != "true" {
+		s.logger.Error("Assignment would overallocate employee", zap.Int("employeeId", a.EmployeeID))
+		s.writeJSON(w, http.StatusConflict, map[string]any{
+			"error":           "Assignment would take the employee over 100%",
+			"overallocations": over,
+		})
+		return
+	}
+
+	if r.Method == "POST" {
+		err = s.db.CreateAssignment(&a)
+	} else {
+		err = s.db.UpdateAssignment(&a)
+	}
+	if err != nil {
+		s.logger.Error("Assignment save failed", zap.Error(err))
+		http.Error(w, "Failed to save assignment", http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("Assignment saved", zap.Any("assignment", a))
+	status := http.StatusOK
+	if r.Method == "POST" {
+		status = http.StatusCreated
+	}
+	s.writeJSON(w, status, a)
+}
+
+// checkAssignment checks that the employee and the team or project of a
+// new assignment exist.
+func (s *Server) checkAssignment(a *models.Assignment) error {
+	if _, err := s.db.GetEmployee(strconv.Itoa(a.EmployeeID)); err != nil {
+		return errors.New("employee not found")
+	}
+	if a.TeamID != nil {
+		if _, err := s.db.GetTeam(*a.TeamID); err != nil {
+			return errTeamNotFound
+		}
+	}
+	if a.ProjectID != nil {
+		if _, err := s.db.GetProject(*a.ProjectID); err != nil {
+			return errProjectNotFound
+		}
+	}
+	return nil
+}
+
+// handleOverallocations lists every stretch between ?from= and ?to= on
+// which someone is assigned more than 100% of their time.
+func (s *Server) handleOverallocations(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := s.dateRange(w, r)
+	if !ok {
+		return
+	}
+	assignments, err := s.db.ListAssignments(models.AssignmentFilter{From: &from, To: &to})
+	if err != nil {
+		s.logger.Error("Failed to list assignments", zap.Error(err))
+		http.Error(w, "Failed to list overallocations", http.StatusInternalServerError)
+		return
+	}
+	found := []models.Overallocation{}
+	for _, o := range allocation.Overallocations(assignments) {
+		// Clip each stretch to the requested range.
+		if o.From.Before(from.Time) {
+			o.From = from
+		}
+		if o.To == nil || o.To.After(to.Time) {
+			end := to
+			o.To = &end
+		}
+		if !o.To.Before(o.From.Time) {
+			found = append(found, o)
+		}
+	}
+	s.writeJSON(w, http.StatusOK, found)
+}
+
+// handleCapacity reports the weekly allocation and spare capacity of each
+// active employee from ?from= to ?to=, narrowed to ?employeeId=, to the
+// members of ?teamId= or ?projectId=, or to ?departmentId=.
+func (s *Server) handleCapacity(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := s.dateRange(w, r)
+	if !ok {
+		return
+	}
+	filter, ok := s.assignmentFilter(w, r)
+	if !ok {
+		return
+	}
+	filter.From, filter.To = &from, &to
+
+	employeeIDs := filter.EmployeeIDs
+	switch {
+	case len(employeeIDs) > 0:
+	case filter.TeamID != nil || filter.ProjectID != nil:
+		members, err := s.db.ListAssignments(filter)
+		if err != nil {
+			s.logger.Error("Failed to list assignments", zap.Error(err))
+			http.Error(w, "Failed to build capacity report", http.StatusInternalServerError)
+			return
+		}
+		seen := map[int]bool{}
+		for _, m := range members {
+			if !seen[m.EmployeeID] {
+				seen[m.EmployeeID] = true
+				employeeIDs = append(employeeIDs, m.EmployeeID)
+			}
+		}
+	default:
+		empFilter := models.EmployeeFilter{Statuses: []models.EmployeeStatus{models.EmployeeStatusActive}}
+		if r.URL.Query().Get("departmentId") != "" {
+			id, ok := s.queryID(w, r, "departmentId")
+			if !ok {
+				return
+			}
+			empFilter.DepartmentID = &id
+		}
+		emps, err := s.db.ListEmployees(empFilter)
+		if err != nil {
+			s.logger.Error("Failed to list employees", zap.Error(err))
+			http.Error(w, "Failed to build capacity report", http.StatusInternalServerError)
+			return
+		}
+		for _, emp := range emps {
+			employeeIDs = append(employeeIDs, emp.ID)
+		}
+	}
+
+	report := []models.WeeklyCapacity{}
+	if len(employeeIDs) > 0 {
+		// Capacity counts every assignment of the people in the report, not
+		// just those on the team or project that selected them.
+		assignments, err := s.db.ListAssignments(models.AssignmentFilter{EmployeeIDs: employeeIDs, From: &from, To: &to})
+		if err != nil {
+			s.logger.Error("Failed to list assignments", zap.Error(err))
+			http.Error(w, "Failed to build capacity report", http.StatusInternalServerError)
+			return
+		}
+		report = allocation.Weekly(assignments, employeeIDs, from, to)
+	}
+	s.writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCreateAssignment(t *testing.T) {
+	june2 := models.NewDate(2025, time.June, 2)
+	existing := []models.Assignment{{ID: 1, EmployeeID: 3, ProjectID: intPtr(1), Allocation: 60, StartDate: june2}}
+
+	tests := []struct {
+		name       string
+		url        string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Within Capacity",
+			url:  "/assignments",
+			body: `{"employeeId":3,"projectId":2,"role":"Reviewer","allocation":40,"startDate":"2025-06-09"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("ListAssignments", mock.AnythingOfType("models.AssignmentFilter")).Return(existing, nil)
+				m.On("CreateAssignment", mock.AnythingOfType("*models.Assignment")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Overallocated",
+			url:  "/assignments",
+			body: `{"employeeId":3,"projectId":2,"allocation":50,"startDate":"2025-06-09"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("ListAssignments", mock.AnythingOfType("models.AssignmentFilter")).Return(existing, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Overallocated Forced",
+			url:  "/assignments?force=true",
+			body: `{"employeeId":3,"projectId":2,"allocation":50,"startDate":"2025-06-09"}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("ListAssignments", mock.AnythingOfType("models.AssignmentFilter")).Return(existing, nil)
+				m.On("CreateAssignment", mock.AnythingOfType("*models.Assignment")).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Team And Project",
+			url:        "/assignments",
+			body:       `{"employeeId":3,"projectId":2,"teamId":1,"allocation":50,"startDate":"2025-06-09"}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetEmployee", "3").Return(&models.Employee{ID: 3}, nil).Maybe()
+			mockDB.On("GetProject", 2).Return(&models.Project{ID: 2}, nil).Maybe()
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", tt.url, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleAssignments(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleCapacity(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	from, to := models.NewDate(2025, time.June, 2), models.NewDate(2025, time.June, 8)
+	mockDB.On("ListAssignments", models.AssignmentFilter{TeamID: intPtr(4), From: &from, To: &to}).
+		Return([]models.Assignment{{EmployeeID: 3, TeamID: intPtr(4), StartDate: from}}, nil)
+	mockDB.On("ListAssignments", models.AssignmentFilter{EmployeeIDs: []int{3}, From: &from, To: &to}).
+		Return([]models.Assignment{
+			{EmployeeID: 3, TeamID: intPtr(4), StartDate: from},
+			{EmployeeID: 3, ProjectID: intPtr(1), Allocation: 80, StartDate: from},
+		}, nil)
+
+	req := httptest.NewRequest("GET", "/allocations/capacity?teamId=4&from=2025-06-02&to=2025-06-08", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleCapacity(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []models.WeeklyCapacity
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	require.Len(t, got, 1)
+	assert.Equal(t, 80.0, got[0].Allocated)
+	assert.Equal(t, 20.0, got[0].Available)
+}
+
+func TestHandleOverallocations(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	from, to := models.NewDate(2025, time.June, 1), models.NewDate(2025, time.June, 30)
+	mockDB.On("ListAssignments", models.AssignmentFilter{From: &from, To: &to}).Return([]models.Assignment{
+		{ID: 1, EmployeeID: 3, ProjectID: intPtr(1), Allocation: 70, StartDate: models.NewDate(2025, time.May, 1)},
+		{ID: 2, EmployeeID: 3, ProjectID: intPtr(2), Allocation: 70, StartDate: models.NewDate(2025, time.June, 16)},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/allocations/overallocated?from=2025-06-01&to=2025-06-30", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleOverallocations(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []models.Overallocation
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	require.Len(t, got, 1)
+	assert.Equal(t, models.NewDate(2025, time.June, 16), got[0].From)
+	assert.Equal(t, to, *got[0].To)
+	assert.Equal(t, 140, got[0].Allocation)
+}
+
+func TestDeleteTeamWithObjectives(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	mockDB.On("DeleteTeam", 4).Return(db.ErrConflict)
+
+	req := httptest.NewRequest("DELETE", "/teams?id=4", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleTeams(rr, req)
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
@@ -0,0 +1,528 @@
+package api
+
+import (
+	"employees/internal/models"
+	"employees/internal/timesheet"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) handleProject(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var project models.Project
+		if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if project.Code == "" || project.Name == "" {
+			http.Error(w, "code and name are required", http.StatusBadRequest)
+			s.logger.Error("Invalid project", zap.Any("project", project))
+			return
+		}
+		if err := s.db.CreateProject(&project); err != nil {
+			s.logger.Error("Project creation failed", zap.Error(err))
+			http.Error(w, "Failed to create project", http.StatusBadRequest)
+			return
+		}
+		s.logger.Info("Project created", zap.Any("project", project))
+		s.writeJSON(w, http.StatusCreated, project)
+	case "GET":
+		if r.URL.Query().Get("id") == "" {
+			projects, err := s.db.ListProjects()
+			if err != nil {
+				s.logger.Error("Failed to list projects", zap.Error(err))
+				http.Error(w, "Failed to list projects", http.StatusInternalServerError)
+				return
+			}
+			s.writeJSON(w, http.StatusOK, projects)
+			return
+		}
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		project, err := s.db.GetProject(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Project not found", zap.Error(err))
+			return
+		}
+		s.writeJSON(w, http.StatusOK, project)
+	}
+}
+
+// weekUnlocked writes a 409 and returns false when the employee's timesheet
+// for the week containing day has been submitted or approved.
+func (s *Server) weekUnlocked(w http.ResponseWriter, employeeID int, day models.Date) bool {
+	ts, err := s.db.GetTimesheet(employeeID, timesheet.WeekStart(day))
+	if err != nil {
+		s.logger.Error("Failed to get timesheet", zap.Error(err))
+		http.Error(w, "Failed to check timesheet", http.StatusInternalServerError)
+		return false
+	}
+	if timesheet.Locked(ts.Status) {
+		http.Error(w, "Timesheet for this week is "+string(ts.Status), http.StatusConflict)
+		s.logger.Error("Timesheet locked", zap.Any("timesheet", ts))
+		return false
+	}
+	return true
+}
+
+func (s *Server) validProject(w http.ResponseWriter, projectID *int) bool {
+	if projectID == nil {
+		return true
+	}
+	if _, err := s.db.GetProject(*projectID); err != nil {
+		http.Error(w, "Project not found", http.StatusBadRequest)
+		s.logger.Error("Project not found", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleClockIn(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var body struct {
+		ProjectID *int   `json:"projectId"`
+		Note      string `json:"note"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(id)); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if !s.validProject(w, body.ProjectID) {
+		return
+	}
+
+	open, err := s.db.GetOpenTimeEntry(id)
+	if err != nil {
+		s.logger.Error("Failed to get open time entry", zap.Error(err))
+		http.Error(w, "Failed to clock in", http.StatusInternalServerError)
+		return
+	}
+	if open != nil {
+		http.Error(w, "Already clocked in", http.StatusConflict)
+		s.logger.Error("Already clocked in", zap.Any("entry", open))
+		return
+	}
+
+	now := time.Now()
+	if !s.weekUnlocked(w, id, models.DateOf(now)) {
+		return
+	}
+
+	entry := models.TimeEntry{
+		EmployeeID: id,
+		ProjectID:  body.ProjectID,
+		Date:       models.DateOf(now),
+		ClockIn:    &now,
+		Source:     models.TimeEntrySourceClock,
+		Note:       body.Note,
+	}
+	if err := s.db.CreateTimeEntry(&entry); err != nil {
+		s.logger.Error("Clock in failed", zap.Error(err))
+		http.Error(w, "Failed to clock in", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Clocked in", zap.Any("entry", entry))
+	s.writeJSON(w, http.StatusCreated, entry)
+}
+
+func (s *Server) handleClockOut(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	entry, err := s.db.GetOpenTimeEntry(id)
+	if err != nil {
+		s.logger.Error("Failed to get open time entry", zap.Error(err))
+		http.Error(w, "Failed to clock out", http.StatusInternalServerError)
+		return
+	}
+	if entry == nil {
+		http.Error(w, "Not clocked in", http.StatusConflict)
+		s.logger.Error("Not clocked in", zap.Int("employeeId", id))
+		return
+	}
+
+	now := time.Now()
+	entry.ClockOut = &now
+	entry.Minutes = int(now.Sub(*entry.ClockIn).Minutes())
+	if err := s.db.UpdateTimeEntry(entry); err != nil {
+		s.logger.Error("Clock out failed", zap.Error(err))
+		http.Error(w, "Failed to clock out", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Clocked out", zap.Any("entry", entry))
+	s.writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) handleTimeEntries(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s.handleCreateTimeEntry(w, r)
+	case "GET":
+		id, ok := s.queryID(w, r, "id")
+		if !ok {
+			return
+		}
+		from, to, ok := s.dateRange(w, r)
+		if !ok {
+			return
+		}
+		entries, err := s.db.ListTimeEntries(models.TimeEntryFilter{EmployeeID: &id, From: from, To: to})
+		if err != nil {
+			s.logger.Error("Failed to list time entries", zap.Error(err))
+			http.Error(w, "Failed to list time entries", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, entries)
+	case "DELETE":
+		id, ok := s.queryID(w, r, "entryId")
+		if !ok {
+			return
+		}
+		entry, err := s.db.GetTimeEntry(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			s.logger.Error("Time entry not found", zap.Error(err))
+			return
+		}
+		if !s.weekUnlocked(w, entry.EmployeeID, entry.Date) {
+			return
+		}
+		if err := s.db.DeleteTimeEntry(id); err != nil {
+			s.logger.Error("Time entry deletion failed", zap.Error(err))
+			http.Error(w, "Failed to delete time entry", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Time entry deleted", zap.Int("entryId", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleCreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var entry models.TimeEntry
+	if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if entry.Date.IsZero() || entry.Minutes <= 0 || entry.Minutes > 24*60 {
+		http.Error(w, "date and minutes between 1 and 1440 are required", http.StatusBadRequest)
+		s.logger.Error("Invalid time entry", zap.Any("entry", entry))
+		return
+	}
+
+	if _, err := s.db.GetEmployee(strconv.Itoa(entry.EmployeeID)); err != nil {
+		http.Error(w, "Employee not found", http.StatusNotFound)
+		s.logger.Error("Employee not found", zap.Error(err))
+		return
+	}
+	if !s.validProject(w, entry.ProjectID) || !s.weekUnlocked(w, entry.EmployeeID, entry.Date) {
+		return
+	}
+
+	entry.ID = 0
+	entry.ClockIn, entry.ClockOut = nil, nil
+	entry.Source = models.TimeEntrySourceManual
+	if err := s.db.CreateTimeEntry(&entry); err != nil {
+		s.logger.Error("Time entry creation failed", zap.Error(err))
+		http.Error(w, "Failed to create time entry", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Time entry created", zap.Any("entry", entry))
+	s.writeJSON(w, http.StatusCreated, entry)
+}
+
+// handleTimesheet returns the employee's timesheet for the week containing
+// ?week= (default this week) with its entries and hour summary.
+func (s *Server) handleTimesheet(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	day, ok := s.queryDate(w, r, "week")
+	if !ok {
+		return
+	}
+	weekStart := timesheet.WeekStart(day)
+
+	ts, err := s.db.GetTimesheet(id, weekStart)
+	if err != nil {
+		s.logger.Error("Failed to get timesheet", zap.Error(err))
+		http.Error(w, "Failed to get timesheet", http.StatusInternalServerError)
+		return
+	}
+	entries, err := s.db.ListTimeEntries(models.TimeEntryFilter{EmployeeID: &id, From: weekStart, To: weekStart.AddDays(6)})
+	if err != nil {
+		s.logger.Error("Failed to list time entries", zap.Error(err))
+		http.Error(w, "Failed to get timesheet", http.StatusInternalServerError)
+		return
+	}
+	policy, err := s.db.GetOvertimePolicy()
+	if err != nil {
+		s.logger.Error("Failed to get overtime policy", zap.Error(err))
+		http.Error(w, "Failed to get timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	summary := timesheet.Summarize(entries, *policy, weekStart, weekStart.AddDays(6))
+	summary.EmployeeID = &id
+	summary.ByEmployee = nil
+	s.writeJSON(w, http.StatusOK, struct {
+		Timesheet *models.Timesheet  `json:"timesheet"`
+		Entries   []models.TimeEntry `json:"entries"`
+		Summary   models.TimeSummary `json:"summary"`
+	}{ts, entries, summary})
+}
+
+// handleSubmitTimesheet submits the employee's week containing ?week= for
+// approval. Open clock entries must be closed first.
+func (s *Server) handleSubmitTimesheet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+	day, ok := s.queryDate(w, r, "week")
+	if !ok {
+		return
+	}
+
+	ts, err := s.db.GetTimesheet(id, timesheet.WeekStart(day))
+	if err != nil {
+		s.logger.Error("Failed to get timesheet", zap.Error(err))
+		http.Error(w, "Failed to submit timesheet", http.StatusInternalServerError)
+		return
+	}
+	if err := timesheet.Transition(ts.Status, models.TimesheetStatusSubmitted); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid timesheet submission", zap.Error(err))
+		return
+	}
+
+	open, err := s.db.GetOpenTimeEntry(id)
+	if err != nil {
+		s.logger.Error("Failed to get open time entry", zap.Error(err))
+		http.Error(w, "Failed to submit timesheet", http.StatusInternalServerError)
+		return
+	}
+	if open != nil && timesheet.WeekStart(open.Date) == ts.WeekStart {
+		http.Error(w, "Clock out before submitting the timesheet", http.StatusConflict)
+		s.logger.Error("Open clock entry in submitted week", zap.Any("entry", open))
+		return
+	}
+
+	now := time.Now()
+	ts.Status = models.TimesheetStatusSubmitted
+	ts.SubmittedAt = &now
+	ts.DecidedAt, ts.DecidedByAdminID, ts.DecisionNote = nil, nil, ""
+	if err := s.db.SaveTimesheet(ts); err != nil {
+		s.logger.Error("Timesheet submission failed", zap.Error(err))
+		http.Error(w, "Failed to submit timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Timesheet submitted", zap.Any("timesheet", ts))
+	s.writeJSON(w, http.StatusOK, ts)
+}
+
+// handleTimesheetDecision approves or rejects a submitted timesheet. GET
+// lists the timesheets awaiting a decision.
+func (s *Server) handleTimesheetDecision(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "GET" {
+		sheets, err := s.db.ListTimesheets(models.TimesheetStatusSubmitted)
+		if err != nil {
+			s.logger.Error("Failed to list timesheets", zap.Error(err))
+			http.Error(w, "Failed to list timesheets", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, sheets)
+		return
+	}
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := s.queryID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var decision struct {
+		Status models.TimesheetStatus `json:"status"`
+		Note   string                 `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&decision); err != nil {
+		s.logger.Error("Invalid request body", zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ts, err := s.db.GetTimesheetByID(id)
+	if err != nil {
+		http.Error(w, "Timesheet not found", http.StatusNotFound)
+		s.logger.Error("Timesheet not found", zap.Error(err))
+		return
+	}
+	if decision.Status == models.TimesheetStatusSubmitted {
+		decision.Status = ""
+	}
+	if err := timesheet.Transition(ts.Status, decision.Status); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		s.logger.Error("Invalid timesheet decision", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	ts.Status = decision.Status
+	ts.DecidedAt = &now
+	ts.DecidedByAdminID = adminID(r)
+	ts.DecisionNote = decision.Note
+	if err := s.db.SaveTimesheet(ts); err != nil {
+		s.logger.Error("Timesheet decision failed", zap.Error(err))
+		http.Error(w, "Failed to decide timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info("Timesheet decided", zap.Any("timesheet", ts))
+	s.writeJSON(w, http.StatusOK, ts)
+}
+
+func (s *Server) handleOvertimePolicy(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		var policy models.OvertimePolicy
+		if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+			s.logger.Error("Invalid request body", zap.Error(err))
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if policy.DailyThresholdMinutes < 0 || policy.WeeklyThresholdMinutes < 0 ||
+			policy.OvertimeMultiplier < 1 || policy.WeekendMultiplier < 0 {
+			http.Error(w, "Thresholds must not be negative and overtimeMultiplier must be at least 1", http.StatusBadRequest)
+			s.logger.Error("Invalid overtime policy", zap.Any("policy", policy))
+			return
+		}
+		if err := s.db.SetOvertimePolicy(&policy); err != nil {
+			s.logger.Error("Overtime policy update failed", zap.Error(err))
+			http.Error(w, "Failed to set overtime policy", http.StatusInternalServerError)
+			return
+		}
+		s.logger.Info("Overtime policy updated", zap.Any("policy", policy))
+		s.writeJSON(w, http.StatusOK, policy)
+	case "GET":
+		policy, err := s.db.GetOvertimePolicy()
+		if err != nil {
+			s.logger.Error("Failed to get overtime policy", zap.Error(err))
+			http.Error(w, "Failed to get overtime policy", http.StatusInternalServerError)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, policy)
+	}
+}
+
+// handleTimeSummary totals hours between ?from= and ?to= for one employee
+// (/timesheet/summary/employee?id=), one project (/timesheet/summary/project?id=)
+// or every employee in a pay period (/timesheet/summary/period).
+func (s *Server) handleTimeSummary(scope string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to, ok := s.dateRange(w, r)
+		if !ok {
+			return
+		}
+
+		filter := models.TimeEntryFilter{From: from, To: to}
+		if scope != "period" {
+			id, ok := s.queryID(w, r, "id")
+			if !ok {
+				return
+			}
+			if scope == "employee" {
+				filter.EmployeeID = &id
+			} else {
+				filter.ProjectID = &id
+			}
+		}
+
+		entries, err := s.db.ListTimeEntries(filter)
+		if err != nil {
+			s.logger.Error("Failed to list time entries", zap.Error(err))
+			http.Error(w, "Failed to summarize time", http.StatusInternalServerError)
+			return
+		}
+		policy, err := s.db.GetOvertimePolicy()
+		if err != nil {
+			s.logger.Error("Failed to get overtime policy", zap.Error(err))
+			http.Error(w, "Failed to summarize time", http.StatusInternalServerError)
+			return
+		}
+
+		switch scope {
+		case "employee":
+			summary := timesheet.Summarize(entries, *policy, from, to)
+			summary.EmployeeID, summary.ByEmployee = filter.EmployeeID, nil
+			s.writeJSON(w, http.StatusOK, summary)
+		case "project":
+			summary := timesheet.Summarize(entries, *policy, from, to)
+			summary.ProjectID, summary.ByProject = filter.ProjectID, nil
+			s.writeJSON(w, http.StatusOK, summary)
+		default:
+			byEmployee := map[int][]models.TimeEntry{}
+			var ids []int
+			for _, e := range entries {
+				if byEmployee[e.EmployeeID] == nil {
+					ids = append(ids, e.EmployeeID)
+				}
+				byEmployee[e.EmployeeID] = append(byEmployee[e.EmployeeID], e)
+			}
+			summaries := make([]models.TimeSummary, 0, len(ids))
+			for _, id := range ids {
+				summary := timesheet.Summarize(byEmployee[id], *policy, from, to)
+				summary.EmployeeID, summary.ByEmployee = &id, nil
+				summaries = append(summaries, summary)
+			}
+			s.writeJSON(w, http.StatusOK, summaries)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"bytes"
+	"employees/internal/db/mocks"
+	"employees/internal/models"
+	"employees/internal/timesheet"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleClockIn(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetOpenTimeEntry", 1).Return(nil, nil)
+				m.On("GetTimesheet", 1, mock.Anything).Return(&models.Timesheet{Status: models.TimesheetStatusDraft}, nil)
+				m.On("CreateTimeEntry", mock.MatchedBy(func(e *models.TimeEntry) bool {
+					return e.Source == models.TimeEntrySourceClock && e.ClockIn != nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Already Clocked In",
+			setupMock: func(m *mocks.Database) {
+				now := time.Now()
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetOpenTimeEntry", 1).Return(&models.TimeEntry{ID: 4, ClockIn: &now}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Week Submitted",
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetOpenTimeEntry", 1).Return(nil, nil)
+				m.On("GetTimesheet", 1, mock.Anything).Return(&models.Timesheet{Status: models.TimesheetStatusSubmitted}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/timesheet/clockin?id=1", nil)
+			rr := httptest.NewRecorder()
+
+			server.handleClockIn(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleClockOut(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	in := time.Now().Add(-90 * time.Minute)
+	mockDB.On("GetOpenTimeEntry", 1).Return(&models.TimeEntry{ID: 4, EmployeeID: 1, ClockIn: &in}, nil)
+	mockDB.On("UpdateTimeEntry", mock.AnythingOfType("*models.TimeEntry")).Return(nil)
+
+	req := httptest.NewRequest("POST", "/timesheet/clockout?id=1", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleClockOut(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got models.TimeEntry
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	assert.Equal(t, 90, got.Minutes)
+	assert.NotNil(t, got.ClockOut)
+}
+
+func TestHandleCreateTimeEntry(t *testing.T) {
+	// 2025-06-04 is a Wednesday in the week starting 2025-06-02.
+	week := models.NewDate(2025, time.June, 2)
+
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"employeeId":1,"projectId":3,"date":"2025-06-04","minutes":120}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetProject", 3).Return(&models.Project{ID: 3}, nil)
+				m.On("GetTimesheet", 1, week).Return(&models.Timesheet{Status: models.TimesheetStatusRejected}, nil)
+				m.On("CreateTimeEntry", mock.MatchedBy(func(e *models.TimeEntry) bool {
+					return e.Source == models.TimeEntrySourceManual && e.Minutes == 120
+				})).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Approved Week",
+			body: `{"employeeId":1,"date":"2025-06-04","minutes":60}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetTimesheet", 1, week).Return(&models.Timesheet{Status: models.TimesheetStatusApproved}, nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "Unknown Project",
+			body: `{"employeeId":1,"projectId":9,"date":"2025-06-04","minutes":60}`,
+			setupMock: func(m *mocks.Database) {
+				m.On("GetEmployee", "1").Return(&models.Employee{ID: 1}, nil)
+				m.On("GetProject", 9).Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Too Many Minutes",
+			body:       `{"employeeId":1,"date":"2025-06-04","minutes":1500}`,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/timesheet/entries", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleTimeEntries(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleTimesheetDecision(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		status     models.TimesheetStatus
+		setupMock  func(*mocks.Database)
+		wantStatus int
+	}{
+		{
+			name:   "Approve Submitted",
+			body:   `{"status":"approved"}`,
+			status: models.TimesheetStatusSubmitted,
+			setupMock: func(m *mocks.Database) {
+				m.On("SaveTimesheet", mock.MatchedBy(func(ts *models.Timesheet) bool {
+					return ts.Status == models.TimesheetStatusApproved && ts.DecidedAt != nil
+				})).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Approve Draft",
+			body:       `{"status":"approved"}`,
+			status:     models.TimesheetStatusDraft,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Resubmit Through Decision",
+			body:       `{"status":"submitted"}`,
+			status:     models.TimesheetStatusSubmitted,
+			setupMock:  func(m *mocks.Database) {},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockDB := setupTestServer(t)
+			mockDB.On("GetTimesheetByID", 7).Return(&models.Timesheet{ID: 7, EmployeeID: 1, Status: tt.status}, nil)
+			tt.setupMock(mockDB)
+
+			req := httptest.NewRequest("POST", "/timesheet/decision?id=7", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			server.handleTimesheetDecision(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandlePeriodSummary(t *testing.T) {
+	server, mockDB := setupTestServer(t)
+	from, to := models.NewDate(2025, time.June, 2), models.NewDate(2025, time.June, 8)
+	mockDB.On("ListTimeEntries", models.TimeEntryFilter{From: from, To: to}).Return([]models.TimeEntry{
+		{EmployeeID: 1, Date: from, Minutes: 600},
+		{EmployeeID: 2, Date: from, Minutes: 240},
+		{EmployeeID: 1, Date: from.AddDays(1), Minutes: 480},
+	}, nil)
+	mockDB.On("GetOvertimePolicy").Return(&timesheet.DefaultPolicy, nil)
+
+	req := httptest.NewRequest("GET", "/timesheet/summary/period?from=2025-06-02&to=2025-06-08", nil)
+	rr := httptest.NewRecorder()
+
+	server.handleTimeSummary("period")(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []models.TimeSummary
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	require.Len(t, got, 2)
+	assert.Equal(t, 1, *got[0].EmployeeID)
+	assert.Equal(t, 18.0, got[0].TotalHours)
+	assert.Equal(t, 2.0, got[0].OvertimeHours)
+	assert.Equal(t, 2, *got[1].EmployeeID)
+	assert.Equal(t, 4.0, got[1].TotalHours)
+}
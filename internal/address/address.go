@@ -0,0 +1,152 @@
+// Package address validates, formats and parses structured postal
+// addresses.
+package address
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid address")
+
+// countries holds every ISO 3166-1 alpha-2 code.
+var countries = map[string]bool{}
+
+func init() {
+	for _, code := range strings.Fields(`
+		AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI
+		BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN
+		CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK
+		FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM
+		HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN
+		KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK
+		ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP
+		NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW
+		SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF
+		TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI
+		VN VU WF WS YE YT ZA ZM ZW`) {
+		countries[code] = true
+	}
+}
+
+// postalCodes are the postal code formats of the countries we validate
+// strictly. Other countries accept any plausible code.
+var postalCodes = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^\d{4}$`),
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"BE": regexp.MustCompile(`^\d{4}$`),
+	"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+	"CA": regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+	"CH": regexp.MustCompile(`^\d{4}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"DK": regexp.MustCompile(`^\d{4}$`),
+	"ES": regexp.MustCompile(`^\d{5}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+	"IE": regexp.MustCompile(`^[AC-FHKNPRTV-Y]\d[\dW] ?[\dAC-FHKNPRTV-Y]{4}$`),
+	"IN": regexp.MustCompile(`^\d{6}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+	"MX": regexp.MustCompile(`^\d{5}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"NO": regexp.MustCompile(`^\d{4}$`),
+	"NZ": regexp.MustCompile(`^\d{4}$`),
+	"PL": regexp.MustCompile(`^\d{2}-\d{3}$`),
+	"PT": regexp.MustCompile(`^\d{4}-\d{3}$`),
+	"SE": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"SG": regexp.MustCompile(`^\d{6}$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+}
+
+var anyPostalCode = regexp.MustCompile(`^[A-Z\d][A-Z\d -]{1,10}$`)
+
+// withoutPostalCodes are countries that do not use postal codes at all.
+var withoutPostalCodes = map[string]bool{
+	"AE": true, "AG": true, "AO": true, "BS": true, "BZ": true, "FJ": true,
+	"HK": true, "KI": true, "MO": true, "QA": true, "TV": true, "ZW": true,
+}
+
+// ValidCountry reports whether code is an ISO 3166-1 alpha-2 code.
+func ValidCountry(code string) bool {
+	return countries[code]
+}
+
+// Normalize trims every field and upper-cases the country and postal code.
+func Normalize(a *models.Address) {
+	a.Line1 = strings.TrimSpace(a.Line1)
+	a.Line2 = strings.TrimSpace(a.Line2)
+	a.City = strings.TrimSpace(a.City)
+	a.Region = strings.TrimSpace(a.Region)
+	a.PostalCode = strings.ToUpper(strings.TrimSpace(a.PostalCode))
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+}
+
+// Validate checks a normalized address.
+func Validate(a models.Address) error {
+	switch {
+	case a.Type != models.AddressTypeHome && a.Type != models.AddressTypeMailing:
+		return fmt.Errorf("%w: type must be home or mailing", ErrInvalid)
+	case a.Line1 == "":
+		return fmt.Errorf("%w: line1 is required", ErrInvalid)
+	case a.City == "":
+		return fmt.Errorf("%w: city is required", ErrInvalid)
+	case !ValidCountry(a.Country):
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalid)
+	}
+	if withoutPostalCodes[a.Country] {
+		if a.PostalCode != "" {
+			return fmt.Errorf("%w: %s does not use postal codes", ErrInvalid, a.Country)
+		}
+		return nil
+	}
+	if a.PostalCode == "" {
+		return fmt.Errorf("%w: postalCode is required", ErrInvalid)
+	}
+	format, ok := postalCodes[a.Country]
+	if !ok {
+		format = anyPostalCode
+	}
+	if !format.MatchString(a.PostalCode) {
+		return fmt.Errorf("%w: invalid postal code %q for %s", ErrInvalid, a.PostalCode, a.Country)
+	}
+	return nil
+}
+
+// ValidateAll validates each address and that no type appears twice.
+func ValidateAll(addrs []models.Address) error {
+	seen := map[models.AddressType]bool{}
+	for _, a := range addrs {
+		if err := Validate(a); err != nil {
+			return err
+		}
+		if seen[a.Type] {
+			return fmt.Errorf("%w: more than one %s address", ErrInvalid, a.Type)
+		}
+		seen[a.Type] = true
+	}
+	return nil
+}
+
+// Format renders the address on one line.
+func Format(a models.Address) string {
+	locality := strings.TrimSpace(a.Region + " " + a.PostalCode)
+	var parts []string
+	for _, part := range []string{a.Line1, a.Line2, a.City, locality, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatAll renders every address on one line, separated by semicolons.
+func FormatAll(addrs []models.Address) string {
+	lines := make([]string, len(addrs))
+	for i, a := range addrs {
+		lines[i] = Format(a)
+	}
+	return strings.Join(lines, "; ")
+}
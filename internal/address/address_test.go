@@ -0,0 +1,108 @@
+package address
+
+import (
+	"employees/internal/models"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := models.Address{Type: models.AddressTypeHome, Line1: "1 Main St", City: "Springfield",
+		Region: "IL", PostalCode: "62704", Country: "US"}
+
+	tests := []struct {
+		name    string
+		modify  func(*models.Address)
+		wantErr bool
+	}{
+		{name: "Valid", modify: func(a *models.Address) {}},
+		{name: "ZIP+4", modify: func(a *models.Address) { a.PostalCode = "62704-1234" }},
+		{name: "Bad ZIP", modify: func(a *models.Address) { a.PostalCode = "6270" }, wantErr: true},
+		{name: "Unknown Type", modify: func(a *models.Address) { a.Type = "work" }, wantErr: true},
+		{name: "Missing Line1", modify: func(a *models.Address) { a.Line1 = "" }, wantErr: true},
+		{name: "Missing City", modify: func(a *models.Address) { a.City = "" }, wantErr: true},
+		{name: "Country Name", modify: func(a *models.Address) { a.Country = "USA" }, wantErr: true},
+		{name: "Unknown Country", modify: func(a *models.Address) { a.Country = "XX" }, wantErr: true},
+		{name: "Missing Postal Code", modify: func(a *models.Address) { a.PostalCode = "" }, wantErr: true},
+		{name: "UK Postcode", modify: func(a *models.Address) { a.Country, a.PostalCode = "GB", "SW1A 1AA" }},
+		{name: "Canadian Postcode", modify: func(a *models.Address) { a.Country, a.PostalCode = "CA", "K1A 0B1" }},
+		{name: "Dutch Postcode As US", modify: func(a *models.Address) { a.PostalCode = "1012 AB" }, wantErr: true},
+		{name: "Unlisted Country", modify: func(a *models.Address) { a.Country, a.PostalCode = "KE", "00100" }},
+		{name: "No Postal Codes", modify: func(a *models.Address) { a.Country, a.PostalCode = "HK", "" }},
+		{name: "Postal Code Where None Used", modify: func(a *models.Address) { a.Country = "HK" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.modify(&a)
+			err := Validate(a)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalid), err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAllRejectsDuplicateTypes(t *testing.T) {
+	a := models.Address{Type: models.AddressTypeHome, Line1: "Hauptstr. 1", City: "Berlin", PostalCode: "10115", Country: "DE"}
+	assert.NoError(t, ValidateAll([]models.Address{a}))
+	assert.ErrorIs(t, ValidateAll([]models.Address{a, a}), ErrInvalid)
+}
+
+func TestNormalizeAndFormat(t *testing.T) {
+	a := models.Address{Line1: " 1 Main St ", City: "Springfield", Region: "IL", PostalCode: " 62704", Country: "us"}
+	Normalize(&a)
+	assert.Equal(t, "1 Main St, Springfield, IL 62704, US", Format(a))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want models.Address
+	}{
+		{
+			raw:  "1 Main St, Springfield, IL 62704",
+			want: models.Address{Line1: "1 Main St", City: "Springfield", Region: "IL", PostalCode: "62704", Country: "US"},
+		},
+		{
+			raw:  "1 Main St, Apt 4, Springfield, IL 62704, USA",
+			want: models.Address{Line1: "1 Main St", Line2: "Apt 4", City: "Springfield", Region: "IL", PostalCode: "62704", Country: "US"},
+		},
+		{
+			raw:  "Hauptstraße 5\n10115 Berlin\nGermany",
+			want: models.Address{Line1: "Hauptstraße 5", City: "Berlin", PostalCode: "10115", Country: "DE"},
+		},
+		{
+			raw:  "10 Downing Street, London, sw1a 2aa, United Kingdom",
+			want: models.Address{Line1: "10 Downing Street", City: "London", PostalCode: "SW1A 2AA", Country: "GB"},
+		},
+		{
+			raw:  "Calle Mayor 5, 28013 Madrid, ES",
+			want: models.Address{Line1: "Calle Mayor 5", City: "Madrid", PostalCode: "28013", Country: "ES"},
+		},
+		{
+			raw:  "familia",
+			want: models.Address{Line1: "familia", NeedsReview: true},
+		},
+		{
+			raw:  "Calle Mayor 5, Madrid",
+			want: models.Address{Line1: "Calle Mayor 5, Madrid", NeedsReview: true},
+		},
+		{
+			raw:  "Somewhere, 1234, Germany",
+			want: models.Address{Line1: "Somewhere, 1234, Germany", Country: "DE", NeedsReview: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			tt.want.Type = models.AddressTypeHome
+			assert.Equal(t, tt.want, Parse(tt.raw))
+		})
+	}
+}
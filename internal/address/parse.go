@@ -0,0 +1,129 @@
+package address
+
+import (
+	"employees/internal/models"
+	"regexp"
+	"strings"
+)
+
+// countryNames maps the spellings we have seen in free-text addresses to
+// country codes.
+var countryNames = map[string]string{
+	"australia": "AU", "austria": "AT", "belgium": "BE", "brazil": "BR", "brasil": "BR",
+	"canada": "CA", "denmark": "DK", "england": "GB", "france": "FR", "germany": "DE",
+	"deutschland": "DE", "great britain": "GB", "holland": "NL", "india": "IN",
+	"ireland": "IE", "italy": "IT", "italia": "IT", "japan": "JP", "mexico": "MX",
+	"méxico": "MX", "netherlands": "NL", "the netherlands": "NL", "new zealand": "NZ",
+	"norway": "NO", "poland": "PL", "portugal": "PT", "scotland": "GB", "singapore": "SG",
+	"spain": "ES", "españa": "ES", "sweden": "SE", "switzerland": "CH", "uk": "GB",
+	"united kingdom": "GB", "united states": "US", "united states of america": "US",
+	"usa": "US", "wales": "GB",
+}
+
+// regionFirst are countries that write the region before the postal code
+// on the same line, as in "Springfield, IL 62704".
+var regionFirst = map[string]bool{"US": true, "CA": true, "AU": true}
+
+var (
+	usLocality    = regexp.MustCompile(`\b[A-Z]{2} \d{5}(-\d{4})?$`)
+	anyPostalWord = regexp.MustCompile(`(?i)(?:^|\s)([A-Z\d-]*\d[A-Z\d-]*)(?:\s|$)`)
+	region        = regexp.MustCompile(`^[A-Z]{2,3}$`)
+)
+
+// postalWithin matches a country's postal code anywhere in a line,
+// ignoring case.
+func postalWithin(country string) *regexp.Regexp {
+	format, ok := postalCodes[country]
+	if !ok {
+		return anyPostalWord
+	}
+	expr := strings.TrimSuffix(strings.TrimPrefix(format.String(), "^"), "$")
+	return regexp.MustCompile(`(?i)(?:^|\s)(` + expr + `)(?:\s|$)`)
+}
+
+// Parse makes a best effort at turning a free-text address into a home
+// address. When the result does not validate, the original text is kept in
+// Line1 and the address is marked NeedsReview.
+func Parse(raw string) models.Address {
+	var parts []string
+	for _, part := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '\n' || r == ';' }) {
+		if part = strings.Join(strings.Fields(part), " "); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	raw = strings.Join(parts, ", ")
+
+	a := models.Address{Type: models.AddressTypeHome}
+	if len(parts) > 0 {
+		last := parts[len(parts)-1]
+		if code, ok := countryNames[strings.ToLower(last)]; ok {
+			a.Country = code
+			parts = parts[:len(parts)-1]
+		} else if upper := strings.ToUpper(last); len(parts) > 1 && ValidCountry(upper) {
+			a.Country = upper
+			parts = parts[:len(parts)-1]
+		}
+	}
+	if a.Country == "" && len(parts) > 0 && usLocality.MatchString(strings.ToUpper(parts[len(parts)-1])) {
+		a.Country = "US"
+	}
+
+	if parsed, ok := parseLocality(a, parts); ok {
+		Normalize(&parsed)
+		if Validate(parsed) == nil {
+			return parsed
+		}
+	}
+	return models.Address{Type: models.AddressTypeHome, Line1: raw, Country: a.Country, NeedsReview: true}
+}
+
+// parseLocality finds the part holding the postal code and splits the rest
+// into street lines, city and region.
+func parseLocality(a models.Address, parts []string) (models.Address, bool) {
+	if a.Country == "" || len(parts) < 2 {
+		return a, false
+	}
+	within := postalWithin(a.Country)
+	at := -1
+	for i := len(parts) - 1; i > 0; i-- {
+		if m := within.FindStringSubmatchIndex(parts[i]); m != nil {
+			a.PostalCode = parts[i][m[2]:m[3]]
+			rest := strings.TrimSpace(parts[i][:m[2]] + " " + parts[i][m[3]:])
+			fields := strings.Fields(rest)
+			if n := len(fields); regionFirst[a.Country] && n > 0 && region.MatchString(fields[n-1]) {
+				a.Region = fields[n-1]
+				fields = fields[:n-1]
+			}
+			a.City = strings.Join(fields, " ")
+			at = i
+			break
+		}
+	}
+	if at < 0 && withoutPostalCodes[a.Country] {
+		at = len(parts) - 1
+		a.City = parts[at]
+	}
+	if at < 0 {
+		return a, false
+	}
+
+	street := parts[:at]
+	after := parts[at+1:]
+	if a.City == "" {
+		switch {
+		case len(after) > 0:
+			a.City, after = after[0], after[1:]
+		case len(street) > 1:
+			a.City, street = street[len(street)-1], street[:len(street)-1]
+		}
+	}
+	if len(after) == 1 && a.Region == "" {
+		a.Region, after = after[0], nil
+	}
+	if len(after) > 0 || len(street) == 0 {
+		return a, false
+	}
+	a.Line1 = street[0]
+	a.Line2 = strings.Join(street[1:], ", ")
+	return a, true
+}
@@ -0,0 +1,140 @@
+// Package allocation validates team and project assignments and works out
+// how much of each employee's time they take.
+package allocation
+
+import (
+	"employees/internal/models"
+	"employees/internal/timesheet"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid assignment")
+
+// Full is an employee's whole working time, in percent.
+const Full = 100
+
+// Validate trims the assignment's role and checks that it is for exactly
+// one team or project, for a valid share of time and a valid date range.
+func Validate(a *models.Assignment) error {
+	a.Role = strings.TrimSpace(a.Role)
+	switch {
+	case (a.TeamID == nil) == (a.ProjectID == nil):
+		return fmt.Errorf("%w: set exactly one of teamId and projectId", ErrInvalid)
+	case a.Allocation < 0 || a.Allocation > Full:
+		return fmt.Errorf("%w: allocation must be between 0 and %d", ErrInvalid, Full)
+	case a.StartDate.IsZero():
+		return fmt.Errorf("%w: startDate is required", ErrInvalid)
+	case a.EndDate != nil && a.EndDate.Before(a.StartDate.Time):
+		return fmt.Errorf("%w: endDate is before startDate", ErrInvalid)
+	}
+	return nil
+}
+
+// Active reports whether the assignment covers day.
+func Active(a models.Assignment, day models.Date) bool {
+	return !day.Before(a.StartDate.Time) && (a.EndDate == nil || !day.After(a.EndDate.Time))
+}
+
+// Overallocations finds every stretch of days on which an employee's
+// assignments add up to more than Full, ordered by employee and date.
+func Overallocations(assignments []models.Assignment) []models.Overallocation {
+	byEmployee := map[int][]models.Assignment{}
+	for _, a := range assignments {
+		byEmployee[a.EmployeeID] = append(byEmployee[a.EmployeeID], a)
+	}
+	ids := make([]int, 0, len(byEmployee))
+	for id := range byEmployee {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var found []models.Overallocation
+	for _, id := range ids {
+		found = append(found, overallocations(id, byEmployee[id])...)
+	}
+	return found
+}
+
+// overallocations sweeps the days on which one employee's allocation
+// changes. Between two such days it is constant.
+func overallocations(employeeID int, assignments []models.Assignment) []models.Overallocation {
+	seen := map[models.Date]bool{}
+	var changes []models.Date
+	for _, a := range assignments {
+		for _, d := range []*models.Date{&a.StartDate, endExclusive(a)} {
+			if d != nil && !seen[*d] {
+				seen[*d] = true
+				changes = append(changes, *d)
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Before(changes[j].Time) })
+
+	var found []models.Overallocation
+	for i, day := range changes {
+		total := 0
+		var active []int
+		for _, a := range assignments {
+			if Active(a, day) {
+				total += a.Allocation
+				active = append(active, a.ID)
+			}
+		}
+		if total <= Full {
+			continue
+		}
+		var to *models.Date
+		if i+1 < len(changes) {
+			last := changes[i+1].AddDays(-1)
+			to = &last
+		}
+		sort.Ints(active)
+		found = append(found, models.Overallocation{EmployeeID: employeeID, From: day, To: to,
+			Allocation: total, AssignmentIDs: active})
+	}
+	return found
+}
+
+func endExclusive(a models.Assignment) *models.Date {
+	if a.EndDate == nil {
+		return nil
+	}
+	d := a.EndDate.AddDays(1)
+	return &d
+}
+
+// Weekly reports each employee's allocation for every week from the one
+// containing from to the one containing to. A week's allocation is the
+// mean over its five weekdays.
+func Weekly(assignments []models.Assignment, employeeIDs []int, from, to models.Date) []models.WeeklyCapacity {
+	byEmployee := map[int][]models.Assignment{}
+	for _, a := range assignments {
+		byEmployee[a.EmployeeID] = append(byEmployee[a.EmployeeID], a)
+	}
+
+	var report []models.WeeklyCapacity
+	for _, id := range employeeIDs {
+		for week := timesheet.WeekStart(from); !week.After(to.Time); week = week.AddDays(7) {
+			var sum int
+			over := false
+			for d := 0; d < 5; d++ {
+				day := week.AddDays(d)
+				daily := 0
+				for _, a := range byEmployee[id] {
+					if Active(a, day) {
+						daily += a.Allocation
+					}
+				}
+				sum += daily
+				over = over || daily > Full
+			}
+			allocated := float64(sum) / 5
+			report = append(report, models.WeeklyCapacity{EmployeeID: id, WeekStart: week, Allocated: allocated,
+				Available: max(0, Full-allocated), Overallocated: over})
+		}
+	}
+	return report
+}
@@ -0,0 +1,68 @@
+package allocation
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func datePtr(d models.Date) *models.Date { return &d }
+
+func TestValidate(t *testing.T) {
+	start := models.NewDate(2025, time.June, 2)
+	valid := models.Assignment{EmployeeID: 3, ProjectID: intPtr(1), Allocation: 50, StartDate: start, Role: " Lead "}
+	require.NoError(t, Validate(&valid))
+	assert.Equal(t, "Lead", valid.Role)
+
+	tests := map[string]models.Assignment{
+		"No Team Or Project": {EmployeeID: 3, Allocation: 50, StartDate: start},
+		"Both":               {EmployeeID: 3, TeamID: intPtr(1), ProjectID: intPtr(1), Allocation: 50, StartDate: start},
+		"Over 100":           {EmployeeID: 3, ProjectID: intPtr(1), Allocation: 120, StartDate: start},
+		"No Start":           {EmployeeID: 3, ProjectID: intPtr(1), Allocation: 50},
+		"Ends Before Start":  {EmployeeID: 3, ProjectID: intPtr(1), Allocation: 50, StartDate: start, EndDate: datePtr(start.AddDays(-1))},
+	}
+	for name, a := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, Validate(&a), ErrInvalid)
+		})
+	}
+}
+
+func TestOverallocations(t *testing.T) {
+	june := func(day int) models.Date { return models.NewDate(2025, time.June, day) }
+	assignments := []models.Assignment{
+		{ID: 1, EmployeeID: 3, ProjectID: intPtr(1), Allocation: 60, StartDate: june(2)},
+		{ID: 2, EmployeeID: 3, ProjectID: intPtr(2), Allocation: 50, StartDate: june(9), EndDate: datePtr(june(13))},
+		{ID: 3, EmployeeID: 3, ProjectID: intPtr(3), Allocation: 20, StartDate: june(12)},
+		{ID: 4, EmployeeID: 4, ProjectID: intPtr(1), Allocation: 100, StartDate: june(2)},
+	}
+
+	got := Overallocations(assignments)
+
+	end := june(11)
+	assert.Equal(t, []models.Overallocation{
+		{EmployeeID: 3, From: june(9), To: &end, Allocation: 110, AssignmentIDs: []int{1, 2}},
+		{EmployeeID: 3, From: june(12), To: datePtr(june(13)), Allocation: 130, AssignmentIDs: []int{1, 2, 3}},
+	}, got)
+}
+
+func TestWeekly(t *testing.T) {
+	june := func(day int) models.Date { return models.NewDate(2025, time.June, day) }
+	assignments := []models.Assignment{
+		{EmployeeID: 3, ProjectID: intPtr(1), Allocation: 50, StartDate: june(2)},
+		// Wednesday to Friday of the first week only.
+		{EmployeeID: 3, ProjectID: intPtr(2), Allocation: 100, StartDate: june(4), EndDate: datePtr(june(6))},
+	}
+
+	got := Weekly(assignments, []int{3, 5}, june(4), june(10))
+
+	require.Len(t, got, 4)
+	assert.Equal(t, models.WeeklyCapacity{EmployeeID: 3, WeekStart: june(2), Allocated: 110, Available: 0, Overallocated: true}, got[0])
+	assert.Equal(t, models.WeeklyCapacity{EmployeeID: 3, WeekStart: june(9), Allocated: 50, Available: 50}, got[1])
+	assert.Equal(t, models.WeeklyCapacity{EmployeeID: 5, WeekStart: june(2), Available: 100}, got[2])
+}
@@ -0,0 +1,52 @@
+// Package asset validates the equipment registry.
+package asset
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid asset")
+
+// settable are the statuses an admin may put an asset in directly. Assets
+// only become assigned by being checked out.
+var settable = map[models.AssetStatus]bool{
+	models.AssetStatusAvailable: true,
+	models.AssetStatusInRepair:  true,
+	models.AssetStatusRetired:   true,
+	models.AssetStatusLost:      true,
+}
+
+// Validate trims the asset's fields, lower-cases its type and defaults its
+// status to available.
+func Validate(a *models.Asset) error {
+	a.Type = strings.ToLower(strings.TrimSpace(a.Type))
+	a.SerialNumber = strings.TrimSpace(a.SerialNumber)
+	a.Model = strings.TrimSpace(a.Model)
+	if a.Status == "" {
+		a.Status = models.AssetStatusAvailable
+	}
+	switch {
+	case a.Type == "":
+		return fmt.Errorf("%w: type is required", ErrInvalid)
+	case a.SerialNumber == "":
+		return fmt.Errorf("%w: serialNumber is required", ErrInvalid)
+	case !settable[a.Status]:
+		return fmt.Errorf("%w: status must be available, in_repair, retired or lost", ErrInvalid)
+	}
+	return nil
+}
+
+// ReturnStatus checks the status a returned asset goes into, defaulting to
+// available.
+func ReturnStatus(status models.AssetStatus) (models.AssetStatus, error) {
+	if status == "" {
+		return models.AssetStatusAvailable, nil
+	}
+	if !settable[status] {
+		return "", fmt.Errorf("%w: status must be available, in_repair, retired or lost", ErrInvalid)
+	}
+	return status, nil
+}
@@ -0,0 +1,34 @@
+package asset
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	a := models.Asset{Type: " Laptop ", SerialNumber: " C02XK1 "}
+	require.NoError(t, Validate(&a))
+	assert.Equal(t, "laptop", a.Type)
+	assert.Equal(t, "C02XK1", a.SerialNumber)
+	assert.Equal(t, models.AssetStatusAvailable, a.Status)
+
+	assert.ErrorIs(t, Validate(&models.Asset{SerialNumber: "C02XK1"}), ErrInvalid)
+	assert.ErrorIs(t, Validate(&models.Asset{Type: "badge"}), ErrInvalid)
+	assert.ErrorIs(t, Validate(&models.Asset{Type: "badge", SerialNumber: "B-17", Status: models.AssetStatusAssigned}), ErrInvalid)
+}
+
+func TestReturnStatus(t *testing.T) {
+	status, err := ReturnStatus("")
+	require.NoError(t, err)
+	assert.Equal(t, models.AssetStatusAvailable, status)
+
+	status, err = ReturnStatus(models.AssetStatusInRepair)
+	require.NoError(t, err)
+	assert.Equal(t, models.AssetStatusInRepair, status)
+
+	_, err = ReturnStatus(models.AssetStatusAssigned)
+	assert.ErrorIs(t, err, ErrInvalid)
+}
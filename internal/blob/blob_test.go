@@ -0,0 +1,49 @@
+package blob
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+
+	require.NoError(t, store.Put("employees/7/contract", strings.NewReader("signed")))
+
+	r, err := store.Get("employees/7/contract")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	r.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "signed", string(data))
+
+	require.NoError(t, store.Delete("employees/7/contract"))
+	_, err = store.Get("employees/7/contract")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete("employees/7/contract"))
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestLocalStoreFailedPutLeavesNothing(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+
+	assert.Error(t, store.Put("employees/7/id", io.MultiReader(strings.NewReader("partial"), failingReader{})))
+	_, err := store.Get("employees/7/id")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocalStoreRejectsEscapingKeys(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+
+	for _, key := range []string{"", "../secret", "/etc/passwd", "a/../../b", "a//b"} {
+		assert.ErrorIs(t, store.Put(key, strings.NewReader("x")), ErrInvalidKey, key)
+	}
+}
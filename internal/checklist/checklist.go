@@ -0,0 +1,92 @@
+// Package checklist matches onboarding and offboarding templates to
+// employees and turns them into task lists.
+package checklist
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidTemplate = errors.New("invalid checklist template")
+
+// Validate checks a template before it is stored.
+func Validate(tpl models.ChecklistTemplate) error {
+	if strings.TrimSpace(tpl.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+	if tpl.Kind != models.ChecklistKindOnboarding && tpl.Kind != models.ChecklistKindOffboarding {
+		return fmt.Errorf("%w: kind must be onboarding or offboarding", ErrInvalidTemplate)
+	}
+	if len(tpl.Tasks) == 0 {
+		return fmt.Errorf("%w: at least one task is required", ErrInvalidTemplate)
+	}
+	for i, task := range tpl.Tasks {
+		if strings.TrimSpace(task.Title) == "" {
+			return fmt.Errorf("%w: task %d needs a title", ErrInvalidTemplate, i)
+		}
+		switch task.OwnerRole {
+		case "", models.OwnerRoleManager, models.OwnerRoleEmployee:
+		default:
+			return fmt.Errorf("%w: task %d ownerRole must be manager or employee", ErrInvalidTemplate, i)
+		}
+		if task.OwnerRole != "" && task.OwnerAdminID != nil {
+			return fmt.Errorf("%w: task %d has both an owner admin and an owner role", ErrInvalidTemplate, i)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the template applies to emp for kind.
+func Matches(tpl models.ChecklistTemplate, emp models.Employee, kind models.ChecklistKind) bool {
+	if tpl.Kind != kind {
+		return false
+	}
+	if tpl.DepartmentID != nil && (emp.DepartmentID == nil || *emp.DepartmentID != *tpl.DepartmentID) {
+		return false
+	}
+	return tpl.ContractType == "" || tpl.ContractType == emp.ContractType
+}
+
+// Instantiate builds the employee's checklist from the template, resolving
+// owner roles and due dates relative to start. A manager-owned task for an
+// employee without a manager is left without an owner.
+func Instantiate(tpl models.ChecklistTemplate, emp models.Employee, start models.Date) models.Checklist {
+	list := models.Checklist{
+		EmployeeID: emp.ID,
+		TemplateID: tpl.ID,
+		Name:       tpl.Name,
+		Kind:       tpl.Kind,
+		StartDate:  start,
+		Tasks:      make([]models.ChecklistTask, 0, len(tpl.Tasks)),
+	}
+	for _, t := range tpl.Tasks {
+		task := models.ChecklistTask{
+			EmployeeID:   emp.ID,
+			Title:        t.Title,
+			OwnerAdminID: t.OwnerAdminID,
+			DueDate:      start.AddDays(t.DueDays),
+		}
+		switch t.OwnerRole {
+		case models.OwnerRoleManager:
+			task.OwnerEmployeeID = emp.ManagerID
+		case models.OwnerRoleEmployee:
+			id := emp.ID
+			task.OwnerEmployeeID = &id
+		}
+		list.Tasks = append(list.Tasks, task)
+	}
+	return list
+}
+
+// For instantiates every template that matches emp for kind.
+func For(templates []models.ChecklistTemplate, emp models.Employee, kind models.ChecklistKind, start models.Date) []models.Checklist {
+	var lists []models.Checklist
+	for _, tpl := range templates {
+		if Matches(tpl, emp, kind) {
+			lists = append(lists, Instantiate(tpl, emp, start))
+		}
+	}
+	return lists
+}
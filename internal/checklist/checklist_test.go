@@ -0,0 +1,68 @@
+package checklist
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestFor(t *testing.T) {
+	templates := []models.ChecklistTemplate{
+		{ID: 1, Name: "Everyone", Kind: models.ChecklistKindOnboarding, Tasks: []models.TemplateTask{
+			{Title: "Create accounts", OwnerAdminID: intPtr(9), DueDays: -2},
+			{Title: "Meet the team", OwnerRole: models.OwnerRoleManager, DueDays: 5},
+			{Title: "Read handbook", OwnerRole: models.OwnerRoleEmployee, DueDays: 7},
+		}},
+		{ID: 2, Name: "Engineering", Kind: models.ChecklistKindOnboarding, DepartmentID: intPtr(4),
+			Tasks: []models.TemplateTask{{Title: "Ship laptop", DueDays: 0}}},
+		{ID: 3, Name: "Contractors", Kind: models.ChecklistKindOnboarding, ContractType: "contractor",
+			Tasks: []models.TemplateTask{{Title: "Sign NDA"}}},
+		{ID: 4, Name: "Leavers", Kind: models.ChecklistKindOffboarding,
+			Tasks: []models.TemplateTask{{Title: "Collect laptop"}}},
+	}
+	emp := models.Employee{ID: 7, DepartmentID: intPtr(4), ManagerID: intPtr(2), ContractType: "permanent"}
+	start := models.NewDate(2025, time.June, 2)
+
+	lists := For(templates, emp, models.ChecklistKindOnboarding, start)
+	require.Len(t, lists, 2)
+	assert.Equal(t, "Everyone", lists[0].Name)
+	assert.Equal(t, "Engineering", lists[1].Name)
+
+	tasks := lists[0].Tasks
+	assert.Equal(t, models.NewDate(2025, time.May, 31), tasks[0].DueDate)
+	assert.Equal(t, 9, *tasks[0].OwnerAdminID)
+	assert.Equal(t, 2, *tasks[1].OwnerEmployeeID)
+	assert.Equal(t, 7, *tasks[2].OwnerEmployeeID)
+	assert.Equal(t, 7, tasks[2].EmployeeID)
+
+	leaving := For(templates, emp, models.ChecklistKindOffboarding, start)
+	require.Len(t, leaving, 1)
+	assert.Equal(t, "Collect laptop", leaving[0].Tasks[0].Title)
+}
+
+func TestValidate(t *testing.T) {
+	valid := models.ChecklistTemplate{Name: "Starters", Kind: models.ChecklistKindOnboarding,
+		Tasks: []models.TemplateTask{{Title: "Badge"}}}
+	assert.NoError(t, Validate(valid))
+
+	noTasks := valid
+	noTasks.Tasks = nil
+	assert.ErrorIs(t, Validate(noTasks), ErrInvalidTemplate)
+
+	badRole := valid
+	badRole.Tasks = []models.TemplateTask{{Title: "Badge", OwnerRole: "janitor"}}
+	assert.ErrorIs(t, Validate(badRole), ErrInvalidTemplate)
+
+	twoOwners := valid
+	twoOwners.Tasks = []models.TemplateTask{{Title: "Badge", OwnerRole: models.OwnerRoleManager, OwnerAdminID: intPtr(1)}}
+	assert.ErrorIs(t, Validate(twoOwners), ErrInvalidTemplate)
+
+	badKind := valid
+	badKind.Kind = "transfer"
+	assert.ErrorIs(t, Validate(badKind), ErrInvalidTemplate)
+}
@@ -0,0 +1,119 @@
+// Package compensation validates pay records and converts base pay between
+// pay frequencies.
+package compensation
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrInvalidCurrency  = errors.New("currency must be a three-letter ISO 4217 code")
+	ErrInvalidFrequency = errors.New("payFrequency must be hourly, weekly, biweekly, monthly or annual")
+	ErrInvalidAmount    = errors.New("amount must be positive")
+)
+
+// HoursPerYear converts hourly rates, assuming a 40 hour week.
+const HoursPerYear = 52 * 40
+
+// periodsPerYear is how many times a year each frequency pays out.
+var periodsPerYear = map[models.PayFrequency]int64{
+	models.PayFrequencyHourly:   HoursPerYear,
+	models.PayFrequencyWeekly:   52,
+	models.PayFrequencyBiweekly: 26,
+	models.PayFrequencyMonthly:  12,
+	models.PayFrequencyAnnual:   1,
+}
+
+// ValidCurrency reports whether code looks like an ISO 4217 currency code.
+func ValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// decimals holds the currencies whose minor unit is not a hundredth.
+var decimals = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "OMR": 3, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// Decimals returns the number of digits after the decimal point in
+// amounts of currency.
+func Decimals(currency string) int {
+	if d, ok := decimals[currency]; ok {
+		return d
+	}
+	return 2
+}
+
+// FormatAmount writes an amount in minor units as a decimal, such as
+// 1234 USD as "12.34".
+func FormatAmount(amount int64, currency string) string {
+	d := Decimals(currency)
+	if d == 0 {
+		return strconv.FormatInt(amount, 10)
+	}
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	unit := int64(math.Pow10(d))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/unit, d, amount%unit)
+}
+
+// Validate checks a compensation record before it is stored.
+func Validate(c models.Compensation) error {
+	if c.BasePay <= 0 {
+		return ErrInvalidAmount
+	}
+	if !ValidCurrency(c.Currency) {
+		return ErrInvalidCurrency
+	}
+	if _, ok := periodsPerYear[c.PayFrequency]; !ok {
+		return ErrInvalidFrequency
+	}
+	return nil
+}
+
+// ValidateBonus checks a bonus before it is stored.
+func ValidateBonus(b models.Bonus) error {
+	if b.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	if !ValidCurrency(b.Currency) {
+		return ErrInvalidCurrency
+	}
+	return nil
+}
+
+// PeriodsPerYear returns how many times a year frequency pays out, or 0
+// for an unknown frequency.
+func PeriodsPerYear(frequency models.PayFrequency) int64 {
+	return periodsPerYear[frequency]
+}
+
+// Annual returns base pay scaled to a year.
+func Annual(c models.Compensation) int64 {
+	return c.BasePay * periodsPerYear[c.PayFrequency]
+}
+
+// PerPeriod converts base pay to the amount paid per period of the given
+// frequency, rounding to the nearest minor unit.
+func PerPeriod(c models.Compensation, frequency models.PayFrequency) int64 {
+	periods := periodsPerYear[frequency]
+	if periods == 0 {
+		return 0
+	}
+	annual := Annual(c)
+	return (annual + periods/2) / periods
+}
@@ -0,0 +1,46 @@
+package compensation
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		comp models.Compensation
+		want error
+	}{
+		{"Valid", models.Compensation{BasePay: 500000, Currency: "USD", PayFrequency: models.PayFrequencyMonthly}, nil},
+		{"Zero Pay", models.Compensation{Currency: "USD", PayFrequency: models.PayFrequencyMonthly}, ErrInvalidAmount},
+		{"Lowercase Currency", models.Compensation{BasePay: 1, Currency: "usd", PayFrequency: models.PayFrequencyMonthly}, ErrInvalidCurrency},
+		{"Unknown Frequency", models.Compensation{BasePay: 1, Currency: "EUR", PayFrequency: "daily"}, ErrInvalidFrequency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Validate(tt.comp))
+		})
+	}
+}
+
+func TestAnnualAndPerPeriod(t *testing.T) {
+	monthly := models.Compensation{BasePay: 500000, Currency: "USD", PayFrequency: models.PayFrequencyMonthly}
+	assert.Equal(t, int64(6000000), Annual(monthly))
+	assert.Equal(t, int64(230769), PerPeriod(monthly, models.PayFrequencyBiweekly))
+	assert.Equal(t, int64(500000), PerPeriod(monthly, models.PayFrequencyMonthly))
+
+	hourly := models.Compensation{BasePay: 2500, Currency: "USD", PayFrequency: models.PayFrequencyHourly}
+	assert.Equal(t, int64(5200000), Annual(hourly))
+	assert.Equal(t, int64(100000), PerPeriod(hourly, models.PayFrequencyWeekly))
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "12.34", FormatAmount(1234, "USD"))
+	assert.Equal(t, "0.05", FormatAmount(5, "EUR"))
+	assert.Equal(t, "-1.50", FormatAmount(-150, "GBP"))
+	assert.Equal(t, "1500", FormatAmount(1500, "JPY"))
+	assert.Equal(t, "1.250", FormatAmount(1250, "KWD"))
+}
@@ -0,0 +1,62 @@
+// Package contract validates employment contracts and checks that an
+// employee's contracts do not overlap.
+package contract
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid contract")
+
+// ValidType reports whether t is a known contract type.
+func ValidType(t models.ContractType) bool {
+	switch t {
+	case models.ContractTypePermanent, models.ContractTypeFixedTerm, models.ContractTypeContractor:
+		return true
+	}
+	return false
+}
+
+// Validate trims the contract's notes and checks its type and dates.
+// Fixed-term contracts need an end date and permanent ones may not have
+// one; probation must end within the contract.
+func Validate(c *models.Contract) error {
+	c.Notes = strings.TrimSpace(c.Notes)
+	switch {
+	case !ValidType(c.Type):
+		return fmt.Errorf("%w: unknown type %q", ErrInvalid, c.Type)
+	case c.StartDate.IsZero():
+		return fmt.Errorf("%w: startDate is required", ErrInvalid)
+	case c.Type == models.ContractTypeFixedTerm && c.EndDate == nil:
+		return fmt.Errorf("%w: a fixed-term contract needs an endDate", ErrInvalid)
+	case c.Type == models.ContractTypePermanent && c.EndDate != nil:
+		return fmt.Errorf("%w: a permanent contract has no endDate", ErrInvalid)
+	case c.EndDate != nil && c.EndDate.Before(c.StartDate.Time):
+		return fmt.Errorf("%w: endDate is before startDate", ErrInvalid)
+	case c.NoticePeriodDays < 0:
+		return fmt.Errorf("%w: noticePeriodDays cannot be negative", ErrInvalid)
+	}
+	if p := c.ProbationEndsOn; p != nil && (p.Before(c.StartDate.Time) || (c.EndDate != nil && p.After(c.EndDate.Time))) {
+		return fmt.Errorf("%w: probationEndsOn must fall within the contract", ErrInvalid)
+	}
+	return nil
+}
+
+// Overlapping returns the first of contracts, other than c itself, whose
+// dates overlap c's, or nil when there is none.
+func Overlapping(contracts []models.Contract, c models.Contract) *models.Contract {
+	for i, other := range contracts {
+		if other.ID == c.ID {
+			continue
+		}
+		startsBeforeEnd := c.EndDate == nil || !other.StartDate.After(c.EndDate.Time)
+		endsAfterStart := other.EndDate == nil || !other.EndDate.Before(c.StartDate.Time)
+		if startsBeforeEnd && endsAfterStart {
+			return &contracts[i]
+		}
+	}
+	return nil
+}
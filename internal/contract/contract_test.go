@@ -0,0 +1,64 @@
+package contract
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func datePtr(d models.Date) *models.Date { return &d }
+
+func TestValidate(t *testing.T) {
+	start := models.NewDate(2025, time.January, 1)
+	end := models.NewDate(2025, time.December, 31)
+	tests := []struct {
+		name     string
+		contract models.Contract
+		wantErr  bool
+	}{
+		{name: "Permanent", contract: models.Contract{Type: models.ContractTypePermanent, StartDate: start,
+			NoticePeriodDays: 30, ProbationEndsOn: datePtr(start.AddDays(90))}},
+		{name: "Fixed Term", contract: models.Contract{Type: models.ContractTypeFixedTerm, StartDate: start, EndDate: &end}},
+		{name: "Open Contractor", contract: models.Contract{Type: models.ContractTypeContractor, StartDate: start}},
+		{name: "Unknown Type", contract: models.Contract{Type: "casual", StartDate: start}, wantErr: true},
+		{name: "No Start", contract: models.Contract{Type: models.ContractTypePermanent}, wantErr: true},
+		{name: "Fixed Term Without End", contract: models.Contract{Type: models.ContractTypeFixedTerm, StartDate: start}, wantErr: true},
+		{name: "Permanent With End", contract: models.Contract{Type: models.ContractTypePermanent, StartDate: start, EndDate: &end}, wantErr: true},
+		{name: "Ends Before Start", contract: models.Contract{Type: models.ContractTypeFixedTerm, StartDate: end, EndDate: &start}, wantErr: true},
+		{name: "Negative Notice", contract: models.Contract{Type: models.ContractTypePermanent, StartDate: start, NoticePeriodDays: -1}, wantErr: true},
+		{name: "Probation After End", contract: models.Contract{Type: models.ContractTypeFixedTerm, StartDate: start, EndDate: &end,
+			ProbationEndsOn: datePtr(end.AddDays(1))}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.contract)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOverlapping(t *testing.T) {
+	contracts := []models.Contract{
+		{ID: 1, StartDate: models.NewDate(2024, time.January, 1), EndDate: datePtr(models.NewDate(2024, time.December, 31))},
+		{ID: 2, StartDate: models.NewDate(2025, time.July, 1)},
+	}
+
+	renewal := models.Contract{StartDate: models.NewDate(2025, time.January, 1), EndDate: datePtr(models.NewDate(2025, time.June, 30))}
+	assert.Nil(t, Overlapping(contracts, renewal))
+
+	early := models.Contract{StartDate: models.NewDate(2024, time.December, 31), EndDate: datePtr(models.NewDate(2025, time.June, 30))}
+	assert.Equal(t, 1, Overlapping(contracts, early).ID)
+
+	open := models.Contract{StartDate: models.NewDate(2025, time.January, 1)}
+	assert.Equal(t, 2, Overlapping(contracts, open).ID)
+
+	moved := models.Contract{ID: 2, StartDate: models.NewDate(2025, time.August, 1)}
+	assert.Nil(t, Overlapping(contracts, moved))
+}
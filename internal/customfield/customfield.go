@@ -0,0 +1,179 @@
+// Package customfield validates admin-defined employee fields and the
+// values stored in them.
+package customfield
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalid = errors.New("invalid custom field")
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]{0,62}$`)
+
+// ValidateDefinition checks that def is well formed and that each rule
+// suits its type.
+func ValidateDefinition(def models.CustomFieldDefinition) error {
+	if !keyPattern.MatchString(def.Key) {
+		return fmt.Errorf("%w: key must start with a lower-case letter and contain only letters, digits and underscores", ErrInvalid)
+	}
+
+	switch def.Type {
+	case models.CustomFieldString, models.CustomFieldNumber, models.CustomFieldDate, models.CustomFieldEnum:
+	default:
+		return fmt.Errorf("%w: type must be string, number, date or enum", ErrInvalid)
+	}
+
+	if (def.Pattern != "" || def.MaxLength != nil) && def.Type != models.CustomFieldString {
+		return fmt.Errorf("%w: pattern and maxLength only apply to strings", ErrInvalid)
+	}
+	if def.Pattern != "" {
+		if _, err := regexp.Compile(def.Pattern); err != nil {
+			return fmt.Errorf("%w: invalid pattern: %v", ErrInvalid, err)
+		}
+	}
+	if def.MaxLength != nil && *def.MaxLength <= 0 {
+		return fmt.Errorf("%w: maxLength must be positive", ErrInvalid)
+	}
+
+	if (def.Min != nil || def.Max != nil) && def.Type != models.CustomFieldNumber {
+		return fmt.Errorf("%w: min and max only apply to numbers", ErrInvalid)
+	}
+	if def.Min != nil && def.Max != nil && *def.Min > *def.Max {
+		return fmt.Errorf("%w: min must not exceed max", ErrInvalid)
+	}
+
+	if def.Type != models.CustomFieldEnum {
+		if len(def.Options) > 0 {
+			return fmt.Errorf("%w: options only apply to enums", ErrInvalid)
+		}
+		return nil
+	}
+	if len(def.Options) == 0 {
+		return fmt.Errorf("%w: an enum needs options", ErrInvalid)
+	}
+	seen := map[string]bool{}
+	for _, option := range def.Options {
+		if option == "" || seen[option] {
+			return fmt.Errorf("%w: options must be distinct and non-empty", ErrInvalid)
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+// Validate checks values against defs and returns them normalized: numbers
+// as float64 and dates as YYYY-MM-DD. Unknown keys are rejected, nil
+// values are dropped and every required field must be present.
+func Validate(defs []models.CustomFieldDefinition, values map[string]any) (map[string]any, error) {
+	byKey := make(map[string]models.CustomFieldDefinition, len(defs))
+	for _, def := range defs {
+		byKey[def.Key] = def
+	}
+
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		def, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalid, key)
+		}
+		if value == nil {
+			continue
+		}
+		normalized, err := check(def, value)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = normalized
+	}
+
+	for _, def := range defs {
+		if _, ok := out[def.Key]; def.Required && !ok {
+			return nil, fmt.Errorf("%w: %s is required", ErrInvalid, def.Key)
+		}
+	}
+	return out, nil
+}
+
+// Parse converts text, as found in a CSV cell or query string, to a value
+// of def's type and validates it.
+func Parse(def models.CustomFieldDefinition, text string) (any, error) {
+	if def.Type != models.CustomFieldNumber {
+		return check(def, text)
+	}
+	number, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a number", ErrInvalid, def.Key)
+	}
+	return check(def, number)
+}
+
+// Format renders a stored value as text, the inverse of Parse.
+func Format(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func check(def models.CustomFieldDefinition, value any) (any, error) {
+	switch def.Type {
+	case models.CustomFieldNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be a number", ErrInvalid, def.Key)
+		}
+		if def.Min != nil && number < *def.Min {
+			return nil, fmt.Errorf("%w: %s must be at least %s", ErrInvalid, def.Key, Format(*def.Min))
+		}
+		if def.Max != nil && number > *def.Max {
+			return nil, fmt.Errorf("%w: %s must be at most %s", ErrInvalid, def.Key, Format(*def.Max))
+		}
+		return number, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a string", ErrInvalid, def.Key)
+	}
+	switch def.Type {
+	case models.CustomFieldDate:
+		date, err := models.ParseDate(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be a date (YYYY-MM-DD)", ErrInvalid, def.Key)
+		}
+		return date.String(), nil
+	case models.CustomFieldEnum:
+		for _, option := range def.Options {
+			if text == option {
+				return text, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %s must be one of %s", ErrInvalid, def.Key, strings.Join(def.Options, ", "))
+	}
+
+	if def.MaxLength != nil && utf8.RuneCountInString(text) > *def.MaxLength {
+		return nil, fmt.Errorf("%w: %s must be at most %d characters", ErrInvalid, def.Key, *def.MaxLength)
+	}
+	if def.Pattern != "" {
+		pattern, err := regexp.Compile(def.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s has an invalid pattern: %v", ErrInvalid, def.Key, err)
+		}
+		if !pattern.MatchString(text) {
+			return nil, fmt.Errorf("%w: %s does not match %s", ErrInvalid, def.Key, def.Pattern)
+		}
+	}
+	return text, nil
+}
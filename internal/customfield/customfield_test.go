@@ -0,0 +1,106 @@
+package customfield
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int           { return &i }
+func floatPtr(f float64) *float64 { return &f }
+
+var defs = []models.CustomFieldDefinition{
+	{Key: "tshirtSize", Type: models.CustomFieldEnum, Options: []string{"S", "M", "L"}},
+	{Key: "badgeNumber", Type: models.CustomFieldNumber, Required: true, Min: floatPtr(1), Max: floatPtr(99999)},
+	{Key: "githubHandle", Type: models.CustomFieldString, Pattern: `^[A-Za-z0-9-]+$`, MaxLength: intPtr(39)},
+	{Key: "badgeIssued", Type: models.CustomFieldDate},
+}
+
+func TestValidateDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		def     models.CustomFieldDefinition
+		wantErr bool
+	}{
+		{name: "String", def: models.CustomFieldDefinition{Key: "github_handle", Type: models.CustomFieldString, Pattern: `^\w+$`}},
+		{name: "Enum", def: models.CustomFieldDefinition{Key: "tshirtSize", Type: models.CustomFieldEnum, Options: []string{"S", "M"}}},
+		{name: "Bad Key", def: models.CustomFieldDefinition{Key: "T-shirt", Type: models.CustomFieldString}, wantErr: true},
+		{name: "Unknown Type", def: models.CustomFieldDefinition{Key: "a", Type: "bool"}, wantErr: true},
+		{name: "Enum Without Options", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldEnum}, wantErr: true},
+		{name: "Duplicate Options", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldEnum, Options: []string{"S", "S"}}, wantErr: true},
+		{name: "Options On String", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldString, Options: []string{"S"}}, wantErr: true},
+		{name: "Bad Pattern", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldString, Pattern: `(`}, wantErr: true},
+		{name: "Min On Date", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldDate, Min: floatPtr(1)}, wantErr: true},
+		{name: "Min Above Max", def: models.CustomFieldDefinition{Key: "a", Type: models.CustomFieldNumber, Min: floatPtr(2), Max: floatPtr(1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDefinition(tt.def)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name:   "All Fields",
+			values: map[string]any{"tshirtSize": "M", "badgeNumber": 1234.0, "githubHandle": "octo-cat", "badgeIssued": "2025-03-01"},
+			want:   map[string]any{"tshirtSize": "M", "badgeNumber": 1234.0, "githubHandle": "octo-cat", "badgeIssued": "2025-03-01"},
+		},
+		{
+			name:   "Nil Dropped",
+			values: map[string]any{"badgeNumber": 7.0, "tshirtSize": nil},
+			want:   map[string]any{"badgeNumber": 7.0},
+		},
+		{name: "Missing Required", values: map[string]any{"tshirtSize": "M"}, wantErr: true},
+		{name: "Unknown Field", values: map[string]any{"badgeNumber": 7.0, "shoeSize": 42.0}, wantErr: true},
+		{name: "Not In Enum", values: map[string]any{"badgeNumber": 7.0, "tshirtSize": "XXL"}, wantErr: true},
+		{name: "Number As String", values: map[string]any{"badgeNumber": "7"}, wantErr: true},
+		{name: "Below Min", values: map[string]any{"badgeNumber": 0.0}, wantErr: true},
+		{name: "Pattern Mismatch", values: map[string]any{"badgeNumber": 7.0, "githubHandle": "octo cat"}, wantErr: true},
+		{name: "Too Long", values: map[string]any{"badgeNumber": 7.0, "githubHandle": "a123456789a123456789a123456789a123456789"}, wantErr: true},
+		{name: "Bad Date", values: map[string]any{"badgeNumber": 7.0, "badgeIssued": "01/03/2025"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Validate(defs, tt.values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAndFormat(t *testing.T) {
+	value, err := Parse(defs[1], " 1234 ")
+	require.NoError(t, err)
+	assert.Equal(t, 1234.0, value)
+	assert.Equal(t, "1234", Format(value))
+
+	_, err = Parse(defs[1], "lots")
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	value, err = Parse(defs[0], "L")
+	require.NoError(t, err)
+	assert.Equal(t, "L", Format(value))
+
+	assert.Equal(t, "", Format(nil))
+	assert.Equal(t, "0.5", Format(0.5))
+}
@@ -1,17 +1,240 @@
 package db
 
-import "employees/internal/models"
+import (
+	"employees/internal/models"
+	"errors"
+	"time"
+)
+
+// ErrConflict is returned when a record changed between being read and
+// being written, e.g. a leave request decided by two approvers at once.
+var ErrConflict = errors.New("record was modified concurrently")
 
 //go:generate mockery --name Database
 type Database interface {
 	CreateEmployee(emp *models.Employee) error
+	CreateEmployees(emps []models.Employee) error
+	FindEmployeeEmails(emails []string) ([]string, error)
 	GetEmployee(id string) (*models.Employee, error)
 	UpdateEmployee(emp *models.Employee) error
 	DeleteEmployee(id string) error
+	ListEmployees(filter models.EmployeeFilter) ([]models.Employee, error)
+	SearchEmployees(query string, limit int) ([]models.EmployeeSearchResult, error)
+	GetDirectReports(managerID int) ([]models.Employee, error)
+	GetReportingChain(id int) ([]models.Employee, error)
+	GetSubordinates(managerID int) ([]models.Employee, error)
+	AddPosition(pos *models.Position) error
+	GetPositions(employeeID int) ([]models.Position, error)
+	GetPositionAsOf(employeeID int, date models.Date) (*models.Position, error)
+	ActivatePositions(date models.Date) (int, error)
+	CreateDepartment(dept *models.Department) error
+	GetDepartment(id int) (*models.Department, error)
+	ListDepartments() ([]models.Department, error)
+	UpdateDepartment(dept *models.Department) error
+	DeleteDepartment(id int) error
 	CreateAdmin(admin *models.Admin) error
 	GetAdmin(email string) (*models.Admin, error)
 	GetAdminByEmail(email string) (*models.Admin, error)
 	UpdateAdmin(admin *models.Admin) error
 	DeleteAdmin(email string) error
+	GetAdminByID(id int) (*models.Admin, error)
+	CreateLeaveType(lt *models.LeaveType) error
+	GetLeaveType(id int) (*models.LeaveType, error)
+	ListLeaveTypes() ([]models.LeaveType, error)
+	SetAccrualPolicy(policy *models.AccrualPolicy) error
+	GetAccrualPolicies(employeeID int) ([]models.AccrualPolicy, error)
+	CreateLeaveRequest(req *models.LeaveRequest) error
+	GetLeaveRequest(id int) (*models.LeaveRequest, error)
+	ListLeaveRequests(employeeID int, status models.LeaveStatus) ([]models.LeaveRequest, error)
+	DecideLeaveRequest(req *models.LeaveRequest, from models.LeaveStatus, adj *models.BalanceAdjustment) error
+	AddBalanceAdjustment(adj *models.BalanceAdjustment) error
+	GetBalanceAdjustments(employeeID int) ([]models.BalanceAdjustment, error)
+	CreateLocation(loc *models.Location) error
+	GetLocation(id int) (*models.Location, error)
+	ListLocations() ([]models.Location, error)
+	UpdateLocation(loc *models.Location) error
+	DeleteLocation(id int) error
+	CreateHolidayCalendar(cal *models.HolidayCalendar) error
+	GetHolidayCalendar(id int) (*models.HolidayCalendar, error)
+	ListHolidayCalendars() ([]models.HolidayCalendar, error)
+	DeleteHolidayCalendar(id int) error
+	AddHolidays(holidays []models.Holiday) error
+	AddHolidayRule(rule *models.HolidayRule) error
+	ListHolidayRules(calendarID int) ([]models.HolidayRule, error)
+	ListCalendarHolidays(calendarID int, from, to models.Date) ([]models.Holiday, error)
+	GetHolidays(employeeID int, from, to models.Date) ([]models.Holiday, error)
+	CreateProject(project *models.Project) error
+	GetProject(id int) (*models.Project, error)
+	ListProjects() ([]models.Project, error)
+	CreateTimeEntry(entry *models.TimeEntry) error
+	GetTimeEntry(id int) (*models.TimeEntry, error)
+	UpdateTimeEntry(entry *models.TimeEntry) error
+	DeleteTimeEntry(id int) error
+	GetOpenTimeEntry(employeeID int) (*models.TimeEntry, error)
+	ListTimeEntries(filter models.TimeEntryFilter) ([]models.TimeEntry, error)
+	GetTimesheet(employeeID int, weekStart models.Date) (*models.Timesheet, error)
+	GetTimesheetByID(id int) (*models.Timesheet, error)
+	SaveTimesheet(ts *models.Timesheet) error
+	ListTimesheets(status models.TimesheetStatus) ([]models.Timesheet, error)
+	GetOvertimePolicy() (*models.OvertimePolicy, error)
+	SetOvertimePolicy(policy *models.OvertimePolicy) error
+	AddCompensation(comp *models.Compensation) error
+	GetCompensationHistory(employeeID int) ([]models.Compensation, error)
+	GetCompensationAsOf(employeeID int, date models.Date) (*models.Compensation, error)
+	AddBonus(bonus *models.Bonus) error
+	ListBonuses(employeeID int) ([]models.Bonus, error)
+	CreatePayrollRun(run *models.PayrollRun) error
+	GetPayrollRun(id int) (*models.PayrollRun, error)
+	ListPayrollRuns() ([]models.PayrollRun, error)
+	RecalculatePayrollRun(run *models.PayrollRun) error
+	LockPayrollRun(id int, adminID *int) error
+	DeletePayrollRun(id int) error
+	GetPayslip(runID, employeeID int) (*models.Payslip, error)
+	RecordStatusTransition(tr *models.StatusTransition) error
+	ListStatusTransitions(employeeID int) ([]models.StatusTransition, error)
+	GetPendingStatusTransition(employeeID int) (*models.StatusTransition, error)
+	DueStatusTransitions(date models.Date) ([]models.StatusTransition, error)
+	ApplyStatusTransition(tr *models.StatusTransition) error
+	CancelStatusTransition(id int) error
+	CreateChecklistTemplate(tpl *models.ChecklistTemplate) error
+	GetChecklistTemplate(id int) (*models.ChecklistTemplate, error)
+	ListChecklistTemplates(kind models.ChecklistKind) ([]models.ChecklistTemplate, error)
+	UpdateChecklistTemplate(tpl *models.ChecklistTemplate) error
+	DeleteChecklistTemplate(id int) error
+	StartChecklists(emp *models.Employee, kind models.ChecklistKind, start models.Date) ([]models.Checklist, error)
+	CreateChecklist(list *models.Checklist) error
+	ListChecklists(employeeID int) ([]models.Checklist, error)
+	GetChecklistTask(id int) (*models.ChecklistTask, error)
+	UpdateChecklistTask(task *models.ChecklistTask) error
+	ListChecklistTasks(filter models.ChecklistTaskFilter) ([]models.ChecklistTask, error)
+	CreateDocument(doc *models.Document) error
+	GetDocument(id int) (*models.Document, error)
+	ListDocuments(employeeID int, category models.DocumentCategory) ([]models.Document, error)
+	ListExpiringDocuments(date models.Date) ([]models.Document, error)
+	DeleteDocument(id int) error
+	SetEmployeePhoto(employeeID int, url string) error
+	ListAddresses(employeeID int) ([]models.Address, error)
+	SetAddresses(employeeID int, addrs []models.Address) error
+	DeleteAddress(employeeID int, addrType models.AddressType) error
+	ListAddressesNeedingReview() ([]models.Address, error)
+	ListEmergencyContacts(employeeID int) ([]models.EmergencyContact, error)
+	GetEmergencyContact(id int) (*models.EmergencyContact, error)
+	CreateEmergencyContact(contact *models.EmergencyContact) error
+	UpdateEmergencyContact(contact *models.EmergencyContact) error
+	DeleteEmergencyContact(id int) error
+	ReorderEmergencyContacts(employeeID int, ids []int) error
+	CreateCustomField(def *models.CustomFieldDefinition) error
+	GetCustomField(id int) (*models.CustomFieldDefinition, error)
+	ListCustomFields() ([]models.CustomFieldDefinition, error)
+	UpdateCustomField(def *models.CustomFieldDefinition) error
+	DeleteCustomField(id int) error
+	CreateSkill(skill *models.Skill) error
+	GetSkill(id int) (*models.Skill, error)
+	ListSkills() ([]models.Skill, error)
+	UpdateSkill(skill *models.Skill) error
+	DeleteSkill(id int) error
+	SetEmployeeSkill(es *models.EmployeeSkill) error
+	ListEmployeeSkills(employeeID int) ([]models.EmployeeSkill, error)
+	DeleteEmployeeSkill(employeeID, skillID int) error
+	FindEmployeesBySkills(reqs []models.SkillRequirement) ([]models.Employee, error)
+	CreateCertification(cert *models.Certification) error
+	GetCertification(id int) (*models.Certification, error)
+	ListCertifications(employeeID int) ([]models.Certification, error)
+	UpdateCertification(cert *models.Certification) error
+	DeleteCertification(id int) error
+	ListExpiringCertifications(date models.Date) ([]models.Certification, error)
+	MarkCertificationsAlerted(ids []int, date models.Date) error
+	CreateReviewTemplate(tpl *models.ReviewTemplate) error
+	GetReviewTemplate(id int) (*models.ReviewTemplate, error)
+	ListReviewTemplates() ([]models.ReviewTemplate, error)
+	UpdateReviewTemplate(tpl *models.ReviewTemplate) error
+	DeleteReviewTemplate(id int) error
+	CreateReviewCycle(cycle *models.ReviewCycle) error
+	GetReviewCycle(id int) (*models.ReviewCycle, error)
+	ListReviewCycles(status models.ReviewCycleStatus) ([]models.ReviewCycle, error)
+	DeleteReviewCycle(id int) error
+	OpenReviewCycle(id int, reviews []models.Review) error
+	AdvanceReviewCycle(id int, from, to models.ReviewCycleStatus) error
+	ListReviews(filter models.ReviewFilter) ([]models.Review, error)
+	GetReview(id int) (*models.Review, error)
+	UpdateReview(rev *models.Review) error
+	ListReviewsToRemind(date models.Date) ([]models.Review, error)
+	MarkReviewsReminded(ids []int, date models.Date) error
+	ListReviewCalibrations(cycleID int) ([]models.ReviewCalibration, error)
+	SetReviewCalibration(c *models.ReviewCalibration) error
+	CreateObjective(obj *models.Objective) error
+	GetObjective(id int) (*models.Objective, error)
+	ListObjectives(filter models.ObjectiveFilter) ([]models.Objective, error)
+	ListObjectiveTree(id int) ([]models.Objective, error)
+	UpdateObjective(obj *models.Objective) error
+	DeleteObjective(id int) error
+	CreateKeyResult(kr *models.KeyResult) error
+	GetKeyResult(id int) (*models.KeyResult, error)
+	UpdateKeyResult(kr *models.KeyResult) error
+	DeleteKeyResult(id int) error
+	RecordCheckIn(ci *models.CheckIn) error
+	ListCheckIns(keyResultID int) ([]models.CheckIn, error)
+	CreateTeam(team *models.Team) error
+	GetTeam(id int) (*models.Team, error)
+	ListTeams() ([]models.Team, error)
+	UpdateTeam(team *models.Team) error
+	DeleteTeam(id int) error
+	CreateAssignment(a *models.Assignment) error
+	GetAssignment(id int) (*models.Assignment, error)
+	ListAssignments(filter models.AssignmentFilter) ([]models.Assignment, error)
+	UpdateAssignment(a *models.Assignment) error
+	DeleteAssignment(id int) error
+	CreateAsset(asset *models.Asset) error
+	GetAsset(id int) (*models.Asset, error)
+	ListAssets(filter models.AssetFilter) ([]models.Asset, error)
+	UpdateAsset(asset *models.Asset) error
+	CheckOutAsset(a *models.AssetAssignment) error
+	CheckInAsset(assetID int, status models.AssetStatus, adminID *int, note string) (*models.AssetAssignment, error)
+	ListAssetAssignments(filter models.AssetAssignmentFilter) ([]models.AssetAssignment, error)
+	CreateRequisition(req *models.Requisition) error
+	GetRequisition(id int) (*models.Requisition, error)
+	ListRequisitions(status models.RequisitionStatus) ([]models.Requisition, error)
+	UpdateRequisition(req *models.Requisition) error
+	DeleteRequisition(id int) error
+	CreatePipelineStage(stage *models.PipelineStage) error
+	GetPipelineStage(id int) (*models.PipelineStage, error)
+	ListPipelineStages() ([]models.PipelineStage, error)
+	UpdatePipelineStage(stage *models.PipelineStage) error
+	DeletePipelineStage(id int) error
+	CreateCandidate(c *models.Candidate) error
+	GetCandidate(id int) (*models.Candidate, error)
+	ListCandidates(filter models.CandidateFilter) ([]models.Candidate, error)
+	UpdateCandidate(c *models.Candidate) error
+	CreateInterviewFeedback(f *models.InterviewFeedback) error
+	ListInterviewFeedback(candidateID int) ([]models.InterviewFeedback, error)
+	HireCandidate(c *models.Candidate, emp *models.Employee, tr *models.StatusTransition) error
+	CreatePlannedPosition(pos *models.PlannedPosition) error
+	GetPlannedPosition(id int) (*models.PlannedPosition, error)
+	ListPlannedPositions(filter models.PlannedPositionFilter) ([]models.PlannedPosition, error)
+	UpdatePlannedPosition(pos *models.PlannedPosition) error
+	DeletePlannedPosition(id int) error
+	FillPlannedPosition(id, employeeID int) error
+	VacatePlannedPosition(id int) error
+	ListPositionsBetween(from, to models.Date) ([]models.Position, error)
+	ListAppliedStatusTransitions(employeeIDs []int) ([]models.StatusTransition, error)
+	CreateExpenseCategory(c *models.ExpenseCategory) error
+	ListExpenseCategories() ([]models.ExpenseCategory, error)
+	UpdateExpenseCategory(c *models.ExpenseCategory) error
+	CreateExpenseClaim(claim *models.ExpenseClaim) error
+	GetExpenseClaim(id int) (*models.ExpenseClaim, error)
+	ListExpenseClaims(filter models.ExpenseClaimFilter) ([]models.ExpenseClaim, error)
+	UpdateExpenseClaim(claim *models.ExpenseClaim) error
+	DeleteExpenseClaim(id int) error
+	DecideExpenseClaim(claim *models.ExpenseClaim, from models.ExpenseClaimStatus) error
+	CreateContract(c *models.Contract) error
+	GetContract(id int) (*models.Contract, error)
+	ListContracts(employeeID int) ([]models.Contract, error)
+	UpdateContract(c *models.Contract) error
+	DeleteContract(id int) error
+	ListEndingContracts(date models.Date) ([]models.Contract, error)
+	ListEndingProbations(from, to models.Date) ([]models.Contract, error)
+	MarkContractEndsAlerted(ids []int, date models.Date) error
+	MarkProbationsAlerted(ids []int, date models.Date) error
+	MarkContractEnded(id int, at time.Time) error
 	Close() error
 }
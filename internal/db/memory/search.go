@@ -0,0 +1,182 @@
+// Package memory provides in-memory equivalents of the PostgreSQL-backed
+// queries so handlers can be exercised in tests without a database.
+package memory
+
+import (
+	"employees/internal/models"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Thresholds match the pg_trgm defaults used by the % and <% operators.
+const (
+	similarityThreshold     = 0.3
+	wordSimilarityThreshold = 0.6
+)
+
+// EmployeeIndex searches a fixed set of employees the same way
+// PostgresDB.SearchEmployees does: every query term must prefix-match a word,
+// or one of the fields must be trigram-similar to the whole query.
+type EmployeeIndex struct {
+	employees []models.Employee
+}
+
+func NewEmployeeIndex(employees ...models.Employee) *EmployeeIndex {
+	return &EmployeeIndex{employees: employees}
+}
+
+func (idx *EmployeeIndex) SearchEmployees(query string, limit int) ([]models.EmployeeSearchResult, error) {
+	terms := queryTerms(query)
+	results := []models.EmployeeSearchResult{}
+	if len(terms) == 0 {
+		return results, nil
+	}
+
+	q := strings.ToLower(query)
+	for _, emp := range idx.employees {
+		fields := map[string]string{
+			"firstName": emp.FirstName,
+			"lastName":  emp.LastName,
+			"email":     emp.Email,
+			"address":   emp.AddressText,
+		}
+
+		textRank := textRank(terms, fields)
+		fullName := emp.FirstName + " " + emp.LastName
+		trgmRank := max(
+			similarity(emp.FirstName, q),
+			similarity(emp.LastName, q),
+			similarity(fullName, q),
+			similarity(emp.Email, q),
+		)
+		addressRank := wordSimilarity(q, emp.AddressText)
+
+		if textRank == 0 && trgmRank < similarityThreshold && addressRank < wordSimilarityThreshold {
+			continue
+		}
+
+		highlights := map[string]string{}
+		for field, value := range fields {
+			if hl, ok := highlight(value, terms); ok {
+				highlights[field] = hl
+			}
+		}
+		results = append(results, models.EmployeeSearchResult{
+			Employee:   emp,
+			Rank:       textRank + max(trgmRank, addressRank),
+			Highlights: highlights,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].Employee.ID < results[j].Employee.ID
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func queryTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// textRank returns the share of words across all fields matched by a query
+// term, or zero unless every term matches at least one word.
+func textRank(terms []string, fields map[string]string) float64 {
+	var words []string
+	for _, value := range fields {
+		for _, w := range strings.Fields(value) {
+			words = append(words, strings.ToLower(trimWord(w)))
+		}
+	}
+	if len(words) == 0 {
+		return 0
+	}
+
+	hits := 0
+	for _, term := range terms {
+		matched := false
+		for _, w := range words {
+			if strings.HasPrefix(w, term) {
+				matched = true
+				hits++
+			}
+		}
+		if !matched {
+			return 0
+		}
+	}
+	return float64(hits) / float64(len(words))
+}
+
+// highlight HTML-escapes value and wraps every word that starts with one of
+// the terms in <mark>.
+func highlight(value string, terms []string) (string, bool) {
+	words := strings.Fields(models.StripHighlightDelimiters(value))
+	for i, w := range words {
+		word := trimWord(w)
+		for _, term := range terms {
+			if word != "" && strings.HasPrefix(strings.ToLower(word), term) {
+				words[i] = strings.Replace(w, word, models.HighlightStart+word+models.HighlightStop, 1)
+				break
+			}
+		}
+	}
+	return models.MarkHighlights(strings.Join(words, " "))
+}
+
+func trimWord(w string) string {
+	return strings.TrimFunc(w, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// trigrams follows pg_trgm: each lower-cased alphanumeric word is padded with
+// two leading spaces and one trailing space before being split.
+func trigrams(s string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, word := range queryTerms(s) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+func similarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range ta {
+		if _, ok := tb[t]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// wordSimilarity approximates pg_trgm's word_similarity as the share of the
+// query's trigrams that occur anywhere in the text.
+func wordSimilarity(query, text string) float64 {
+	tq, tt := trigrams(query), trigrams(text)
+	if len(tq) == 0 || len(tt) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range tq {
+		if _, ok := tt[t]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(tq))
+}
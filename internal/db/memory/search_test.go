@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeeIndexSearch(t *testing.T) {
+	index := NewEmployeeIndex(
+		models.Employee{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com"},
+		models.Employee{ID: 2, FirstName: "Johanna", LastName: "Doyle", Email: "jd@example.com"},
+		models.Employee{ID: 3, FirstName: "Maria", LastName: "Garcia", Email: "maria@example.com", AddressText: "Calle Mayor 5, Madrid"},
+	)
+
+	t.Run("Ranks Closer Match First", func(t *testing.T) {
+		results, err := index.SearchEmployees("john doe", 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, results)
+		assert.Equal(t, 1, results[0].Employee.ID)
+		assert.Equal(t, "<mark>John</mark>", results[0].Highlights["firstName"])
+		assert.Equal(t, "<mark>Doe</mark>", results[0].Highlights["lastName"])
+	})
+
+	t.Run("Fuzzy Match", func(t *testing.T) {
+		results, err := index.SearchEmployees("Garciia", 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, 3, results[0].Employee.ID)
+	})
+
+	t.Run("Address Match", func(t *testing.T) {
+		results, err := index.SearchEmployees("madrid", 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "Calle Mayor 5, <mark>Madrid</mark>", results[0].Highlights["address"])
+	})
+
+	t.Run("Escapes Values", func(t *testing.T) {
+		index := NewEmployeeIndex(models.Employee{ID: 4, FirstName: "Ana", LastName: "Ruiz",
+			Email: "ana@example.com", AddressText: "<mark>Sol</mark> & <img src=x onerror=alert(1)> Ruiz"})
+
+		results, err := index.SearchEmployees("ruiz", 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "<mark>Ruiz</mark>", results[0].Highlights["lastName"])
+		assert.Equal(t, "&lt;mark&gt;Sol&lt;/mark&gt; &amp; &lt;img src=x onerror=alert(1)&gt; <mark>Ruiz</mark>",
+			results[0].Highlights["address"])
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		results, err := index.SearchEmployees("jo", 1)
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("No Terms", func(t *testing.T) {
+		results, err := index.SearchEmployees("!!", 10)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
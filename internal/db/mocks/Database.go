@@ -6,6 +6,8 @@ import (
 	models "employees/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Database is an autogenerated mock type for the Database type
@@ -13,17 +15,45 @@ type Database struct {
 	mock.Mock
 }
 
-// Close provides a mock function with no fields
-func (_m *Database) Close() error {
-	ret := _m.Called()
+// ActivatePositions provides a mock function with given fields: date
+func (_m *Database) ActivatePositions(date models.Date) (int, error) {
+	ret := _m.Called(date)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for ActivatePositions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) (int, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) int); ok {
+		r0 = rf(date)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddBalanceAdjustment provides a mock function with given fields: adj
+func (_m *Database) AddBalanceAdjustment(adj *models.BalanceAdjustment) error {
+	ret := _m.Called(adj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBalanceAdjustment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*models.BalanceAdjustment) error); ok {
+		r0 = rf(adj)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -31,17 +61,17 @@ func (_m *Database) Close() error {
 	return r0
 }
 
-// CreateAdmin provides a mock function with given fields: admin
-func (_m *Database) CreateAdmin(admin *models.Admin) error {
-	ret := _m.Called(admin)
+// AddBonus provides a mock function with given fields: bonus
+func (_m *Database) AddBonus(bonus *models.Bonus) error {
+	ret := _m.Called(bonus)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAdmin")
+		panic("no return value specified for AddBonus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Admin) error); ok {
-		r0 = rf(admin)
+	if rf, ok := ret.Get(0).(func(*models.Bonus) error); ok {
+		r0 = rf(bonus)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,17 +79,17 @@ func (_m *Database) CreateAdmin(admin *models.Admin) error {
 	return r0
 }
 
-// CreateEmployee provides a mock function with given fields: emp
-func (_m *Database) CreateEmployee(emp *models.Employee) error {
-	ret := _m.Called(emp)
+// AddCompensation provides a mock function with given fields: comp
+func (_m *Database) AddCompensation(comp *models.Compensation) error {
+	ret := _m.Called(comp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEmployee")
+		panic("no return value specified for AddCompensation")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Employee) error); ok {
-		r0 = rf(emp)
+	if rf, ok := ret.Get(0).(func(*models.Compensation) error); ok {
+		r0 = rf(comp)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -67,17 +97,17 @@ func (_m *Database) CreateEmployee(emp *models.Employee) error {
 	return r0
 }
 
-// DeleteAdmin provides a mock function with given fields: email
-func (_m *Database) DeleteAdmin(email string) error {
-	ret := _m.Called(email)
+// AddHolidayRule provides a mock function with given fields: rule
+func (_m *Database) AddHolidayRule(rule *models.HolidayRule) error {
+	ret := _m.Called(rule)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAdmin")
+		panic("no return value specified for AddHolidayRule")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(*models.HolidayRule) error); ok {
+		r0 = rf(rule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -85,17 +115,17 @@ func (_m *Database) DeleteAdmin(email string) error {
 	return r0
 }
 
-// DeleteEmployee provides a mock function with given fields: id
-func (_m *Database) DeleteEmployee(id string) error {
-	ret := _m.Called(id)
+// AddHolidays provides a mock function with given fields: holidays
+func (_m *Database) AddHolidays(holidays []models.Holiday) error {
+	ret := _m.Called(holidays)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEmployee")
+		panic("no return value specified for AddHolidays")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func([]models.Holiday) error); ok {
+		r0 = rf(holidays)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -103,59 +133,101 @@ func (_m *Database) DeleteEmployee(id string) error {
 	return r0
 }
 
-// GetAdmin provides a mock function with given fields: email
-func (_m *Database) GetAdmin(email string) (*models.Admin, error) {
-	ret := _m.Called(email)
+// AddPosition provides a mock function with given fields: pos
+func (_m *Database) AddPosition(pos *models.Position) error {
+	ret := _m.Called(pos)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdmin")
+		panic("no return value specified for AddPosition")
 	}
 
-	var r0 *models.Admin
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*models.Admin, error)); ok {
-		return rf(email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Position) error); ok {
+		r0 = rf(pos)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(string) *models.Admin); ok {
-		r0 = rf(email)
+
+	return r0
+}
+
+// AdvanceReviewCycle provides a mock function with given fields: id, from, to
+func (_m *Database) AdvanceReviewCycle(id int, from models.ReviewCycleStatus, to models.ReviewCycleStatus) error {
+	ret := _m.Called(id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceReviewCycle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, models.ReviewCycleStatus, models.ReviewCycleStatus) error); ok {
+		r0 = rf(id, from, to)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Admin)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	return r0
+}
+
+// ApplyStatusTransition provides a mock function with given fields: tr
+func (_m *Database) ApplyStatusTransition(tr *models.StatusTransition) error {
+	ret := _m.Called(tr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyStatusTransition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.StatusTransition) error); ok {
+		r0 = rf(tr)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetAdminByEmail provides a mock function with given fields: email
-func (_m *Database) GetAdminByEmail(email string) (*models.Admin, error) {
-	ret := _m.Called(email)
+// CancelStatusTransition provides a mock function with given fields: id
+func (_m *Database) CancelStatusTransition(id int) error {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdminByEmail")
+		panic("no return value specified for CancelStatusTransition")
 	}
 
-	var r0 *models.Admin
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckInAsset provides a mock function with given fields: assetID, status, adminID, note
+func (_m *Database) CheckInAsset(assetID int, status models.AssetStatus, adminID *int, note string) (*models.AssetAssignment, error) {
+	ret := _m.Called(assetID, status, adminID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckInAsset")
+	}
+
+	var r0 *models.AssetAssignment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*models.Admin, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(int, models.AssetStatus, *int, string) (*models.AssetAssignment, error)); ok {
+		return rf(assetID, status, adminID, note)
 	}
-	if rf, ok := ret.Get(0).(func(string) *models.Admin); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(int, models.AssetStatus, *int, string) *models.AssetAssignment); ok {
+		r0 = rf(assetID, status, adminID, note)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Admin)
+			r0 = ret.Get(0).(*models.AssetAssignment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(int, models.AssetStatus, *int, string) error); ok {
+		r1 = rf(assetID, status, adminID, note)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -163,42 +235,48 @@ func (_m *Database) GetAdminByEmail(email string) (*models.Admin, error) {
 	return r0, r1
 }
 
-// GetEmployee provides a mock function with given fields: id
-func (_m *Database) GetEmployee(id string) (*models.Employee, error) {
-	ret := _m.Called(id)
+// CheckOutAsset provides a mock function with given fields: a
+func (_m *Database) CheckOutAsset(a *models.AssetAssignment) error {
+	ret := _m.Called(a)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEmployee")
+		panic("no return value specified for CheckOutAsset")
 	}
 
-	var r0 *models.Employee
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*models.Employee, error)); ok {
-		return rf(id)
-	}
-	if rf, ok := ret.Get(0).(func(string) *models.Employee); ok {
-		r0 = rf(id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.AssetAssignment) error); ok {
+		r0 = rf(a)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Employee)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	return r0
+}
+
+// Close provides a mock function with no fields
+func (_m *Database) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// UpdateAdmin provides a mock function with given fields: admin
-func (_m *Database) UpdateAdmin(admin *models.Admin) error {
+// CreateAdmin provides a mock function with given fields: admin
+func (_m *Database) CreateAdmin(admin *models.Admin) error {
 	ret := _m.Called(admin)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAdmin")
+		panic("no return value specified for CreateAdmin")
 	}
 
 	var r0 error
@@ -211,17 +289,5057 @@ func (_m *Database) UpdateAdmin(admin *models.Admin) error {
 	return r0
 }
 
-// UpdateEmployee provides a mock function with given fields: emp
-func (_m *Database) UpdateEmployee(emp *models.Employee) error {
-	ret := _m.Called(emp)
+// CreateAsset provides a mock function with given fields: asset
+func (_m *Database) CreateAsset(asset *models.Asset) error {
+	ret := _m.Called(asset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployee")
+		panic("no return value specified for CreateAsset")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Employee) error); ok {
-		r0 = rf(emp)
+	if rf, ok := ret.Get(0).(func(*models.Asset) error); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateAssignment provides a mock function with given fields: a
+func (_m *Database) CreateAssignment(a *models.Assignment) error {
+	ret := _m.Called(a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Assignment) error); ok {
+		r0 = rf(a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCandidate provides a mock function with given fields: c
+func (_m *Database) CreateCandidate(c *models.Candidate) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCandidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Candidate) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCertification provides a mock function with given fields: cert
+func (_m *Database) CreateCertification(cert *models.Certification) error {
+	ret := _m.Called(cert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Certification) error); ok {
+		r0 = rf(cert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChecklist provides a mock function with given fields: list
+func (_m *Database) CreateChecklist(list *models.Checklist) error {
+	ret := _m.Called(list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Checklist) error); ok {
+		r0 = rf(list)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChecklistTemplate provides a mock function with given fields: tpl
+func (_m *Database) CreateChecklistTemplate(tpl *models.ChecklistTemplate) error {
+	ret := _m.Called(tpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklistTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChecklistTemplate) error); ok {
+		r0 = rf(tpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateContract provides a mock function with given fields: c
+func (_m *Database) CreateContract(c *models.Contract) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContract")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contract) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCustomField provides a mock function with given fields: def
+func (_m *Database) CreateCustomField(def *models.CustomFieldDefinition) error {
+	ret := _m.Called(def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CustomFieldDefinition) error); ok {
+		r0 = rf(def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateDepartment provides a mock function with given fields: dept
+func (_m *Database) CreateDepartment(dept *models.Department) error {
+	ret := _m.Called(dept)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDepartment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Department) error); ok {
+		r0 = rf(dept)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateDocument provides a mock function with given fields: doc
+func (_m *Database) CreateDocument(doc *models.Document) error {
+	ret := _m.Called(doc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDocument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Document) error); ok {
+		r0 = rf(doc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEmergencyContact provides a mock function with given fields: contact
+func (_m *Database) CreateEmergencyContact(contact *models.EmergencyContact) error {
+	ret := _m.Called(contact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmergencyContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.EmergencyContact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEmployee provides a mock function with given fields: emp
+func (_m *Database) CreateEmployee(emp *models.Employee) error {
+	ret := _m.Called(emp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmployee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Employee) error); ok {
+		r0 = rf(emp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEmployees provides a mock function with given fields: emps
+func (_m *Database) CreateEmployees(emps []models.Employee) error {
+	ret := _m.Called(emps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmployees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Employee) error); ok {
+		r0 = rf(emps)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateExpenseCategory provides a mock function with given fields: c
+func (_m *Database) CreateExpenseCategory(c *models.ExpenseCategory) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExpenseCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExpenseCategory) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateExpenseClaim provides a mock function with given fields: claim
+func (_m *Database) CreateExpenseClaim(claim *models.ExpenseClaim) error {
+	ret := _m.Called(claim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExpenseClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExpenseClaim) error); ok {
+		r0 = rf(claim)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateHolidayCalendar provides a mock function with given fields: cal
+func (_m *Database) CreateHolidayCalendar(cal *models.HolidayCalendar) error {
+	ret := _m.Called(cal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHolidayCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.HolidayCalendar) error); ok {
+		r0 = rf(cal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInterviewFeedback provides a mock function with given fields: f
+func (_m *Database) CreateInterviewFeedback(f *models.InterviewFeedback) error {
+	ret := _m.Called(f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInterviewFeedback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.InterviewFeedback) error); ok {
+		r0 = rf(f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateKeyResult provides a mock function with given fields: kr
+func (_m *Database) CreateKeyResult(kr *models.KeyResult) error {
+	ret := _m.Called(kr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKeyResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.KeyResult) error); ok {
+		r0 = rf(kr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLeaveRequest provides a mock function with given fields: req
+func (_m *Database) CreateLeaveRequest(req *models.LeaveRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLeaveRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.LeaveRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLeaveType provides a mock function with given fields: lt
+func (_m *Database) CreateLeaveType(lt *models.LeaveType) error {
+	ret := _m.Called(lt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLeaveType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.LeaveType) error); ok {
+		r0 = rf(lt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLocation provides a mock function with given fields: loc
+func (_m *Database) CreateLocation(loc *models.Location) error {
+	ret := _m.Called(loc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLocation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Location) error); ok {
+		r0 = rf(loc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateObjective provides a mock function with given fields: obj
+func (_m *Database) CreateObjective(obj *models.Objective) error {
+	ret := _m.Called(obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateObjective")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Objective) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePayrollRun provides a mock function with given fields: run
+func (_m *Database) CreatePayrollRun(run *models.PayrollRun) error {
+	ret := _m.Called(run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePayrollRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PayrollRun) error); ok {
+		r0 = rf(run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePipelineStage provides a mock function with given fields: stage
+func (_m *Database) CreatePipelineStage(stage *models.PipelineStage) error {
+	ret := _m.Called(stage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePipelineStage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PipelineStage) error); ok {
+		r0 = rf(stage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePlannedPosition provides a mock function with given fields: pos
+func (_m *Database) CreatePlannedPosition(pos *models.PlannedPosition) error {
+	ret := _m.Called(pos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlannedPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PlannedPosition) error); ok {
+		r0 = rf(pos)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProject provides a mock function with given fields: project
+func (_m *Database) CreateProject(project *models.Project) error {
+	ret := _m.Called(project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Project) error); ok {
+		r0 = rf(project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRequisition provides a mock function with given fields: req
+func (_m *Database) CreateRequisition(req *models.Requisition) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequisition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Requisition) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateReviewCycle provides a mock function with given fields: cycle
+func (_m *Database) CreateReviewCycle(cycle *models.ReviewCycle) error {
+	ret := _m.Called(cycle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReviewCycle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ReviewCycle) error); ok {
+		r0 = rf(cycle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateReviewTemplate provides a mock function with given fields: tpl
+func (_m *Database) CreateReviewTemplate(tpl *models.ReviewTemplate) error {
+	ret := _m.Called(tpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReviewTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ReviewTemplate) error); ok {
+		r0 = rf(tpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSkill provides a mock function with given fields: skill
+func (_m *Database) CreateSkill(skill *models.Skill) error {
+	ret := _m.Called(skill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSkill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Skill) error); ok {
+		r0 = rf(skill)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTeam provides a mock function with given fields: team
+func (_m *Database) CreateTeam(team *models.Team) error {
+	ret := _m.Called(team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Team) error); ok {
+		r0 = rf(team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTimeEntry provides a mock function with given fields: entry
+func (_m *Database) CreateTimeEntry(entry *models.TimeEntry) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.TimeEntry) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DecideExpenseClaim provides a mock function with given fields: claim, from
+func (_m *Database) DecideExpenseClaim(claim *models.ExpenseClaim, from models.ExpenseClaimStatus) error {
+	ret := _m.Called(claim, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideExpenseClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExpenseClaim, models.ExpenseClaimStatus) error); ok {
+		r0 = rf(claim, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DecideLeaveRequest provides a mock function with given fields: req, from, adj
+func (_m *Database) DecideLeaveRequest(req *models.LeaveRequest, from models.LeaveStatus, adj *models.BalanceAdjustment) error {
+	ret := _m.Called(req, from, adj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideLeaveRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.LeaveRequest, models.LeaveStatus, *models.BalanceAdjustment) error); ok {
+		r0 = rf(req, from, adj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAddress provides a mock function with given fields: employeeID, addrType
+func (_m *Database) DeleteAddress(employeeID int, addrType models.AddressType) error {
+	ret := _m.Called(employeeID, addrType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, models.AddressType) error); ok {
+		r0 = rf(employeeID, addrType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAdmin provides a mock function with given fields: email
+func (_m *Database) DeleteAdmin(email string) error {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAssignment provides a mock function with given fields: id
+func (_m *Database) DeleteAssignment(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCertification provides a mock function with given fields: id
+func (_m *Database) DeleteCertification(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteChecklistTemplate provides a mock function with given fields: id
+func (_m *Database) DeleteChecklistTemplate(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklistTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteContract provides a mock function with given fields: id
+func (_m *Database) DeleteContract(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteContract")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCustomField provides a mock function with given fields: id
+func (_m *Database) DeleteCustomField(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDepartment provides a mock function with given fields: id
+func (_m *Database) DeleteDepartment(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDepartment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDocument provides a mock function with given fields: id
+func (_m *Database) DeleteDocument(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDocument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEmergencyContact provides a mock function with given fields: id
+func (_m *Database) DeleteEmergencyContact(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmergencyContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEmployee provides a mock function with given fields: id
+func (_m *Database) DeleteEmployee(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEmployeeSkill provides a mock function with given fields: employeeID, skillID
+func (_m *Database) DeleteEmployeeSkill(employeeID int, skillID int) error {
+	ret := _m.Called(employeeID, skillID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployeeSkill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = rf(employeeID, skillID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExpenseClaim provides a mock function with given fields: id
+func (_m *Database) DeleteExpenseClaim(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpenseClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHolidayCalendar provides a mock function with given fields: id
+func (_m *Database) DeleteHolidayCalendar(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHolidayCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteKeyResult provides a mock function with given fields: id
+func (_m *Database) DeleteKeyResult(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKeyResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteLocation provides a mock function with given fields: id
+func (_m *Database) DeleteLocation(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLocation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteObjective provides a mock function with given fields: id
+func (_m *Database) DeleteObjective(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObjective")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePayrollRun provides a mock function with given fields: id
+func (_m *Database) DeletePayrollRun(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePayrollRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePipelineStage provides a mock function with given fields: id
+func (_m *Database) DeletePipelineStage(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePipelineStage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePlannedPosition provides a mock function with given fields: id
+func (_m *Database) DeletePlannedPosition(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePlannedPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRequisition provides a mock function with given fields: id
+func (_m *Database) DeleteRequisition(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRequisition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteReviewCycle provides a mock function with given fields: id
+func (_m *Database) DeleteReviewCycle(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReviewCycle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteReviewTemplate provides a mock function with given fields: id
+func (_m *Database) DeleteReviewTemplate(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReviewTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSkill provides a mock function with given fields: id
+func (_m *Database) DeleteSkill(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSkill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTeam provides a mock function with given fields: id
+func (_m *Database) DeleteTeam(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTimeEntry provides a mock function with given fields: id
+func (_m *Database) DeleteTimeEntry(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTimeEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DueStatusTransitions provides a mock function with given fields: date
+func (_m *Database) DueStatusTransitions(date models.Date) ([]models.StatusTransition, error) {
+	ret := _m.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DueStatusTransitions")
+	}
+
+	var r0 []models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) ([]models.StatusTransition, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) []models.StatusTransition); ok {
+		r0 = rf(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FillPlannedPosition provides a mock function with given fields: id, employeeID
+func (_m *Database) FillPlannedPosition(id int, employeeID int) error {
+	ret := _m.Called(id, employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FillPlannedPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = rf(id, employeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindEmployeeEmails provides a mock function with given fields: emails
+func (_m *Database) FindEmployeeEmails(emails []string) ([]string, error) {
+	ret := _m.Called(emails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindEmployeeEmails")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]string, error)); ok {
+		return rf(emails)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindEmployeesBySkills provides a mock function with given fields: reqs
+func (_m *Database) FindEmployeesBySkills(reqs []models.SkillRequirement) ([]models.Employee, error) {
+	ret := _m.Called(reqs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindEmployeesBySkills")
+	}
+
+	var r0 []models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.SkillRequirement) ([]models.Employee, error)); ok {
+		return rf(reqs)
+	}
+	if rf, ok := ret.Get(0).(func([]models.SkillRequirement) []models.Employee); ok {
+		r0 = rf(reqs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.SkillRequirement) error); ok {
+		r1 = rf(reqs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccrualPolicies provides a mock function with given fields: employeeID
+func (_m *Database) GetAccrualPolicies(employeeID int) ([]models.AccrualPolicy, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccrualPolicies")
+	}
+
+	var r0 []models.AccrualPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.AccrualPolicy, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.AccrualPolicy); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AccrualPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdmin provides a mock function with given fields: email
+func (_m *Database) GetAdmin(email string) (*models.Admin, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdmin")
+	}
+
+	var r0 *models.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Admin, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Admin); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdminByEmail provides a mock function with given fields: email
+func (_m *Database) GetAdminByEmail(email string) (*models.Admin, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminByEmail")
+	}
+
+	var r0 *models.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Admin, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Admin); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdminByID provides a mock function with given fields: id
+func (_m *Database) GetAdminByID(id int) (*models.Admin, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminByID")
+	}
+
+	var r0 *models.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Admin, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Admin); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAsset provides a mock function with given fields: id
+func (_m *Database) GetAsset(id int) (*models.Asset, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsset")
+	}
+
+	var r0 *models.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Asset, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Asset); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignment provides a mock function with given fields: id
+func (_m *Database) GetAssignment(id int) (*models.Assignment, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignment")
+	}
+
+	var r0 *models.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Assignment, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Assignment); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBalanceAdjustments provides a mock function with given fields: employeeID
+func (_m *Database) GetBalanceAdjustments(employeeID int) ([]models.BalanceAdjustment, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceAdjustments")
+	}
+
+	var r0 []models.BalanceAdjustment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.BalanceAdjustment, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.BalanceAdjustment); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalanceAdjustment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCandidate provides a mock function with given fields: id
+func (_m *Database) GetCandidate(id int) (*models.Candidate, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCandidate")
+	}
+
+	var r0 *models.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Candidate, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Candidate); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCertification provides a mock function with given fields: id
+func (_m *Database) GetCertification(id int) (*models.Certification, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCertification")
+	}
+
+	var r0 *models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Certification, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Certification); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChecklistTask provides a mock function with given fields: id
+func (_m *Database) GetChecklistTask(id int) (*models.ChecklistTask, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistTask")
+	}
+
+	var r0 *models.ChecklistTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.ChecklistTask, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.ChecklistTask); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChecklistTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChecklistTemplate provides a mock function with given fields: id
+func (_m *Database) GetChecklistTemplate(id int) (*models.ChecklistTemplate, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistTemplate")
+	}
+
+	var r0 *models.ChecklistTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.ChecklistTemplate, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.ChecklistTemplate); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChecklistTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompensationAsOf provides a mock function with given fields: employeeID, date
+func (_m *Database) GetCompensationAsOf(employeeID int, date models.Date) (*models.Compensation, error) {
+	ret := _m.Called(employeeID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompensationAsOf")
+	}
+
+	var r0 *models.Compensation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Date) (*models.Compensation, error)); ok {
+		return rf(employeeID, date)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Date) *models.Compensation); ok {
+		r0 = rf(employeeID, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Compensation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Date) error); ok {
+		r1 = rf(employeeID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompensationHistory provides a mock function with given fields: employeeID
+func (_m *Database) GetCompensationHistory(employeeID int) ([]models.Compensation, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompensationHistory")
+	}
+
+	var r0 []models.Compensation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Compensation, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Compensation); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Compensation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContract provides a mock function with given fields: id
+func (_m *Database) GetContract(id int) (*models.Contract, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContract")
+	}
+
+	var r0 *models.Contract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Contract, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Contract); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contract)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCustomField provides a mock function with given fields: id
+func (_m *Database) GetCustomField(id int) (*models.CustomFieldDefinition, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomField")
+	}
+
+	var r0 *models.CustomFieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.CustomFieldDefinition, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.CustomFieldDefinition); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CustomFieldDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDepartment provides a mock function with given fields: id
+func (_m *Database) GetDepartment(id int) (*models.Department, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepartment")
+	}
+
+	var r0 *models.Department
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Department, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Department); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Department)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDirectReports provides a mock function with given fields: managerID
+func (_m *Database) GetDirectReports(managerID int) ([]models.Employee, error) {
+	ret := _m.Called(managerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectReports")
+	}
+
+	var r0 []models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Employee, error)); ok {
+		return rf(managerID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Employee); ok {
+		r0 = rf(managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDocument provides a mock function with given fields: id
+func (_m *Database) GetDocument(id int) (*models.Document, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDocument")
+	}
+
+	var r0 *models.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Document, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Document); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmergencyContact provides a mock function with given fields: id
+func (_m *Database) GetEmergencyContact(id int) (*models.EmergencyContact, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmergencyContact")
+	}
+
+	var r0 *models.EmergencyContact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.EmergencyContact, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.EmergencyContact); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.EmergencyContact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmployee provides a mock function with given fields: id
+func (_m *Database) GetEmployee(id string) (*models.Employee, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployee")
+	}
+
+	var r0 *models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Employee, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Employee); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpenseClaim provides a mock function with given fields: id
+func (_m *Database) GetExpenseClaim(id int) (*models.ExpenseClaim, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseClaim")
+	}
+
+	var r0 *models.ExpenseClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.ExpenseClaim, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.ExpenseClaim); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExpenseClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHolidayCalendar provides a mock function with given fields: id
+func (_m *Database) GetHolidayCalendar(id int) (*models.HolidayCalendar, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidayCalendar")
+	}
+
+	var r0 *models.HolidayCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.HolidayCalendar, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.HolidayCalendar); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.HolidayCalendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHolidays provides a mock function with given fields: employeeID, from, to
+func (_m *Database) GetHolidays(employeeID int, from models.Date, to models.Date) ([]models.Holiday, error) {
+	ret := _m.Called(employeeID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []models.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Date, models.Date) ([]models.Holiday, error)); ok {
+		return rf(employeeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Date, models.Date) []models.Holiday); ok {
+		r0 = rf(employeeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Date, models.Date) error); ok {
+		r1 = rf(employeeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyResult provides a mock function with given fields: id
+func (_m *Database) GetKeyResult(id int) (*models.KeyResult, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeyResult")
+	}
+
+	var r0 *models.KeyResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.KeyResult, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.KeyResult); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.KeyResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeaveRequest provides a mock function with given fields: id
+func (_m *Database) GetLeaveRequest(id int) (*models.LeaveRequest, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveRequest")
+	}
+
+	var r0 *models.LeaveRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.LeaveRequest, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.LeaveRequest); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LeaveRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeaveType provides a mock function with given fields: id
+func (_m *Database) GetLeaveType(id int) (*models.LeaveType, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveType")
+	}
+
+	var r0 *models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.LeaveType, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.LeaveType); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLocation provides a mock function with given fields: id
+func (_m *Database) GetLocation(id int) (*models.Location, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocation")
+	}
+
+	var r0 *models.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Location, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Location); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetObjective provides a mock function with given fields: id
+func (_m *Database) GetObjective(id int) (*models.Objective, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjective")
+	}
+
+	var r0 *models.Objective
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Objective, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Objective); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Objective)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenTimeEntry provides a mock function with given fields: employeeID
+func (_m *Database) GetOpenTimeEntry(employeeID int) (*models.TimeEntry, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenTimeEntry")
+	}
+
+	var r0 *models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.TimeEntry, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.TimeEntry); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOvertimePolicy provides a mock function with no fields
+func (_m *Database) GetOvertimePolicy() (*models.OvertimePolicy, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOvertimePolicy")
+	}
+
+	var r0 *models.OvertimePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*models.OvertimePolicy, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *models.OvertimePolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OvertimePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayrollRun provides a mock function with given fields: id
+func (_m *Database) GetPayrollRun(id int) (*models.PayrollRun, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayrollRun")
+	}
+
+	var r0 *models.PayrollRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.PayrollRun, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.PayrollRun); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PayrollRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayslip provides a mock function with given fields: runID, employeeID
+func (_m *Database) GetPayslip(runID int, employeeID int) (*models.Payslip, error) {
+	ret := _m.Called(runID, employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayslip")
+	}
+
+	var r0 *models.Payslip
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*models.Payslip, error)); ok {
+		return rf(runID, employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *models.Payslip); ok {
+		r0 = rf(runID, employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payslip)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(runID, employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingStatusTransition provides a mock function with given fields: employeeID
+func (_m *Database) GetPendingStatusTransition(employeeID int) (*models.StatusTransition, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingStatusTransition")
+	}
+
+	var r0 *models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.StatusTransition, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.StatusTransition); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPipelineStage provides a mock function with given fields: id
+func (_m *Database) GetPipelineStage(id int) (*models.PipelineStage, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineStage")
+	}
+
+	var r0 *models.PipelineStage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.PipelineStage, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.PipelineStage); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PipelineStage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlannedPosition provides a mock function with given fields: id
+func (_m *Database) GetPlannedPosition(id int) (*models.PlannedPosition, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlannedPosition")
+	}
+
+	var r0 *models.PlannedPosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.PlannedPosition, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.PlannedPosition); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PlannedPosition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPositionAsOf provides a mock function with given fields: employeeID, date
+func (_m *Database) GetPositionAsOf(employeeID int, date models.Date) (*models.Position, error) {
+	ret := _m.Called(employeeID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPositionAsOf")
+	}
+
+	var r0 *models.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Date) (*models.Position, error)); ok {
+		return rf(employeeID, date)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Date) *models.Position); ok {
+		r0 = rf(employeeID, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Date) error); ok {
+		r1 = rf(employeeID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPositions provides a mock function with given fields: employeeID
+func (_m *Database) GetPositions(employeeID int) ([]models.Position, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPositions")
+	}
+
+	var r0 []models.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Position, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Position); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProject provides a mock function with given fields: id
+func (_m *Database) GetProject(id int) (*models.Project, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 *models.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Project, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Project); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReportingChain provides a mock function with given fields: id
+func (_m *Database) GetReportingChain(id int) ([]models.Employee, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportingChain")
+	}
+
+	var r0 []models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Employee, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Employee); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRequisition provides a mock function with given fields: id
+func (_m *Database) GetRequisition(id int) (*models.Requisition, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequisition")
+	}
+
+	var r0 *models.Requisition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Requisition, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Requisition); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Requisition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReview provides a mock function with given fields: id
+func (_m *Database) GetReview(id int) (*models.Review, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReview")
+	}
+
+	var r0 *models.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Review, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Review); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewCycle provides a mock function with given fields: id
+func (_m *Database) GetReviewCycle(id int) (*models.ReviewCycle, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewCycle")
+	}
+
+	var r0 *models.ReviewCycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.ReviewCycle, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.ReviewCycle); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReviewCycle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewTemplate provides a mock function with given fields: id
+func (_m *Database) GetReviewTemplate(id int) (*models.ReviewTemplate, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewTemplate")
+	}
+
+	var r0 *models.ReviewTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.ReviewTemplate, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.ReviewTemplate); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReviewTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSkill provides a mock function with given fields: id
+func (_m *Database) GetSkill(id int) (*models.Skill, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkill")
+	}
+
+	var r0 *models.Skill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Skill, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Skill); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Skill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubordinates provides a mock function with given fields: managerID
+func (_m *Database) GetSubordinates(managerID int) ([]models.Employee, error) {
+	ret := _m.Called(managerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubordinates")
+	}
+
+	var r0 []models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Employee, error)); ok {
+		return rf(managerID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Employee); ok {
+		r0 = rf(managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeam provides a mock function with given fields: id
+func (_m *Database) GetTeam(id int) (*models.Team, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeam")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Team, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Team); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTimeEntry provides a mock function with given fields: id
+func (_m *Database) GetTimeEntry(id int) (*models.TimeEntry, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeEntry")
+	}
+
+	var r0 *models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.TimeEntry, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.TimeEntry); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTimesheet provides a mock function with given fields: employeeID, weekStart
+func (_m *Database) GetTimesheet(employeeID int, weekStart models.Date) (*models.Timesheet, error) {
+	ret := _m.Called(employeeID, weekStart)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimesheet")
+	}
+
+	var r0 *models.Timesheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Date) (*models.Timesheet, error)); ok {
+		return rf(employeeID, weekStart)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Date) *models.Timesheet); ok {
+		r0 = rf(employeeID, weekStart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Timesheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Date) error); ok {
+		r1 = rf(employeeID, weekStart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTimesheetByID provides a mock function with given fields: id
+func (_m *Database) GetTimesheetByID(id int) (*models.Timesheet, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimesheetByID")
+	}
+
+	var r0 *models.Timesheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Timesheet, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Timesheet); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Timesheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HireCandidate provides a mock function with given fields: c, emp, tr
+func (_m *Database) HireCandidate(c *models.Candidate, emp *models.Employee, tr *models.StatusTransition) error {
+	ret := _m.Called(c, emp, tr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HireCandidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Candidate, *models.Employee, *models.StatusTransition) error); ok {
+		r0 = rf(c, emp, tr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAddresses provides a mock function with given fields: employeeID
+func (_m *Database) ListAddresses(employeeID int) ([]models.Address, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAddresses")
+	}
+
+	var r0 []models.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Address, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Address); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAddressesNeedingReview provides a mock function with no fields
+func (_m *Database) ListAddressesNeedingReview() ([]models.Address, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAddressesNeedingReview")
+	}
+
+	var r0 []models.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Address, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAppliedStatusTransitions provides a mock function with given fields: employeeIDs
+func (_m *Database) ListAppliedStatusTransitions(employeeIDs []int) ([]models.StatusTransition, error) {
+	ret := _m.Called(employeeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAppliedStatusTransitions")
+	}
+
+	var r0 []models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int) ([]models.StatusTransition, error)); ok {
+		return rf(employeeIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]int) []models.StatusTransition); ok {
+		r0 = rf(employeeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = rf(employeeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAssetAssignments provides a mock function with given fields: filter
+func (_m *Database) ListAssetAssignments(filter models.AssetAssignmentFilter) ([]models.AssetAssignment, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAssetAssignments")
+	}
+
+	var r0 []models.AssetAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.AssetAssignmentFilter) ([]models.AssetAssignment, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.AssetAssignmentFilter) []models.AssetAssignment); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AssetAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AssetAssignmentFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAssets provides a mock function with given fields: filter
+func (_m *Database) ListAssets(filter models.AssetFilter) ([]models.Asset, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAssets")
+	}
+
+	var r0 []models.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.AssetFilter) ([]models.Asset, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.AssetFilter) []models.Asset); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AssetFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAssignments provides a mock function with given fields: filter
+func (_m *Database) ListAssignments(filter models.AssignmentFilter) ([]models.Assignment, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAssignments")
+	}
+
+	var r0 []models.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.AssignmentFilter) ([]models.Assignment, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.AssignmentFilter) []models.Assignment); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AssignmentFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBonuses provides a mock function with given fields: employeeID
+func (_m *Database) ListBonuses(employeeID int) ([]models.Bonus, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBonuses")
+	}
+
+	var r0 []models.Bonus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Bonus, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Bonus); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Bonus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCalendarHolidays provides a mock function with given fields: calendarID, from, to
+func (_m *Database) ListCalendarHolidays(calendarID int, from models.Date, to models.Date) ([]models.Holiday, error) {
+	ret := _m.Called(calendarID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCalendarHolidays")
+	}
+
+	var r0 []models.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Date, models.Date) ([]models.Holiday, error)); ok {
+		return rf(calendarID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Date, models.Date) []models.Holiday); ok {
+		r0 = rf(calendarID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Date, models.Date) error); ok {
+		r1 = rf(calendarID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCandidates provides a mock function with given fields: filter
+func (_m *Database) ListCandidates(filter models.CandidateFilter) ([]models.Candidate, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCandidates")
+	}
+
+	var r0 []models.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.CandidateFilter) ([]models.Candidate, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.CandidateFilter) []models.Candidate); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CandidateFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCertifications provides a mock function with given fields: employeeID
+func (_m *Database) ListCertifications(employeeID int) ([]models.Certification, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCertifications")
+	}
+
+	var r0 []models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Certification, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Certification); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCheckIns provides a mock function with given fields: keyResultID
+func (_m *Database) ListCheckIns(keyResultID int) ([]models.CheckIn, error) {
+	ret := _m.Called(keyResultID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCheckIns")
+	}
+
+	var r0 []models.CheckIn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.CheckIn, error)); ok {
+		return rf(keyResultID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.CheckIn); ok {
+		r0 = rf(keyResultID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CheckIn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(keyResultID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChecklistTasks provides a mock function with given fields: filter
+func (_m *Database) ListChecklistTasks(filter models.ChecklistTaskFilter) ([]models.ChecklistTask, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChecklistTasks")
+	}
+
+	var r0 []models.ChecklistTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ChecklistTaskFilter) ([]models.ChecklistTask, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.ChecklistTaskFilter) []models.ChecklistTask); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ChecklistTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ChecklistTaskFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChecklistTemplates provides a mock function with given fields: kind
+func (_m *Database) ListChecklistTemplates(kind models.ChecklistKind) ([]models.ChecklistTemplate, error) {
+	ret := _m.Called(kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChecklistTemplates")
+	}
+
+	var r0 []models.ChecklistTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ChecklistKind) ([]models.ChecklistTemplate, error)); ok {
+		return rf(kind)
+	}
+	if rf, ok := ret.Get(0).(func(models.ChecklistKind) []models.ChecklistTemplate); ok {
+		r0 = rf(kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ChecklistTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ChecklistKind) error); ok {
+		r1 = rf(kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChecklists provides a mock function with given fields: employeeID
+func (_m *Database) ListChecklists(employeeID int) ([]models.Checklist, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChecklists")
+	}
+
+	var r0 []models.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Checklist, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Checklist); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContracts provides a mock function with given fields: employeeID
+func (_m *Database) ListContracts(employeeID int) ([]models.Contract, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListContracts")
+	}
+
+	var r0 []models.Contract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Contract, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Contract); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Contract)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCustomFields provides a mock function with no fields
+func (_m *Database) ListCustomFields() ([]models.CustomFieldDefinition, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomFields")
+	}
+
+	var r0 []models.CustomFieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.CustomFieldDefinition, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.CustomFieldDefinition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CustomFieldDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDepartments provides a mock function with no fields
+func (_m *Database) ListDepartments() ([]models.Department, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDepartments")
+	}
+
+	var r0 []models.Department
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Department, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Department); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Department)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDocuments provides a mock function with given fields: employeeID, category
+func (_m *Database) ListDocuments(employeeID int, category models.DocumentCategory) ([]models.Document, error) {
+	ret := _m.Called(employeeID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDocuments")
+	}
+
+	var r0 []models.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.DocumentCategory) ([]models.Document, error)); ok {
+		return rf(employeeID, category)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.DocumentCategory) []models.Document); ok {
+		r0 = rf(employeeID, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.DocumentCategory) error); ok {
+		r1 = rf(employeeID, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmergencyContacts provides a mock function with given fields: employeeID
+func (_m *Database) ListEmergencyContacts(employeeID int) ([]models.EmergencyContact, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmergencyContacts")
+	}
+
+	var r0 []models.EmergencyContact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.EmergencyContact, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.EmergencyContact); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EmergencyContact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmployeeSkills provides a mock function with given fields: employeeID
+func (_m *Database) ListEmployeeSkills(employeeID int) ([]models.EmployeeSkill, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployeeSkills")
+	}
+
+	var r0 []models.EmployeeSkill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.EmployeeSkill, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.EmployeeSkill); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EmployeeSkill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmployees provides a mock function with given fields: filter
+func (_m *Database) ListEmployees(filter models.EmployeeFilter) ([]models.Employee, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployees")
+	}
+
+	var r0 []models.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EmployeeFilter) ([]models.Employee, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.EmployeeFilter) []models.Employee); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EmployeeFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEndingContracts provides a mock function with given fields: date
+func (_m *Database) ListEndingContracts(date models.Date) ([]models.Contract, error) {
+	ret := _m.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndingContracts")
+	}
+
+	var r0 []models.Contract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) ([]models.Contract, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) []models.Contract); ok {
+		r0 = rf(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Contract)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEndingProbations provides a mock function with given fields: from, to
+func (_m *Database) ListEndingProbations(from models.Date, to models.Date) ([]models.Contract, error) {
+	ret := _m.Called(from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndingProbations")
+	}
+
+	var r0 []models.Contract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date, models.Date) ([]models.Contract, error)); ok {
+		return rf(from, to)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date, models.Date) []models.Contract); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Contract)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date, models.Date) error); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpenseCategories provides a mock function with no fields
+func (_m *Database) ListExpenseCategories() ([]models.ExpenseCategory, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpenseCategories")
+	}
+
+	var r0 []models.ExpenseCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.ExpenseCategory, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.ExpenseCategory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExpenseCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpenseClaims provides a mock function with given fields: filter
+func (_m *Database) ListExpenseClaims(filter models.ExpenseClaimFilter) ([]models.ExpenseClaim, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpenseClaims")
+	}
+
+	var r0 []models.ExpenseClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ExpenseClaimFilter) ([]models.ExpenseClaim, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.ExpenseClaimFilter) []models.ExpenseClaim); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExpenseClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ExpenseClaimFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiringCertifications provides a mock function with given fields: date
+func (_m *Database) ListExpiringCertifications(date models.Date) ([]models.Certification, error) {
+	ret := _m.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiringCertifications")
+	}
+
+	var r0 []models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) ([]models.Certification, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) []models.Certification); ok {
+		r0 = rf(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiringDocuments provides a mock function with given fields: date
+func (_m *Database) ListExpiringDocuments(date models.Date) ([]models.Document, error) {
+	ret := _m.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiringDocuments")
+	}
+
+	var r0 []models.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) ([]models.Document, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) []models.Document); ok {
+		r0 = rf(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHolidayCalendars provides a mock function with no fields
+func (_m *Database) ListHolidayCalendars() ([]models.HolidayCalendar, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHolidayCalendars")
+	}
+
+	var r0 []models.HolidayCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.HolidayCalendar, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.HolidayCalendar); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.HolidayCalendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHolidayRules provides a mock function with given fields: calendarID
+func (_m *Database) ListHolidayRules(calendarID int) ([]models.HolidayRule, error) {
+	ret := _m.Called(calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHolidayRules")
+	}
+
+	var r0 []models.HolidayRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.HolidayRule, error)); ok {
+		return rf(calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.HolidayRule); ok {
+		r0 = rf(calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.HolidayRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInterviewFeedback provides a mock function with given fields: candidateID
+func (_m *Database) ListInterviewFeedback(candidateID int) ([]models.InterviewFeedback, error) {
+	ret := _m.Called(candidateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInterviewFeedback")
+	}
+
+	var r0 []models.InterviewFeedback
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.InterviewFeedback, error)); ok {
+		return rf(candidateID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.InterviewFeedback); ok {
+		r0 = rf(candidateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.InterviewFeedback)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(candidateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLeaveRequests provides a mock function with given fields: employeeID, status
+func (_m *Database) ListLeaveRequests(employeeID int, status models.LeaveStatus) ([]models.LeaveRequest, error) {
+	ret := _m.Called(employeeID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLeaveRequests")
+	}
+
+	var r0 []models.LeaveRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.LeaveStatus) ([]models.LeaveRequest, error)); ok {
+		return rf(employeeID, status)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.LeaveStatus) []models.LeaveRequest); ok {
+		r0 = rf(employeeID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LeaveRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.LeaveStatus) error); ok {
+		r1 = rf(employeeID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLeaveTypes provides a mock function with no fields
+func (_m *Database) ListLeaveTypes() ([]models.LeaveType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLeaveTypes")
+	}
+
+	var r0 []models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.LeaveType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.LeaveType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLocations provides a mock function with no fields
+func (_m *Database) ListLocations() ([]models.Location, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocations")
+	}
+
+	var r0 []models.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Location, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Location); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListObjectiveTree provides a mock function with given fields: id
+func (_m *Database) ListObjectiveTree(id int) ([]models.Objective, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjectiveTree")
+	}
+
+	var r0 []models.Objective
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.Objective, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.Objective); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Objective)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListObjectives provides a mock function with given fields: filter
+func (_m *Database) ListObjectives(filter models.ObjectiveFilter) ([]models.Objective, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjectives")
+	}
+
+	var r0 []models.Objective
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ObjectiveFilter) ([]models.Objective, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.ObjectiveFilter) []models.Objective); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Objective)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ObjectiveFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPayrollRuns provides a mock function with no fields
+func (_m *Database) ListPayrollRuns() ([]models.PayrollRun, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPayrollRuns")
+	}
+
+	var r0 []models.PayrollRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.PayrollRun, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.PayrollRun); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PayrollRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPipelineStages provides a mock function with no fields
+func (_m *Database) ListPipelineStages() ([]models.PipelineStage, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPipelineStages")
+	}
+
+	var r0 []models.PipelineStage
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.PipelineStage, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.PipelineStage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PipelineStage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPlannedPositions provides a mock function with given fields: filter
+func (_m *Database) ListPlannedPositions(filter models.PlannedPositionFilter) ([]models.PlannedPosition, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlannedPositions")
+	}
+
+	var r0 []models.PlannedPosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.PlannedPositionFilter) ([]models.PlannedPosition, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.PlannedPositionFilter) []models.PlannedPosition); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PlannedPosition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.PlannedPositionFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPositionsBetween provides a mock function with given fields: from, to
+func (_m *Database) ListPositionsBetween(from models.Date, to models.Date) ([]models.Position, error) {
+	ret := _m.Called(from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPositionsBetween")
+	}
+
+	var r0 []models.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date, models.Date) ([]models.Position, error)); ok {
+		return rf(from, to)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date, models.Date) []models.Position); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date, models.Date) error); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjects provides a mock function with no fields
+func (_m *Database) ListProjects() ([]models.Project, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []models.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Project, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Project); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRequisitions provides a mock function with given fields: status
+func (_m *Database) ListRequisitions(status models.RequisitionStatus) ([]models.Requisition, error) {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRequisitions")
+	}
+
+	var r0 []models.Requisition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.RequisitionStatus) ([]models.Requisition, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(models.RequisitionStatus) []models.Requisition); ok {
+		r0 = rf(status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Requisition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.RequisitionStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviewCalibrations provides a mock function with given fields: cycleID
+func (_m *Database) ListReviewCalibrations(cycleID int) ([]models.ReviewCalibration, error) {
+	ret := _m.Called(cycleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewCalibrations")
+	}
+
+	var r0 []models.ReviewCalibration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.ReviewCalibration, error)); ok {
+		return rf(cycleID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.ReviewCalibration); ok {
+		r0 = rf(cycleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewCalibration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(cycleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviewCycles provides a mock function with given fields: status
+func (_m *Database) ListReviewCycles(status models.ReviewCycleStatus) ([]models.ReviewCycle, error) {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewCycles")
+	}
+
+	var r0 []models.ReviewCycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ReviewCycleStatus) ([]models.ReviewCycle, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(models.ReviewCycleStatus) []models.ReviewCycle); ok {
+		r0 = rf(status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewCycle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ReviewCycleStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviewTemplates provides a mock function with no fields
+func (_m *Database) ListReviewTemplates() ([]models.ReviewTemplate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewTemplates")
+	}
+
+	var r0 []models.ReviewTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.ReviewTemplate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.ReviewTemplate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviews provides a mock function with given fields: filter
+func (_m *Database) ListReviews(filter models.ReviewFilter) ([]models.Review, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviews")
+	}
+
+	var r0 []models.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ReviewFilter) ([]models.Review, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.ReviewFilter) []models.Review); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ReviewFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviewsToRemind provides a mock function with given fields: date
+func (_m *Database) ListReviewsToRemind(date models.Date) ([]models.Review, error) {
+	ret := _m.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewsToRemind")
+	}
+
+	var r0 []models.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Date) ([]models.Review, error)); ok {
+		return rf(date)
+	}
+	if rf, ok := ret.Get(0).(func(models.Date) []models.Review); ok {
+		r0 = rf(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Date) error); ok {
+		r1 = rf(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSkills provides a mock function with no fields
+func (_m *Database) ListSkills() ([]models.Skill, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkills")
+	}
+
+	var r0 []models.Skill
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Skill, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Skill); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusTransitions provides a mock function with given fields: employeeID
+func (_m *Database) ListStatusTransitions(employeeID int) ([]models.StatusTransition, error) {
+	ret := _m.Called(employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusTransitions")
+	}
+
+	var r0 []models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.StatusTransition, error)); ok {
+		return rf(employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.StatusTransition); ok {
+		r0 = rf(employeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTeams provides a mock function with no fields
+func (_m *Database) ListTeams() ([]models.Team, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 []models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Team, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Team); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTimeEntries provides a mock function with given fields: filter
+func (_m *Database) ListTimeEntries(filter models.TimeEntryFilter) ([]models.TimeEntry, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTimeEntries")
+	}
+
+	var r0 []models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.TimeEntryFilter) ([]models.TimeEntry, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.TimeEntryFilter) []models.TimeEntry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.TimeEntryFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTimesheets provides a mock function with given fields: status
+func (_m *Database) ListTimesheets(status models.TimesheetStatus) ([]models.Timesheet, error) {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTimesheets")
+	}
+
+	var r0 []models.Timesheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.TimesheetStatus) ([]models.Timesheet, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(models.TimesheetStatus) []models.Timesheet); ok {
+		r0 = rf(status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Timesheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.TimesheetStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockPayrollRun provides a mock function with given fields: id, adminID
+func (_m *Database) LockPayrollRun(id int, adminID *int) error {
+	ret := _m.Called(id, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPayrollRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, *int) error); ok {
+		r0 = rf(id, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkCertificationsAlerted provides a mock function with given fields: ids, date
+func (_m *Database) MarkCertificationsAlerted(ids []int, date models.Date) error {
+	ret := _m.Called(ids, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCertificationsAlerted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int, models.Date) error); ok {
+		r0 = rf(ids, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkContractEnded provides a mock function with given fields: id, at
+func (_m *Database) MarkContractEnded(id int, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkContractEnded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkContractEndsAlerted provides a mock function with given fields: ids, date
+func (_m *Database) MarkContractEndsAlerted(ids []int, date models.Date) error {
+	ret := _m.Called(ids, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkContractEndsAlerted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int, models.Date) error); ok {
+		r0 = rf(ids, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkProbationsAlerted provides a mock function with given fields: ids, date
+func (_m *Database) MarkProbationsAlerted(ids []int, date models.Date) error {
+	ret := _m.Called(ids, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkProbationsAlerted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int, models.Date) error); ok {
+		r0 = rf(ids, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkReviewsReminded provides a mock function with given fields: ids, date
+func (_m *Database) MarkReviewsReminded(ids []int, date models.Date) error {
+	ret := _m.Called(ids, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkReviewsReminded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int, models.Date) error); ok {
+		r0 = rf(ids, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenReviewCycle provides a mock function with given fields: id, reviews
+func (_m *Database) OpenReviewCycle(id int, reviews []models.Review) error {
+	ret := _m.Called(id, reviews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenReviewCycle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []models.Review) error); ok {
+		r0 = rf(id, reviews)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecalculatePayrollRun provides a mock function with given fields: run
+func (_m *Database) RecalculatePayrollRun(run *models.PayrollRun) error {
+	ret := _m.Called(run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecalculatePayrollRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PayrollRun) error); ok {
+		r0 = rf(run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordCheckIn provides a mock function with given fields: ci
+func (_m *Database) RecordCheckIn(ci *models.CheckIn) error {
+	ret := _m.Called(ci)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCheckIn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CheckIn) error); ok {
+		r0 = rf(ci)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordStatusTransition provides a mock function with given fields: tr
+func (_m *Database) RecordStatusTransition(tr *models.StatusTransition) error {
+	ret := _m.Called(tr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordStatusTransition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.StatusTransition) error); ok {
+		r0 = rf(tr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReorderEmergencyContacts provides a mock function with given fields: employeeID, ids
+func (_m *Database) ReorderEmergencyContacts(employeeID int, ids []int) error {
+	ret := _m.Called(employeeID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderEmergencyContacts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []int) error); ok {
+		r0 = rf(employeeID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveTimesheet provides a mock function with given fields: ts
+func (_m *Database) SaveTimesheet(ts *models.Timesheet) error {
+	ret := _m.Called(ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTimesheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Timesheet) error); ok {
+		r0 = rf(ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchEmployees provides a mock function with given fields: query, limit
+func (_m *Database) SearchEmployees(query string, limit int) ([]models.EmployeeSearchResult, error) {
+	ret := _m.Called(query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchEmployees")
+	}
+
+	var r0 []models.EmployeeSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.EmployeeSearchResult, error)); ok {
+		return rf(query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.EmployeeSearchResult); ok {
+		r0 = rf(query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EmployeeSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetAccrualPolicy provides a mock function with given fields: policy
+func (_m *Database) SetAccrualPolicy(policy *models.AccrualPolicy) error {
+	ret := _m.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAccrualPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.AccrualPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAddresses provides a mock function with given fields: employeeID, addrs
+func (_m *Database) SetAddresses(employeeID int, addrs []models.Address) error {
+	ret := _m.Called(employeeID, addrs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAddresses")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []models.Address) error); ok {
+		r0 = rf(employeeID, addrs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetEmployeePhoto provides a mock function with given fields: employeeID, url
+func (_m *Database) SetEmployeePhoto(employeeID int, url string) error {
+	ret := _m.Called(employeeID, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEmployeePhoto")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(employeeID, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetEmployeeSkill provides a mock function with given fields: es
+func (_m *Database) SetEmployeeSkill(es *models.EmployeeSkill) error {
+	ret := _m.Called(es)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEmployeeSkill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.EmployeeSkill) error); ok {
+		r0 = rf(es)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetOvertimePolicy provides a mock function with given fields: policy
+func (_m *Database) SetOvertimePolicy(policy *models.OvertimePolicy) error {
+	ret := _m.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOvertimePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.OvertimePolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetReviewCalibration provides a mock function with given fields: c
+func (_m *Database) SetReviewCalibration(c *models.ReviewCalibration) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReviewCalibration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ReviewCalibration) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartChecklists provides a mock function with given fields: emp, kind, start
+func (_m *Database) StartChecklists(emp *models.Employee, kind models.ChecklistKind, start models.Date) ([]models.Checklist, error) {
+	ret := _m.Called(emp, kind, start)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartChecklists")
+	}
+
+	var r0 []models.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.Employee, models.ChecklistKind, models.Date) ([]models.Checklist, error)); ok {
+		return rf(emp, kind, start)
+	}
+	if rf, ok := ret.Get(0).(func(*models.Employee, models.ChecklistKind, models.Date) []models.Checklist); ok {
+		r0 = rf(emp, kind, start)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.Employee, models.ChecklistKind, models.Date) error); ok {
+		r1 = rf(emp, kind, start)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAdmin provides a mock function with given fields: admin
+func (_m *Database) UpdateAdmin(admin *models.Admin) error {
+	ret := _m.Called(admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Admin) error); ok {
+		r0 = rf(admin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAsset provides a mock function with given fields: asset
+func (_m *Database) UpdateAsset(asset *models.Asset) error {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAsset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Asset) error); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAssignment provides a mock function with given fields: a
+func (_m *Database) UpdateAssignment(a *models.Assignment) error {
+	ret := _m.Called(a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Assignment) error); ok {
+		r0 = rf(a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCandidate provides a mock function with given fields: c
+func (_m *Database) UpdateCandidate(c *models.Candidate) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCandidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Candidate) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCertification provides a mock function with given fields: cert
+func (_m *Database) UpdateCertification(cert *models.Certification) error {
+	ret := _m.Called(cert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Certification) error); ok {
+		r0 = rf(cert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateChecklistTask provides a mock function with given fields: task
+func (_m *Database) UpdateChecklistTask(task *models.ChecklistTask) error {
+	ret := _m.Called(task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklistTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChecklistTask) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateChecklistTemplate provides a mock function with given fields: tpl
+func (_m *Database) UpdateChecklistTemplate(tpl *models.ChecklistTemplate) error {
+	ret := _m.Called(tpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklistTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChecklistTemplate) error); ok {
+		r0 = rf(tpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateContract provides a mock function with given fields: c
+func (_m *Database) UpdateContract(c *models.Contract) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContract")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contract) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCustomField provides a mock function with given fields: def
+func (_m *Database) UpdateCustomField(def *models.CustomFieldDefinition) error {
+	ret := _m.Called(def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CustomFieldDefinition) error); ok {
+		r0 = rf(def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDepartment provides a mock function with given fields: dept
+func (_m *Database) UpdateDepartment(dept *models.Department) error {
+	ret := _m.Called(dept)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDepartment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Department) error); ok {
+		r0 = rf(dept)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEmergencyContact provides a mock function with given fields: contact
+func (_m *Database) UpdateEmergencyContact(contact *models.EmergencyContact) error {
+	ret := _m.Called(contact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmergencyContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.EmergencyContact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEmployee provides a mock function with given fields: emp
+func (_m *Database) UpdateEmployee(emp *models.Employee) error {
+	ret := _m.Called(emp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmployee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Employee) error); ok {
+		r0 = rf(emp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateExpenseCategory provides a mock function with given fields: c
+func (_m *Database) UpdateExpenseCategory(c *models.ExpenseCategory) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExpenseCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExpenseCategory) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateExpenseClaim provides a mock function with given fields: claim
+func (_m *Database) UpdateExpenseClaim(claim *models.ExpenseClaim) error {
+	ret := _m.Called(claim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExpenseClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ExpenseClaim) error); ok {
+		r0 = rf(claim)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateKeyResult provides a mock function with given fields: kr
+func (_m *Database) UpdateKeyResult(kr *models.KeyResult) error {
+	ret := _m.Called(kr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKeyResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.KeyResult) error); ok {
+		r0 = rf(kr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLocation provides a mock function with given fields: loc
+func (_m *Database) UpdateLocation(loc *models.Location) error {
+	ret := _m.Called(loc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLocation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Location) error); ok {
+		r0 = rf(loc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateObjective provides a mock function with given fields: obj
+func (_m *Database) UpdateObjective(obj *models.Objective) error {
+	ret := _m.Called(obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateObjective")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Objective) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePipelineStage provides a mock function with given fields: stage
+func (_m *Database) UpdatePipelineStage(stage *models.PipelineStage) error {
+	ret := _m.Called(stage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePipelineStage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PipelineStage) error); ok {
+		r0 = rf(stage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePlannedPosition provides a mock function with given fields: pos
+func (_m *Database) UpdatePlannedPosition(pos *models.PlannedPosition) error {
+	ret := _m.Called(pos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlannedPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PlannedPosition) error); ok {
+		r0 = rf(pos)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRequisition provides a mock function with given fields: req
+func (_m *Database) UpdateRequisition(req *models.Requisition) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRequisition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Requisition) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateReview provides a mock function with given fields: rev
+func (_m *Database) UpdateReview(rev *models.Review) error {
+	ret := _m.Called(rev)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Review) error); ok {
+		r0 = rf(rev)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateReviewTemplate provides a mock function with given fields: tpl
+func (_m *Database) UpdateReviewTemplate(tpl *models.ReviewTemplate) error {
+	ret := _m.Called(tpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReviewTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ReviewTemplate) error); ok {
+		r0 = rf(tpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSkill provides a mock function with given fields: skill
+func (_m *Database) UpdateSkill(skill *models.Skill) error {
+	ret := _m.Called(skill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSkill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Skill) error); ok {
+		r0 = rf(skill)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTeam provides a mock function with given fields: team
+func (_m *Database) UpdateTeam(team *models.Team) error {
+	ret := _m.Called(team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Team) error); ok {
+		r0 = rf(team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTimeEntry provides a mock function with given fields: entry
+func (_m *Database) UpdateTimeEntry(entry *models.TimeEntry) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTimeEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.TimeEntry) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VacatePlannedPosition provides a mock function with given fields: id
+func (_m *Database) VacatePlannedPosition(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VacatePlannedPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"employees/internal/address"
+	"employees/internal/models"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// migrateAddresses turns the free-text address of every employee who has
+// no structured address yet into a home address. Text that cannot be
+// parsed is kept whole and flagged for review. It is safe to run on every
+// start: migrated employees have address rows and are skipped.
+func migrateAddresses(db *gorm.DB) error {
+	var emps []models.Employee
+	err := db.Select("id", "address").
+		Where("coalesce(address, '') <> ''").
+		Where("NOT EXISTS (SELECT 1 FROM addresses WHERE addresses.employee_id = employees.id)").
+		Find(&emps).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate addresses: %w", err)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, emp := range emps {
+			addr := address.Parse(emp.AddressText)
+			addr.EmployeeID = emp.ID
+			if err := replaceAddresses(tx, emp.ID, []models.Address{addr}); err != nil {
+				return fmt.Errorf("failed to migrate address of employee %d: %w", emp.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func orderByType(tx *gorm.DB) *gorm.DB {
+	return tx.Order("type")
+}
+
+// replaceAddresses swaps the employee's addresses for addrs and refreshes
+// the searchable address text.
+func replaceAddresses(tx *gorm.DB, employeeID int, addrs []models.Address) error {
+	if err := tx.Delete(&models.Address{}, "employee_id = ?", employeeID).Error; err != nil {
+		return err
+	}
+	for i := range addrs {
+		addrs[i].ID = 0
+		addrs[i].EmployeeID = employeeID
+	}
+	if len(addrs) > 0 {
+		if err := tx.Create(&addrs).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Model(&models.Employee{}).Where("id = ?", employeeID).
+		UpdateColumn("address", address.FormatAll(addrs)).Error
+}
+
+func (p *PostgresDB) ListAddresses(employeeID int) ([]models.Address, error) {
+	var addrs []models.Address
+	if err := p.db.Scopes(orderByType).Where("employee_id = ?", employeeID).Find(&addrs).Error; err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// SetAddresses replaces all of the employee's addresses.
+func (p *PostgresDB) SetAddresses(employeeID int, addrs []models.Address) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		return replaceAddresses(tx, employeeID, addrs)
+	})
+}
+
+func (p *PostgresDB) DeleteAddress(employeeID int, addrType models.AddressType) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.Address{}, "employee_id = ? AND type = ?", employeeID, addrType).Error; err != nil {
+			return err
+		}
+		var rest []models.Address
+		if err := tx.Scopes(orderByType).Where("employee_id = ?", employeeID).Find(&rest).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Employee{}).Where("id = ?", employeeID).
+			UpdateColumn("address", address.FormatAll(rest)).Error
+	})
+}
+
+// ListAddressesNeedingReview returns migrated addresses that could not be
+// parsed, oldest employee first.
+func (p *PostgresDB) ListAddressesNeedingReview() ([]models.Address, error) {
+	var addrs []models.Address
+	if err := p.db.Where("needs_review").Order("employee_id, type").Find(&addrs).Error; err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
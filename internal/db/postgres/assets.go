@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreateAsset(asset *models.Asset) error {
+	return p.db.Create(asset).Error
+}
+
+func (p *PostgresDB) GetAsset(id int) (*models.Asset, error) {
+	var asset models.Asset
+	if err := p.db.First(&asset, id).Error; err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+func (p *PostgresDB) ListAssets(filter models.AssetFilter) ([]models.Asset, error) {
+	query := p.db.Order("type, serial_number")
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if len(filter.HolderStatuses) > 0 {
+		query = query.Where("employee_id IN (SELECT id FROM employees WHERE status IN ?)", filter.HolderStatuses)
+	}
+	var assets []models.Asset
+	if err := query.Find(&assets).Error; err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// UpdateAsset saves the asset's details and status. It returns
+// db.ErrConflict if the asset is checked out, as those change only through
+// check-in.
+func (p *PostgresDB) UpdateAsset(asset *models.Asset) error {
+	result := p.db.Model(asset).Where("employee_id IS NULL").
+		Select("type", "serial_number", "model", "purchase_date", "status", "notes", "updated_at").Updates(asset)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// CheckOutAsset hands an available asset to a.EmployeeID and opens the
+// assignment. It returns db.ErrConflict when the asset is not available.
+func (p *PostgresDB) CheckOutAsset(a *models.AssetAssignment) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Asset{}).
+			Where("id = ? AND status = ?", a.AssetID, models.AssetStatusAvailable).
+			Updates(map[string]any{"status": models.AssetStatusAssigned, "employee_id": a.EmployeeID, "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		return tx.Create(a).Error
+	})
+}
+
+// CheckInAsset closes the asset's open assignment and puts the asset into
+// status. It returns db.ErrConflict when the asset is not checked out.
+func (p *PostgresDB) CheckInAsset(assetID int, status models.AssetStatus, adminID *int, note string) (*models.AssetAssignment, error) {
+	var a models.AssetAssignment
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Asset{}).
+			Where("id = ? AND status = ?", assetID, models.AssetStatusAssigned).
+			Updates(map[string]any{"status": status, "employee_id": nil, "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		if err := tx.Where("asset_id = ? AND checked_in_at IS NULL", assetID).First(&a).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		a.CheckedInAt = &now
+		a.CheckedInBy = adminID
+		a.CheckInNote = note
+		a.ReturnedAsStatus = string(status)
+		return tx.Save(&a).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListAssetAssignments returns check-outs, most recent first.
+func (p *PostgresDB) ListAssetAssignments(filter models.AssetAssignmentFilter) ([]models.AssetAssignment, error) {
+	query := p.db.Order("checked_out_at DESC, id DESC")
+	if filter.AssetID != nil {
+		query = query.Where("asset_id = ?", *filter.AssetID)
+	}
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	var history []models.AssetAssignment
+	if err := query.Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"employees/internal/checklist"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreateChecklistTemplate(tpl *models.ChecklistTemplate) error {
+	return p.db.Create(tpl).Error
+}
+
+func (p *PostgresDB) GetChecklistTemplate(id int) (*models.ChecklistTemplate, error) {
+	var tpl models.ChecklistTemplate
+	if err := p.db.First(&tpl, id).Error; err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// ListChecklistTemplates returns the templates of kind, or all of them
+// when kind is empty.
+func (p *PostgresDB) ListChecklistTemplates(kind models.ChecklistKind) ([]models.ChecklistTemplate, error) {
+	query := p.db.Order("id")
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	var templates []models.ChecklistTemplate
+	if err := query.Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (p *PostgresDB) UpdateChecklistTemplate(tpl *models.ChecklistTemplate) error {
+	result := p.db.Model(tpl).Select("name", "kind", "department_id", "contract_type", "tasks", "updated_at").Updates(tpl)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDB) DeleteChecklistTemplate(id int) error {
+	return p.db.Delete(&models.ChecklistTemplate{}, id).Error
+}
+
+// startChecklists instantiates every template of kind that matches emp.
+func startChecklists(tx *gorm.DB, emp *models.Employee, kind models.ChecklistKind, start models.Date) ([]models.Checklist, error) {
+	var templates []models.ChecklistTemplate
+	if err := tx.Where("kind = ?", kind).Order("id").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	lists := checklist.For(templates, *emp, kind, start)
+	if len(lists) == 0 {
+		return nil, nil
+	}
+	if err := tx.Create(&lists).Error; err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (p *PostgresDB) StartChecklists(emp *models.Employee, kind models.ChecklistKind, start models.Date) ([]models.Checklist, error) {
+	var lists []models.Checklist
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		lists, err = startChecklists(tx, emp, kind, start)
+		return err
+	})
+	return lists, err
+}
+
+func (p *PostgresDB) CreateChecklist(list *models.Checklist) error {
+	return p.db.Create(list).Error
+}
+
+func (p *PostgresDB) ListChecklists(employeeID int) ([]models.Checklist, error) {
+	var lists []models.Checklist
+	err := p.db.Preload("Tasks", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("due_date, id")
+	}).Where("employee_id = ?", employeeID).Order("id").Find(&lists).Error
+	if err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (p *PostgresDB) GetChecklistTask(id int) (*models.ChecklistTask, error) {
+	var task models.ChecklistTask
+	if err := p.db.First(&task, id).Error; err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (p *PostgresDB) UpdateChecklistTask(task *models.ChecklistTask) error {
+	return p.db.Save(task).Error
+}
+
+func (p *PostgresDB) ListChecklistTasks(filter models.ChecklistTaskFilter) ([]models.ChecklistTask, error) {
+	query := p.db.Order("due_date, id")
+	if filter.OwnerAdminID != nil {
+		query = query.Where("owner_admin_id = ?", *filter.OwnerAdminID)
+	}
+	if filter.OwnerEmployeeID != nil {
+		query = query.Where("owner_employee_id = ?", *filter.OwnerEmployeeID)
+	}
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if filter.Open || filter.OverdueOn != nil {
+		query = query.Where("completed_at IS NULL")
+	}
+	if filter.OverdueOn != nil {
+		query = query.Where("due_date < ?", *filter.OverdueOn)
+	}
+
+	var tasks []models.ChecklistTask
+	if err := query.Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"employees/internal/models"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) GetAdminByID(id int) (*models.Admin, error) {
+	var admin models.Admin
+	if err := p.db.First(&admin, id).Error; err != nil {
+		return nil, err
+	}
+	return &admin, nil
+}
+
+// AddCompensation splices comp into the employee's pay history the same way
+// AddPosition does: a record starting on the same day is replaced, the
+// previous one is closed and comp runs until the next one starts.
+func (p *PostgresDB) AddCompensation(comp *models.Compensation) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("employee_id = ? AND effective_from = ?", comp.EmployeeID, comp.EffectiveFrom).
+			Delete(&models.Compensation{}).Error; err != nil {
+			return err
+		}
+
+		var prev models.Compensation
+		err := tx.Where("employee_id = ? AND effective_from < ?", comp.EmployeeID, comp.EffectiveFrom).
+			Order("effective_from DESC").First(&prev).Error
+		if err == nil {
+			if err := tx.Model(&prev).Update("effective_to", comp.EffectiveFrom).Error; err != nil {
+				return err
+			}
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		var next models.Compensation
+		err = tx.Where("employee_id = ? AND effective_from > ?", comp.EmployeeID, comp.EffectiveFrom).
+			Order("effective_from").First(&next).Error
+		if err == nil {
+			comp.EffectiveTo = &next.EffectiveFrom
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			comp.EffectiveTo = nil
+		} else {
+			return err
+		}
+
+		return tx.Create(comp).Error
+	})
+}
+
+func (p *PostgresDB) GetCompensationHistory(employeeID int) ([]models.Compensation, error) {
+	var history []models.Compensation
+	if err := p.db.Where("employee_id = ?", employeeID).Order("effective_from").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (p *PostgresDB) GetCompensationAsOf(employeeID int, date models.Date) (*models.Compensation, error) {
+	var comp models.Compensation
+	err := p.db.Where("employee_id = ? AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)",
+		employeeID, date, date).First(&comp).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comp, nil
+}
+
+func (p *PostgresDB) AddBonus(bonus *models.Bonus) error {
+	return p.db.Create(bonus).Error
+}
+
+func (p *PostgresDB) ListBonuses(employeeID int) ([]models.Bonus, error) {
+	var bonuses []models.Bonus
+	if err := p.db.Where("employee_id = ?", employeeID).Order("awarded_on").Find(&bonuses).Error; err != nil {
+		return nil, err
+	}
+	return bonuses, nil
+}
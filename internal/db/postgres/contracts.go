@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"employees/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// currentEmployees limits a contract query to employees still on the books.
+func currentEmployees(db *gorm.DB) *gorm.DB {
+	return db.Where("employee_id IN (?)",
+		db.Session(&gorm.Session{NewDB: true}).Model(&models.Employee{}).Select("id").
+			Where("status <> ?", models.EmployeeStatusTerminated))
+}
+
+// syncContractType copies the type of a contract in effect today onto the
+// employee record.
+func syncContractType(tx *gorm.DB, c *models.Contract) error {
+	if !c.ActiveOn(models.DateOf(time.Now())) {
+		return nil
+	}
+	return tx.Model(&models.Employee{}).Where("id = ?", c.EmployeeID).
+		Update("contract_type", string(c.Type)).Error
+}
+
+func (p *PostgresDB) CreateContract(c *models.Contract) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+		return syncContractType(tx, c)
+	})
+}
+
+func (p *PostgresDB) GetContract(id int) (*models.Contract, error) {
+	var c models.Contract
+	if err := p.db.First(&c, id).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (p *PostgresDB) ListContracts(employeeID int) ([]models.Contract, error) {
+	var contracts []models.Contract
+	if err := p.db.Where("employee_id = ?", employeeID).Order("start_date, id").Find(&contracts).Error; err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
+
+func (p *PostgresDB) UpdateContract(c *models.Contract) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(c).Select("type", "start_date", "end_date", "notice_period_days", "probation_ends_on",
+			"notes", "end_alerted_on", "probation_alerted_on", "ended_at", "updated_at").Updates(c)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return syncContractType(tx, c)
+	})
+}
+
+func (p *PostgresDB) DeleteContract(id int) error {
+	return p.db.Delete(&models.Contract{}, id).Error
+}
+
+// ListEndingContracts lists the contracts of current employees whose
+// notice deadline falls on or before date and whose end has not yet been
+// handled, leaving out those followed by a later contract.
+func (p *PostgresDB) ListEndingContracts(date models.Date) ([]models.Contract, error) {
+	var contracts []models.Contract
+	err := p.db.Scopes(currentEmployees).
+		Where("ended_at IS NULL AND end_date IS NOT NULL AND end_date - notice_period_days <= ?", date).
+		Where("NOT EXISTS (SELECT 1 FROM contracts later WHERE later.employee_id = contracts.employee_id AND later.start_date > contracts.start_date)").
+		Order("end_date, id").Find(&contracts).Error
+	if err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
+
+// ListEndingProbations lists the contracts of current employees whose
+// probation ends from from to to.
+func (p *PostgresDB) ListEndingProbations(from, to models.Date) ([]models.Contract, error) {
+	var contracts []models.Contract
+	err := p.db.Scopes(currentEmployees).
+		Where("probation_ends_on >= ? AND probation_ends_on <= ?", from, to).
+		Order("probation_ends_on, id").Find(&contracts).Error
+	if err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
+
+// MarkContractEndsAlerted records that the end alert for each of ids went
+// out on date.
+func (p *PostgresDB) MarkContractEndsAlerted(ids []int, date models.Date) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return p.db.Model(&models.Contract{}).Where("id IN ?", ids).UpdateColumn("end_alerted_on", date).Error
+}
+
+// MarkProbationsAlerted records that the probation alert for each of ids
+// went out on date.
+func (p *PostgresDB) MarkProbationsAlerted(ids []int, date models.Date) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return p.db.Model(&models.Contract{}).Where("id IN ?", ids).UpdateColumn("probation_alerted_on", date).Error
+}
+
+// MarkContractEnded records that the end of contract id was handled at at.
+func (p *PostgresDB) MarkContractEnded(id int, at time.Time) error {
+	return p.db.Model(&models.Contract{}).Where("id = ?", id).UpdateColumn("ended_at", at).Error
+}
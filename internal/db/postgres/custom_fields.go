@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"employees/internal/models"
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// setupCustomFields indexes employees' custom field values so that
+// containment filters in ListEmployees stay fast.
+func setupCustomFields(db *gorm.DB) error {
+	err := db.Exec(`CREATE INDEX IF NOT EXISTS employees_custom_fields_idx ON employees USING GIN (custom_fields jsonb_path_ops)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to set up custom fields: %w", err)
+	}
+	return nil
+}
+
+// whereCustomFields restricts query to employees holding every value in
+// fields.
+func whereCustomFields(query *gorm.DB, fields map[string]any) (*gorm.DB, error) {
+	if len(fields) == 0 {
+		return query, nil
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return query.Where("custom_fields @> ?::jsonb", string(encoded)), nil
+}
+
+func (p *PostgresDB) CreateCustomField(def *models.CustomFieldDefinition) error {
+	return p.db.Create(def).Error
+}
+
+func (p *PostgresDB) GetCustomField(id int) (*models.CustomFieldDefinition, error) {
+	var def models.CustomFieldDefinition
+	if err := p.db.First(&def, id).Error; err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+func (p *PostgresDB) ListCustomFields() ([]models.CustomFieldDefinition, error) {
+	var defs []models.CustomFieldDefinition
+	if err := p.db.Order("id").Find(&defs).Error; err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+func (p *PostgresDB) UpdateCustomField(def *models.CustomFieldDefinition) error {
+	return p.db.Save(def).Error
+}
+
+// DeleteCustomField removes the definition and its value from every
+// employee.
+func (p *PostgresDB) DeleteCustomField(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var def models.CustomFieldDefinition
+		if err := tx.First(&def, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Employee{}).Where("jsonb_exists(custom_fields, ?)", def.Key).
+			UpdateColumn("custom_fields", gorm.Expr("custom_fields - ?", def.Key)).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&def).Error
+	})
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import "employees/internal/models"
+
+func (p *PostgresDB) CreateDocument(doc *models.Document) error {
+	return p.db.Create(doc).Error
+}
+
+func (p *PostgresDB) GetDocument(id int) (*models.Document, error) {
+	var doc models.Document
+	if err := p.db.First(&doc, id).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// ListDocuments returns the employee's documents, optionally only those of
+// category.
+func (p *PostgresDB) ListDocuments(employeeID int, category models.DocumentCategory) ([]models.Document, error) {
+	query := p.db.Where("employee_id = ?", employeeID).Order("created_at DESC, id DESC")
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+	var docs []models.Document
+	if err := query.Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// ListExpiringDocuments returns documents expiring on or before date,
+// soonest first, including those already expired.
+func (p *PostgresDB) ListExpiringDocuments(date models.Date) ([]models.Document, error) {
+	var docs []models.Document
+	if err := p.db.Where("expires_on <= ?", date).Order("expires_on, id").Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (p *PostgresDB) DeleteDocument(id int) error {
+	return p.db.Delete(&models.Document{}, id).Error
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ListEmergencyContacts returns the employee's contacts in the order they
+// should be called.
+func (p *PostgresDB) ListEmergencyContacts(employeeID int) ([]models.EmergencyContact, error) {
+	var contacts []models.EmergencyContact
+	if err := p.db.Where("employee_id = ?", employeeID).Order("priority, id").Find(&contacts).Error; err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+func (p *PostgresDB) GetEmergencyContact(id int) (*models.EmergencyContact, error) {
+	var contact models.EmergencyContact
+	if err := p.db.First(&contact, id).Error; err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// CreateEmergencyContact saves the contact, placing it after the employee's
+// existing contacts when it has no priority.
+func (p *PostgresDB) CreateEmergencyContact(contact *models.EmergencyContact) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if contact.Priority == 0 {
+			var last int
+			if err := tx.Model(&models.EmergencyContact{}).Where("employee_id = ?", contact.EmployeeID).
+				Select("coalesce(max(priority), 0)").Scan(&last).Error; err != nil {
+				return err
+			}
+			contact.Priority = last + 1
+		}
+		return tx.Create(contact).Error
+	})
+}
+
+func (p *PostgresDB) UpdateEmergencyContact(contact *models.EmergencyContact) error {
+	return p.db.Save(contact).Error
+}
+
+func (p *PostgresDB) DeleteEmergencyContact(id int) error {
+	return p.db.Delete(&models.EmergencyContact{}, id).Error
+}
+
+// ReorderEmergencyContacts sets the employee's contact priorities to follow
+// ids. It returns db.ErrConflict unless ids lists each of the employee's
+// contacts exactly once.
+func (p *PostgresDB) ReorderEmergencyContacts(employeeID int, ids []int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var existing []int
+		if err := tx.Model(&models.EmergencyContact{}).Where("employee_id = ?", employeeID).
+			Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+		if len(existing) != len(ids) {
+			return db.ErrConflict
+		}
+		owned := make(map[int]bool, len(existing))
+		for _, id := range existing {
+			owned[id] = true
+		}
+		for i, id := range ids {
+			if !owned[id] {
+				return db.ErrConflict
+			}
+			delete(owned, id)
+			if err := tx.Model(&models.EmergencyContact{}).Where("id = ?", id).
+				Update("priority", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
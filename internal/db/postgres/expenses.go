@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreateExpenseCategory(c *models.ExpenseCategory) error {
+	return p.db.Create(c).Error
+}
+
+func (p *PostgresDB) ListExpenseCategories() ([]models.ExpenseCategory, error) {
+	var categories []models.ExpenseCategory
+	if err := p.db.Order("name").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (p *PostgresDB) UpdateExpenseCategory(c *models.ExpenseCategory) error {
+	result := p.db.Model(c).Select("name", "account_code", "limits", "receipt_required", "updated_at").Updates(c)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func orderItems(db *gorm.DB) *gorm.DB {
+	return db.Order("expense_items.id")
+}
+
+func (p *PostgresDB) CreateExpenseClaim(claim *models.ExpenseClaim) error {
+	return p.db.Create(claim).Error
+}
+
+func (p *PostgresDB) GetExpenseClaim(id int) (*models.ExpenseClaim, error) {
+	var claim models.ExpenseClaim
+	if err := p.db.Preload("Items", orderItems).First(&claim, id).Error; err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
+
+func (p *PostgresDB) ListExpenseClaims(filter models.ExpenseClaimFilter) ([]models.ExpenseClaim, error) {
+	query := p.db.Preload("Items", orderItems).Order("created_at DESC, id DESC")
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ApprovedFrom != nil {
+		query = query.Where("approved_at >= ?", filter.ApprovedFrom.Time)
+	}
+	if filter.ApprovedTo != nil {
+		query = query.Where("approved_at < ?", filter.ApprovedTo.AddDays(1).Time)
+	}
+	var claims []models.ExpenseClaim
+	if err := query.Find(&claims).Error; err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// UpdateExpenseClaim replaces the claim's details and items and puts it
+// back to draft. It returns db.ErrConflict unless the claim is a draft or
+// was rejected.
+func (p *PostgresDB) UpdateExpenseClaim(claim *models.ExpenseClaim) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		claim.Status = models.ExpenseClaimStatusDraft
+		result := tx.Model(claim).
+			Where("status IN ?", []models.ExpenseClaimStatus{models.ExpenseClaimStatusDraft, models.ExpenseClaimStatusRejected}).
+			Select("title", "currency", "total", "status", "updated_at").Updates(claim)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		if err := tx.Delete(&models.ExpenseItem{}, "claim_id = ?", claim.ID).Error; err != nil {
+			return err
+		}
+		for i := range claim.Items {
+			claim.Items[i].ID = 0
+			claim.Items[i].ClaimID = claim.ID
+		}
+		if len(claim.Items) == 0 {
+			return nil
+		}
+		return tx.Create(&claim.Items).Error
+	})
+}
+
+// DeleteExpenseClaim deletes a draft claim. It returns db.ErrConflict once
+// the claim has been submitted.
+func (p *PostgresDB) DeleteExpenseClaim(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("status = ?", models.ExpenseClaimStatusDraft).Delete(&models.ExpenseClaim{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		return tx.Delete(&models.ExpenseItem{}, "claim_id = ?", id).Error
+	})
+}
+
+// DecideExpenseClaim stores the claim's move from status from, along with
+// who made it. On submission it also stores which items are over their
+// limit. It returns db.ErrConflict when the claim is no longer in from.
+func (p *PostgresDB) DecideExpenseClaim(claim *models.ExpenseClaim, from models.ExpenseClaimStatus) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(claim).Where("status = ?", from).
+			Select("status", "submitted_at", "manager_approver_id", "manager_approved_at", "manager_admin_id", "finance_admin_id",
+				"approved_at", "rejected_at", "decision_note", "updated_at").
+			Updates(claim)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		if claim.Status != models.ExpenseClaimStatusSubmitted {
+			return nil
+		}
+		for _, item := range claim.Items {
+			if err := tx.Model(&models.ExpenseItem{}).Where("id = ?", item.ID).
+				Update("over_limit", item.OverLimit).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"time"
+)
+
+func (p *PostgresDB) CreatePlannedPosition(pos *models.PlannedPosition) error {
+	return p.db.Create(pos).Error
+}
+
+func (p *PostgresDB) GetPlannedPosition(id int) (*models.PlannedPosition, error) {
+	var pos models.PlannedPosition
+	if err := p.db.First(&pos, id).Error; err != nil {
+		return nil, err
+	}
+	return &pos, nil
+}
+
+func (p *PostgresDB) ListPlannedPositions(filter models.PlannedPositionFilter) ([]models.PlannedPosition, error) {
+	query := p.db.Order("department_id, opened_on, id")
+	if filter.DepartmentID != nil {
+		query = query.Where("department_id = ?", *filter.DepartmentID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	var positions []models.PlannedPosition
+	if err := query.Find(&positions).Error; err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// UpdatePlannedPosition saves the position's details and, for a position
+// nobody holds, whether it is open or frozen. It returns db.ErrConflict
+// when the position was filled or vacated in the meantime.
+func (p *PostgresDB) UpdatePlannedPosition(pos *models.PlannedPosition) error {
+	cols := []any{"department_id", "budget", "currency", "opened_on", "closed_on", "updated_at"}
+	query := p.db.Model(pos)
+	if pos.Status == models.PlannedPositionStatusFilled {
+		query = query.Where("status = ?", models.PlannedPositionStatusFilled)
+	} else {
+		query = query.Where("status <> ?", models.PlannedPositionStatusFilled)
+		cols = append(cols, "status")
+	}
+	result := query.Select("title", cols...).Updates(pos)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// DeletePlannedPosition removes a position from the plan. It returns
+// db.ErrConflict while the position is filled.
+func (p *PostgresDB) DeletePlannedPosition(id int) error {
+	result := p.db.Where("status <> ?", models.PlannedPositionStatusFilled).Delete(&models.PlannedPosition{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// FillPlannedPosition assigns the employee to an open position. It returns
+// db.ErrConflict when the position is filled or frozen.
+func (p *PostgresDB) FillPlannedPosition(id, employeeID int) error {
+	result := p.db.Model(&models.PlannedPosition{}).
+		Where("id = ? AND status = ?", id, models.PlannedPositionStatusOpen).
+		Updates(map[string]any{"status": models.PlannedPositionStatusFilled, "employee_id": employeeID, "updated_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// VacatePlannedPosition reopens a filled position. It returns
+// db.ErrConflict when the position is not filled.
+func (p *PostgresDB) VacatePlannedPosition(id int) error {
+	result := p.db.Model(&models.PlannedPosition{}).
+		Where("id = ? AND status = ?", id, models.PlannedPositionStatusFilled).
+		Updates(map[string]any{"status": models.PlannedPositionStatusOpen, "employee_id": nil, "updated_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// ListPositionsBetween returns every employee's positions with a
+// department that were in effect at some point from from to to.
+func (p *PostgresDB) ListPositionsBetween(from, to models.Date) ([]models.Position, error) {
+	var positions []models.Position
+	err := p.db.Where("department_id IS NOT NULL AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)", to, from).
+		Order("employee_id, effective_from").Find(&positions).Error
+	if err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// ListAppliedStatusTransitions returns the transitions that have taken
+// effect for the employees, in the order they did.
+func (p *PostgresDB) ListAppliedStatusTransitions(employeeIDs []int) ([]models.StatusTransition, error) {
+	if len(employeeIDs) == 0 {
+		return nil, nil
+	}
+	var transitions []models.StatusTransition
+	err := p.db.Where("employee_id IN ? AND applied_at IS NOT NULL", employeeIDs).
+		Order("employee_id, effective_date, id").Find(&transitions).Error
+	if err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
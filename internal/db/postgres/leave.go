@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *PostgresDB) CreateLeaveType(lt *models.LeaveType) error {
+	return p.db.Create(lt).Error
+}
+
+func (p *PostgresDB) GetLeaveType(id int) (*models.LeaveType, error) {
+	var lt models.LeaveType
+	if err := p.db.First(&lt, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &lt, nil
+}
+
+func (p *PostgresDB) ListLeaveTypes() ([]models.LeaveType, error) {
+	var types []models.LeaveType
+	if err := p.db.Order("name").Find(&types).Error; err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// SetAccrualPolicy creates or replaces the employee's policy for the leave
+// type.
+func (p *PostgresDB) SetAccrualPolicy(policy *models.AccrualPolicy) error {
+	return p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "employee_id"}, {Name: "leave_type_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"days_per_year", "start_date", "updated_at"}),
+	}).Create(policy).Error
+}
+
+func (p *PostgresDB) GetAccrualPolicies(employeeID int) ([]models.AccrualPolicy, error) {
+	var policies []models.AccrualPolicy
+	if err := p.db.Where("employee_id = ?", employeeID).Order("leave_type_id").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (p *PostgresDB) CreateLeaveRequest(req *models.LeaveRequest) error {
+	return p.db.Create(req).Error
+}
+
+func (p *PostgresDB) GetLeaveRequest(id int) (*models.LeaveRequest, error) {
+	var req models.LeaveRequest
+	if err := p.db.First(&req, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// ListLeaveRequests returns the employee's requests, newest first. An empty
+// status returns requests in every status.
+func (p *PostgresDB) ListLeaveRequests(employeeID int, status models.LeaveStatus) ([]models.LeaveRequest, error) {
+	query := p.db.Where("employee_id = ?", employeeID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var reqs []models.LeaveRequest
+	if err := query.Order("start_date DESC").Find(&reqs).Error; err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+// DecideLeaveRequest saves a status change made from status from together
+// with the balance adjustment it causes, if any, in one transaction.
+func (p *PostgresDB) DecideLeaveRequest(req *models.LeaveRequest, from models.LeaveStatus, adj *models.BalanceAdjustment) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(req).Where("status = ?", from).Updates(map[string]any{
+			"status":              req.Status,
+			"approver_id":         req.ApproverID,
+			"decided_by_admin_id": req.DecidedByAdminID,
+			"decision_note":       req.DecisionNote,
+			"decided_at":          req.DecidedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		if adj == nil {
+			return nil
+		}
+		return tx.Create(adj).Error
+	})
+}
+
+func (p *PostgresDB) AddBalanceAdjustment(adj *models.BalanceAdjustment) error {
+	return p.db.Create(adj).Error
+}
+
+func (p *PostgresDB) GetBalanceAdjustments(employeeID int) ([]models.BalanceAdjustment, error) {
+	var adjs []models.BalanceAdjustment
+	if err := p.db.Where("employee_id = ?", employeeID).Order("created_at, id").Find(&adjs).Error; err != nil {
+		return nil, err
+	}
+	return adjs, nil
+}
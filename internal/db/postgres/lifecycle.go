@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// applyStatus moves the employee from tr.From to tr.To, returning
+// db.ErrConflict when their status is no longer tr.From.
+func applyStatus(tx *gorm.DB, tr *models.StatusTransition) error {
+	result := tx.Model(&models.Employee{}).
+		Where("id = ? AND status = ?", tr.EmployeeID, tr.From).
+		Update("status", tr.To)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// RecordStatusTransition stores tr and, when tr.AppliedAt is set, applies
+// it to the employee in the same transaction.
+func (p *PostgresDB) RecordStatusTransition(tr *models.StatusTransition) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if tr.AppliedAt != nil {
+			if err := applyStatus(tx, tr); err != nil {
+				return err
+			}
+		}
+		return tx.Create(tr).Error
+	})
+}
+
+func (p *PostgresDB) ListStatusTransitions(employeeID int) ([]models.StatusTransition, error) {
+	var transitions []models.StatusTransition
+	if err := p.db.Where("employee_id = ?", employeeID).Order("effective_date, id").Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// GetPendingStatusTransition returns the employee's scheduled transition,
+// or nil when there is none.
+func (p *PostgresDB) GetPendingStatusTransition(employeeID int) (*models.StatusTransition, error) {
+	var tr models.StatusTransition
+	err := p.db.Where("employee_id = ? AND applied_at IS NULL", employeeID).First(&tr).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
+// DueStatusTransitions returns pending transitions effective on or before
+// date, oldest first.
+func (p *PostgresDB) DueStatusTransitions(date models.Date) ([]models.StatusTransition, error) {
+	var transitions []models.StatusTransition
+	err := p.db.Where("applied_at IS NULL AND effective_date <= ?", date).
+		Order("effective_date, id").Find(&transitions).Error
+	if err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// ApplyStatusTransition applies a pending transition. It returns
+// db.ErrConflict when the transition was already applied or cancelled, or
+// the employee's status changed since it was scheduled.
+func (p *PostgresDB) ApplyStatusTransition(tr *models.StatusTransition) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.StatusTransition{}).
+			Where("id = ? AND applied_at IS NULL", tr.ID).
+			Update("applied_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		if err := applyStatus(tx, tr); err != nil {
+			return err
+		}
+		tr.AppliedAt = &now
+		return nil
+	})
+}
+
+// CancelStatusTransition deletes a pending transition. Applied transitions
+// are history and return db.ErrConflict.
+func (p *PostgresDB) CancelStatusTransition(id int) error {
+	result := p.db.Where("id = ? AND applied_at IS NULL", id).Delete(&models.StatusTransition{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
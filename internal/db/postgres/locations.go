@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"employees/internal/holidays"
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// legacyCalendarName names the calendar that holidays entered before
+// calendars existed are moved into.
+const legacyCalendarName = "Company holidays"
+
+// migrateHolidays moves holidays entered before calendars existed, which
+// applied to everyone, into a calendar of their own and gives it to every
+// location without a calendar, so those holidays keep counting for leave.
+// Employees without a location observe no holidays either way.
+func migrateHolidays(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&models.Holiday{}).Where("calendar_id IS NULL").Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to migrate holidays: %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		cal := models.HolidayCalendar{Name: legacyCalendarName}
+		if err := tx.Where("name = ?", cal.Name).FirstOrCreate(&cal).Error; err != nil {
+			return err
+		}
+		// Dates were not unique before calendars, so keep the first of each.
+		if err := tx.Exec(`DELETE FROM holidays a USING holidays b
+			WHERE a.calendar_id IS NULL AND b.calendar_id IS NULL AND a.date = b.date AND a.id > b.id`).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`DELETE FROM holidays a USING holidays b
+			WHERE a.calendar_id IS NULL AND b.calendar_id = ? AND a.date = b.date`, cal.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Holiday{}).Where("calendar_id IS NULL").
+			Update("calendar_id", cal.ID).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Location{}).Where("holiday_calendar_id IS NULL").
+			Update("holiday_calendar_id", cal.ID).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate holidays: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresDB) CreateLocation(loc *models.Location) error {
+	return p.db.Create(loc).Error
+}
+
+func (p *PostgresDB) GetLocation(id int) (*models.Location, error) {
+	var loc models.Location
+	if err := p.db.First(&loc, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &loc, nil
+}
+
+func (p *PostgresDB) ListLocations() ([]models.Location, error) {
+	var locs []models.Location
+	if err := p.db.Order("name").Find(&locs).Error; err != nil {
+		return nil, err
+	}
+	return locs, nil
+}
+
+func (p *PostgresDB) UpdateLocation(loc *models.Location) error {
+	return p.db.Save(loc).Error
+}
+
+// DeleteLocation removes a location and detaches its employees from it.
+func (p *PostgresDB) DeleteLocation(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Employee{}).Where("location_id = ?", id).
+			Update("location_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Location{}, "id = ?", id).Error
+	})
+}
+
+func (p *PostgresDB) CreateHolidayCalendar(cal *models.HolidayCalendar) error {
+	return p.db.Create(cal).Error
+}
+
+func (p *PostgresDB) GetHolidayCalendar(id int) (*models.HolidayCalendar, error) {
+	var cal models.HolidayCalendar
+	if err := p.db.First(&cal, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &cal, nil
+}
+
+func (p *PostgresDB) ListHolidayCalendars() ([]models.HolidayCalendar, error) {
+	var cals []models.HolidayCalendar
+	if err := p.db.Order("name").Find(&cals).Error; err != nil {
+		return nil, err
+	}
+	return cals, nil
+}
+
+// DeleteHolidayCalendar removes a calendar with its holidays and rules and
+// leaves the locations that used it without one.
+func (p *PostgresDB) DeleteHolidayCalendar(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Location{}).Where("holiday_calendar_id = ?", id).
+			Update("holiday_calendar_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Holiday{}, "calendar_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.HolidayRule{}, "calendar_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.HolidayCalendar{}, "id = ?", id).Error
+	})
+}
+
+// AddHolidays stores dated holidays, renaming any that already exist on the
+// same day of the same calendar so that re-importing a file is harmless.
+func (p *PostgresDB) AddHolidays(hols []models.Holiday) error {
+	if len(hols) == 0 {
+		return nil
+	}
+	return p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "calendar_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}).Create(&hols).Error
+}
+
+func (p *PostgresDB) AddHolidayRule(rule *models.HolidayRule) error {
+	return p.db.Create(rule).Error
+}
+
+func (p *PostgresDB) ListHolidayRules(calendarID int) ([]models.HolidayRule, error) {
+	var rules []models.HolidayRule
+	if err := p.db.Where("calendar_id = ?", calendarID).Order("id").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ListCalendarHolidays returns the calendar's dated holidays together with
+// the dates its rules produce between from and to inclusive.
+func (p *PostgresDB) ListCalendarHolidays(calendarID int, from, to models.Date) ([]models.Holiday, error) {
+	var hols []models.Holiday
+	if err := p.db.Where("calendar_id = ? AND date BETWEEN ? AND ?", calendarID, from, to).
+		Find(&hols).Error; err != nil {
+		return nil, err
+	}
+
+	rules, err := p.ListHolidayRules(calendarID)
+	if err != nil {
+		return nil, err
+	}
+	computed, err := holidays.Expand(rules, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	hols = append(hols, computed...)
+	sort.SliceStable(hols, func(i, j int) bool {
+		return hols[i].Date.Before(hols[j].Date.Time)
+	})
+	return hols, nil
+}
+
+// GetHolidays returns the holidays observed by the employee between from
+// and to inclusive, which are those of their location's calendar.
+// Employees without a location or whose location has no calendar observe
+// none.
+func (p *PostgresDB) GetHolidays(employeeID int, from, to models.Date) ([]models.Holiday, error) {
+	emp, err := p.GetEmployee(strconv.Itoa(employeeID))
+	if err != nil {
+		return nil, err
+	}
+	if emp.LocationID == nil {
+		return []models.Holiday{}, nil
+	}
+
+	loc, err := p.GetLocation(*emp.LocationID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && loc.HolidayCalendarID == nil) {
+		return []models.Holiday{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.ListCalendarHolidays(*loc.HolidayCalendarID, from, to)
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+func orderKeyResults(db *gorm.DB) *gorm.DB {
+	return db.Order("key_results.id")
+}
+
+// CreateObjective stores the objective along with its key results.
+func (p *PostgresDB) CreateObjective(obj *models.Objective) error {
+	return p.db.Create(obj).Error
+}
+
+func (p *PostgresDB) GetObjective(id int) (*models.Objective, error) {
+	var obj models.Objective
+	if err := p.db.Preload("KeyResults", orderKeyResults).First(&obj, id).Error; err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+func (p *PostgresDB) ListObjectives(filter models.ObjectiveFilter) ([]models.Objective, error) {
+	query := p.db.Preload("KeyResults", orderKeyResults).Order("quarter, id")
+	if filter.Quarter != "" {
+		query = query.Where("quarter = ?", filter.Quarter)
+	}
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if filter.DepartmentID != nil {
+		query = query.Where("department_id = ?", *filter.DepartmentID)
+	}
+	if filter.TeamID != nil {
+		query = query.Where("team_id = ?", *filter.TeamID)
+	}
+	if filter.ManagerID != nil {
+		query = query.Where("employee_id IN (SELECT id FROM employees WHERE manager_id = ?)", *filter.ManagerID)
+	}
+	if filter.ParentID != nil {
+		query = query.Where("parent_id = ?", *filter.ParentID)
+	}
+	var objectives []models.Objective
+	if err := query.Find(&objectives).Error; err != nil {
+		return nil, err
+	}
+	return objectives, nil
+}
+
+// ListObjectiveTree returns the objective and every objective aligned
+// below it, however deep.
+func (p *PostgresDB) ListObjectiveTree(id int) ([]models.Objective, error) {
+	var objectives []models.Objective
+	err := p.db.Preload("KeyResults", orderKeyResults).
+		Where(`id IN (WITH RECURSIVE tree AS (
+			SELECT id FROM objectives WHERE id = ?
+			UNION
+			SELECT objectives.id FROM objectives JOIN tree ON objectives.parent_id = tree.id
+		) SELECT id FROM tree)`, id).
+		Order("id").Find(&objectives).Error
+	if err != nil {
+		return nil, err
+	}
+	return objectives, nil
+}
+
+// UpdateObjective saves the objective's own fields. Key results are
+// changed through their own methods.
+func (p *PostgresDB) UpdateObjective(obj *models.Objective) error {
+	result := p.db.Model(obj).Omit("KeyResults").
+		Select("title", "description", "quarter", "employee_id", "department_id", "team_id", "parent_id", "updated_at").
+		Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteObjective deletes the objective with its key results and their
+// check-ins. Objectives aligned to it are realigned to its parent.
+func (p *PostgresDB) DeleteObjective(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var obj models.Objective
+		if err := tx.First(&obj, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Objective{}).Where("parent_id = ?", id).
+			Update("parent_id", obj.ParentID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("key_result_id IN (SELECT id FROM key_results WHERE objective_id = ?)", id).
+			Delete(&models.CheckIn{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.KeyResult{}, "objective_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&obj).Error
+	})
+}
+
+func (p *PostgresDB) CreateKeyResult(kr *models.KeyResult) error {
+	return p.db.Create(kr).Error
+}
+
+func (p *PostgresDB) GetKeyResult(id int) (*models.KeyResult, error) {
+	var kr models.KeyResult
+	if err := p.db.First(&kr, id).Error; err != nil {
+		return nil, err
+	}
+	return &kr, nil
+}
+
+// UpdateKeyResult saves the key result's definition. Its current value
+// only changes through check-ins.
+func (p *PostgresDB) UpdateKeyResult(kr *models.KeyResult) error {
+	result := p.db.Model(kr).Select("title", "unit", "start_value", "target_value", "weight", "updated_at").Updates(kr)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDB) DeleteKeyResult(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.CheckIn{}, "key_result_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.KeyResult{}, id).Error
+	})
+}
+
+// RecordCheckIn stores the check-in and moves its key result to the new
+// value.
+func (p *PostgresDB) RecordCheckIn(ci *models.CheckIn) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.KeyResult{}).Where("id = ?", ci.KeyResultID).Update("current_value", ci.Value)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(ci).Error
+	})
+}
+
+// ListCheckIns returns the key result's check-ins, oldest first.
+func (p *PostgresDB) ListCheckIns(keyResultID int) ([]models.CheckIn, error) {
+	var checkIns []models.CheckIn
+	if err := p.db.Where("key_result_id = ?", keyResultID).Order("created_at, id").Find(&checkIns).Error; err != nil {
+		return nil, err
+	}
+	return checkIns, nil
+}
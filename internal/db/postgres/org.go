@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"database/sql"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// The recursive queries below carry the visited ids in a path array so that a
+// cycle already present in the data ends the walk instead of looping forever.
+
+const reportingChainQuery = `
+WITH RECURSIVE chain AS (
+	SELECT m.id, m.manager_id, 1 AS depth, ARRAY[e.id, m.id] AS path
+	FROM employees e
+	JOIN employees m ON m.id = e.manager_id
+	WHERE e.id = @id
+	UNION ALL
+	SELECT m.id, m.manager_id, c.depth + 1, c.path || m.id
+	FROM chain c
+	JOIN employees m ON m.id = c.manager_id
+	WHERE NOT m.id = ANY(c.path)
+)
+SELECT employees.* FROM chain
+JOIN employees ON employees.id = chain.id
+ORDER BY chain.depth`
+
+const subordinatesQuery = `
+WITH RECURSIVE subtree AS (
+	SELECT id, 1 AS depth, ARRAY[@id::bigint, id] AS path
+	FROM employees
+	WHERE manager_id = @id
+	UNION ALL
+	SELECT e.id, s.depth + 1, s.path || e.id
+	FROM subtree s
+	JOIN employees e ON e.manager_id = s.id
+	WHERE NOT e.id = ANY(s.path)
+)
+SELECT employees.* FROM subtree
+JOIN employees ON employees.id = subtree.id
+ORDER BY subtree.depth, employees.id`
+
+func (p *PostgresDB) GetDirectReports(managerID int) ([]models.Employee, error) {
+	var emps []models.Employee
+	if err := p.db.Where("manager_id = ?", managerID).Order("id").Find(&emps).Error; err != nil {
+		return nil, err
+	}
+	return emps, nil
+}
+
+// GetReportingChain returns the managers above an employee, starting with the
+// direct manager and ending with the top of the hierarchy.
+func (p *PostgresDB) GetReportingChain(id int) ([]models.Employee, error) {
+	var emps []models.Employee
+	if err := p.db.Raw(reportingChainQuery, sql.Named("id", id)).Scan(&emps).Error; err != nil {
+		return nil, err
+	}
+	return emps, nil
+}
+
+// GetSubordinates returns everyone reporting to a manager directly or
+// indirectly, ordered breadth-first.
+func (p *PostgresDB) GetSubordinates(managerID int) ([]models.Employee, error) {
+	var emps []models.Employee
+	if err := p.db.Raw(subordinatesQuery, sql.Named("id", managerID)).Scan(&emps).Error; err != nil {
+		return nil, err
+	}
+	return emps, nil
+}
+
+func (p *PostgresDB) CreateDepartment(dept *models.Department) error {
+	return p.db.Create(dept).Error
+}
+
+func (p *PostgresDB) GetDepartment(id int) (*models.Department, error) {
+	var dept models.Department
+	if err := p.db.First(&dept, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &dept, nil
+}
+
+func (p *PostgresDB) ListDepartments() ([]models.Department, error) {
+	var depts []models.Department
+	if err := p.db.Order("name").Find(&depts).Error; err != nil {
+		return nil, err
+	}
+	return depts, nil
+}
+
+func (p *PostgresDB) UpdateDepartment(dept *models.Department) error {
+	return p.db.Save(dept).Error
+}
+
+// DeleteDepartment removes a department and detaches its employees from it.
+func (p *PostgresDB) DeleteDepartment(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Employee{}).Where("department_id = ?", id).
+			Update("department_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Department{}, "id = ?", id).Error
+	})
+}
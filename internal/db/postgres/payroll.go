@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreatePayrollRun(run *models.PayrollRun) error {
+	return p.db.Create(run).Error
+}
+
+func (p *PostgresDB) GetPayrollRun(id int) (*models.PayrollRun, error) {
+	var run models.PayrollRun
+	err := p.db.Preload("Payslips", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("employee_id")
+	}).First(&run, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (p *PostgresDB) ListPayrollRuns() ([]models.PayrollRun, error) {
+	var runs []models.PayrollRun
+	if err := p.db.Omit("rule_set_source").Order("period_start DESC, id DESC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// RecalculatePayrollRun replaces a draft run's parameters and payslips. It
+// returns db.ErrConflict when the run has been locked in the meantime.
+func (p *PostgresDB) RecalculatePayrollRun(run *models.PayrollRun) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.PayrollRun{}).
+			Where("id = ? AND status = ?", run.ID, models.PayrollRunStatusDraft).
+			Select("rule_set", "rule_set_source", "skipped", "updated_at").
+			Updates(run)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+
+		if err := tx.Where("run_id = ?", run.ID).Delete(&models.Payslip{}).Error; err != nil {
+			return err
+		}
+		if len(run.Payslips) == 0 {
+			return nil
+		}
+		for i := range run.Payslips {
+			run.Payslips[i].ID = 0
+			run.Payslips[i].RunID = run.ID
+		}
+		return tx.Create(&run.Payslips).Error
+	})
+}
+
+// LockPayrollRun freezes a draft run. It returns db.ErrConflict when the run
+// is already locked.
+func (p *PostgresDB) LockPayrollRun(id int, adminID *int) error {
+	result := p.db.Model(&models.PayrollRun{}).
+		Where("id = ? AND status = ?", id, models.PayrollRunStatusDraft).
+		Updates(map[string]any{
+			"status":             models.PayrollRunStatusLocked,
+			"locked_at":          time.Now(),
+			"locked_by_admin_id": adminID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// DeletePayrollRun removes a draft run and its payslips. Locked runs are
+// kept for auditing and return db.ErrConflict.
+func (p *PostgresDB) DeletePayrollRun(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND status = ?", id, models.PayrollRunStatusDraft).Delete(&models.PayrollRun{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+		return tx.Where("run_id = ?", id).Delete(&models.Payslip{}).Error
+	})
+}
+
+func (p *PostgresDB) GetPayslip(runID, employeeID int) (*models.Payslip, error) {
+	var slip models.Payslip
+	if err := p.db.Where("run_id = ? AND employee_id = ?", runID, employeeID).First(&slip).Error; err != nil {
+		return nil, err
+	}
+	return &slip, nil
+}
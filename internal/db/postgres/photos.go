@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// SetEmployeePhoto points the employee at a new photo, or at none when url
+// is empty.
+func (p *PostgresDB) SetEmployeePhoto(employeeID int, url string) error {
+	result := p.db.Model(&models.Employee{}).Where("id = ?", employeeID).Update("photo_url", url)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
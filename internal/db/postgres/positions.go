@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"database/sql"
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migratePositions gives every employee without a position history one
+// position taken from their current title, department and manager,
+// effective from the day they were created. It is safe to run on every
+// start: employees with positions are skipped.
+func migratePositions(db *gorm.DB) error {
+	err := db.Exec(`INSERT INTO positions (employee_id, job_title, department_id, manager_id, effective_from, created_at)
+		SELECT id, job_title, department_id, manager_id, created_at::date, now() FROM employees
+		WHERE NOT EXISTS (SELECT 1 FROM positions WHERE positions.employee_id = employees.id)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate positions: %w", err)
+	}
+	return nil
+}
+
+// addPosition splices pos into the employee's history: a position starting
+// on the same day is replaced, the one before it is closed on pos's start
+// date and pos runs until the next one starts. When pos is in effect today
+// the employee row is brought in line with it.
+func addPosition(tx *gorm.DB, pos *models.Position) error {
+	if err := tx.Where("employee_id = ? AND effective_from = ?", pos.EmployeeID, pos.EffectiveFrom).
+		Delete(&models.Position{}).Error; err != nil {
+		return err
+	}
+
+	var prev models.Position
+	err := tx.Where("employee_id = ? AND effective_from < ?", pos.EmployeeID, pos.EffectiveFrom).
+		Order("effective_from DESC").First(&prev).Error
+	if err == nil {
+		if err := tx.Model(&prev).Update("effective_to", pos.EffectiveFrom).Error; err != nil {
+			return err
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	var next models.Position
+	err = tx.Where("employee_id = ? AND effective_from > ?", pos.EmployeeID, pos.EffectiveFrom).
+		Order("effective_from").First(&next).Error
+	if err == nil {
+		pos.EffectiveTo = &next.EffectiveFrom
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		pos.EffectiveTo = nil
+	} else {
+		return err
+	}
+
+	if err := tx.Create(pos).Error; err != nil {
+		return err
+	}
+
+	if pos.ActiveOn(models.DateOf(time.Now())) {
+		return tx.Model(&models.Employee{}).Where("id = ?", pos.EmployeeID).Updates(map[string]any{
+			"job_title":     pos.JobTitle,
+			"department_id": pos.DepartmentID,
+			"manager_id":    pos.ManagerID,
+		}).Error
+	}
+	return nil
+}
+
+func positionChanged(before, after models.Employee) bool {
+	return before.JobTitle != after.JobTitle ||
+		!sameID(before.DepartmentID, after.DepartmentID) ||
+		!sameID(before.ManagerID, after.ManagerID)
+}
+
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func positionFrom(emp *models.Employee, from models.Date) *models.Position {
+	return &models.Position{
+		EmployeeID:    emp.ID,
+		JobTitle:      emp.JobTitle,
+		DepartmentID:  emp.DepartmentID,
+		ManagerID:     emp.ManagerID,
+		EffectiveFrom: from,
+	}
+}
+
+func (p *PostgresDB) AddPosition(pos *models.Position) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		return addPosition(tx, pos)
+	})
+}
+
+func (p *PostgresDB) GetPositions(employeeID int) ([]models.Position, error) {
+	var positions []models.Position
+	if err := p.db.Where("employee_id = ?", employeeID).Order("effective_from").Find(&positions).Error; err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+func (p *PostgresDB) GetPositionAsOf(employeeID int, date models.Date) (*models.Position, error) {
+	var pos models.Position
+	err := p.db.Where("employee_id = ? AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)",
+		employeeID, date, date).First(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pos, nil
+}
+
+// ActivatePositions copies every position in effect on date onto its
+// employee row where the two differ, returning how many rows changed. This
+// is what makes future-dated changes take effect once their day arrives.
+func (p *PostgresDB) ActivatePositions(date models.Date) (int, error) {
+	result := p.db.Exec(`
+UPDATE employees
+SET job_title = p.job_title, department_id = p.department_id, manager_id = p.manager_id, updated_at = now()
+FROM positions p
+WHERE p.employee_id = employees.id
+	AND p.effective_from <= @date
+	AND (p.effective_to IS NULL OR p.effective_to > @date)
+	AND (employees.job_title IS DISTINCT FROM p.job_title
+		OR employees.department_id IS DISTINCT FROM p.department_id
+		OR employees.manager_id IS DISTINCT FROM p.manager_id)`,
+		sql.Named("date", date))
+	return int(result.RowsAffected), result.Error
+}
@@ -1,8 +1,11 @@
 package postgres
 
 import (
+	"employees/internal/address"
 	"employees/internal/models"
 	"fmt"
+	"strings"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -32,6 +35,10 @@ func NewPostgresDB(config Config) (*PostgresDB, error) {
 	}
 
 	// Auto-migrate the schema
+	if err := db.AutoMigrate(&models.Department{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
 	if err := db.AutoMigrate(&models.Employee{}); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %v", err)
 	}
@@ -40,27 +47,307 @@ func NewPostgresDB(config Config) (*PostgresDB, error) {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
+	if err := db.AutoMigrate(&models.Position{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := migratePositions(db); err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&models.LeaveType{}, &models.AccrualPolicy{}, &models.LeaveRequest{},
+		&models.BalanceAdjustment{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Location{}, &models.HolidayCalendar{}, &models.Holiday{},
+		&models.HolidayRule{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := migrateHolidays(db); err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&models.Project{}, &models.TimeEntry{}, &models.Timesheet{},
+		&models.OvertimePolicy{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Compensation{}, &models.Bonus{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.PayrollRun{}, &models.Payslip{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.StatusTransition{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.ChecklistTemplate{}, &models.Checklist{}, &models.ChecklistTask{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Document{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Address{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := migrateAddresses(db); err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&models.EmergencyContact{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.CustomFieldDefinition{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := setupCustomFields(db); err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&models.Skill{}, &models.EmployeeSkill{}, &models.Certification{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.ReviewTemplate{}, &models.ReviewCycle{}, &models.Review{},
+		&models.ReviewCalibration{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Objective{}, &models.KeyResult{}, &models.CheckIn{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Team{}, &models.Assignment{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Asset{}, &models.AssetAssignment{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Requisition{}, &models.PipelineStage{}, &models.Candidate{}, &models.InterviewFeedback{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.PlannedPosition{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.ExpenseCategory{}, &models.ExpenseClaim{}, &models.ExpenseItem{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.Contract{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := setupSearch(db); err != nil {
+		return nil, err
+	}
+
 	return &PostgresDB{db: db}, nil
 }
 
+// createEmployeeTx creates emp with an employment history starting on from
+// and, unless they are still a candidate, starts their onboarding
+// checklists.
+func createEmployeeTx(tx *gorm.DB, emp *models.Employee, from models.Date) error {
+	emp.AddressText = address.FormatAll(emp.Addresses)
+	if err := tx.Create(emp).Error; err != nil {
+		return err
+	}
+	if err := addPosition(tx, positionFrom(emp, from)); err != nil {
+		return err
+	}
+	if emp.Status == models.EmployeeStatusCandidate {
+		return nil
+	}
+	_, err := startChecklists(tx, emp, models.ChecklistKindOnboarding, from)
+	return err
+}
+
+// CreateEmployee inserts the employee and opens their employment history
+// with a position starting today. Anyone but a candidate also gets the
+// onboarding checklists that match them.
 func (p *PostgresDB) CreateEmployee(emp *models.Employee) error {
-	return p.db.Create(emp).Error
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		return createEmployeeTx(tx, emp, models.DateOf(time.Now()))
+	})
+}
+
+// CreateEmployees creates all of emps or, if any of them fails, none.
+func (p *PostgresDB) CreateEmployees(emps []models.Employee) error {
+	today := models.DateOf(time.Now())
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		for i := range emps {
+			if err := createEmployeeTx(tx, &emps[i], today); err != nil {
+				return fmt.Errorf("employee %d: %w", i, err)
+			}
+		}
+		return nil
+	})
+}
+
+// FindEmployeeEmails returns those of emails already used by an employee,
+// compared case-insensitively, in lower case.
+func (p *PostgresDB) FindEmployeeEmails(emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+	lower := make([]string, len(emails))
+	for i, email := range emails {
+		lower[i] = strings.ToLower(email)
+	}
+	var taken []string
+	err := p.db.Model(&models.Employee{}).Where("lower(email) IN ?", lower).Pluck("lower(email)", &taken).Error
+	if err != nil {
+		return nil, err
+	}
+	return taken, nil
 }
 
 func (p *PostgresDB) GetEmployee(id string) (*models.Employee, error) {
 	var emp models.Employee
-	if err := p.db.First(&emp, "id = ?", id).Error; err != nil {
+	if err := p.db.Preload("Addresses", orderByType).First(&emp, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &emp, nil
 }
 
+// UpdateEmployee saves the employee and, when the job title, department or
+// manager changed, records the change in their history as of today. Nil
+// Addresses or CustomFields leave the employee's existing ones as they are.
 func (p *PostgresDB) UpdateEmployee(emp *models.Employee) error {
-	return p.db.Save(emp).Error
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.Employee
+		if err := tx.First(&existing, "id = ?", emp.ID).Error; err != nil {
+			return err
+		}
+
+		// Status only changes through status transitions and the photo
+		// only through uploads.
+		emp.CreatedAt = existing.CreatedAt
+		emp.Status = existing.Status
+		emp.PhotoURL = existing.PhotoURL
+		emp.AddressText = existing.AddressText
+		if emp.CustomFields == nil {
+			emp.CustomFields = existing.CustomFields
+		}
+		if err := tx.Omit("Addresses").Save(emp).Error; err != nil {
+			return err
+		}
+		if emp.Addresses != nil {
+			if err := replaceAddresses(tx, emp.ID, emp.Addresses); err != nil {
+				return err
+			}
+		}
+
+		if positionChanged(existing, *emp) {
+			return addPosition(tx, positionFrom(emp, models.DateOf(time.Now())))
+		}
+		return nil
+	})
 }
 
+// DeleteEmployee removes an employee and leaves their direct reports without
+// a manager rather than pointing at a missing row.
 func (p *PostgresDB) DeleteEmployee(id string) error {
-	return p.db.Delete(&models.Employee{}, "id = ?", id).Error
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Employee{}).Where("manager_id = ?", id).
+			Update("manager_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Position{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Address{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.EmergencyContact{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.EmployeeSkill{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Certification{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Assignment{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Review{}, "subject_id = ?", id).Error; err != nil {
+			return err
+		}
+		// Feedback they already gave about others is kept; reviews they
+		// still owed can no longer be written.
+		if err := tx.Delete(&models.Review{}, "reviewer_id = ? AND submitted_at IS NULL", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.ReviewCalibration{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Candidate{}).Where("employee_id = ?", id).
+			Update("employee_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.PlannedPosition{}).Where("employee_id = ?", id).
+			Updates(map[string]any{"status": models.PlannedPositionStatusOpen, "employee_id": nil}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Contract{}, "employee_id = ?", id).Error; err != nil {
+			return err
+		}
+		// Anything still checked out to them is checked back in as available
+		// so it can be handed out again; the assignment history is kept.
+		now := time.Now()
+		if err := tx.Model(&models.AssetAssignment{}).Where("employee_id = ? AND checked_in_at IS NULL", id).
+			Updates(map[string]any{
+				"checked_in_at":      now,
+				"check_in_note":      "employee deleted",
+				"returned_as_status": models.AssetStatusAvailable,
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Asset{}).Where("employee_id = ? AND status = ?", id, models.AssetStatusAssigned).
+			Updates(map[string]any{"status": models.AssetStatusAvailable, "employee_id": nil, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Employee{}, "id = ?", id).Error
+	})
+}
+
+func (p *PostgresDB) ListEmployees(filter models.EmployeeFilter) ([]models.Employee, error) {
+	query := p.db.Preload("Addresses", orderByType).Order("id")
+	if filter.DepartmentID != nil {
+		query = query.Where("department_id = ?", *filter.DepartmentID)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.Country != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM addresses WHERE addresses.employee_id = employees.id AND addresses.country = ?)",
+			filter.Country)
+	}
+	query, err := whereCustomFields(query, filter.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var emps []models.Employee
+	if err := query.Find(&emps).Error; err != nil {
+		return nil, err
+	}
+	return emps, nil
 }
 
 func (p *PostgresDB) CreateAdmin(admin *models.Admin) error {
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreateRequisition(req *models.Requisition) error {
+	return p.db.Create(req).Error
+}
+
+func (p *PostgresDB) GetRequisition(id int) (*models.Requisition, error) {
+	var req models.Requisition
+	if err := p.db.First(&req, id).Error; err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (p *PostgresDB) ListRequisitions(status models.RequisitionStatus) ([]models.Requisition, error) {
+	query := p.db.Order("created_at DESC, id DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var reqs []models.Requisition
+	if err := query.Find(&reqs).Error; err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+func (p *PostgresDB) UpdateRequisition(req *models.Requisition) error {
+	result := p.db.Model(req).Select("title", "description", "department_id", "location_id", "hiring_manager_id",
+		"openings", "status", "updated_at").Updates(req)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteRequisition deletes a requisition nobody has applied for. It
+// returns db.ErrConflict once it has candidates; cancel it instead.
+func (p *PostgresDB) DeleteRequisition(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var candidates int64
+		if err := tx.Model(&models.Candidate{}).Where("requisition_id = ?", id).Count(&candidates).Error; err != nil {
+			return err
+		}
+		if candidates > 0 {
+			return db.ErrConflict
+		}
+		result := tx.Delete(&models.Requisition{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (p *PostgresDB) CreatePipelineStage(stage *models.PipelineStage) error {
+	return p.db.Create(stage).Error
+}
+
+func (p *PostgresDB) GetPipelineStage(id int) (*models.PipelineStage, error) {
+	var stage models.PipelineStage
+	if err := p.db.First(&stage, id).Error; err != nil {
+		return nil, err
+	}
+	return &stage, nil
+}
+
+func (p *PostgresDB) ListPipelineStages() ([]models.PipelineStage, error) {
+	var stages []models.PipelineStage
+	if err := p.db.Order("position, id").Find(&stages).Error; err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
+
+func (p *PostgresDB) UpdatePipelineStage(stage *models.PipelineStage) error {
+	result := p.db.Model(stage).Select("name", "position", "updated_at").Updates(stage)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeletePipelineStage removes a stage from the pipeline. It returns
+// db.ErrConflict while active candidates are still at it. Feedback given
+// at the stage is kept without it.
+func (p *PostgresDB) DeletePipelineStage(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var candidates int64
+		if err := tx.Model(&models.Candidate{}).
+			Where("stage_id = ? AND status = ?", id, models.CandidateStatusActive).
+			Count(&candidates).Error; err != nil {
+			return err
+		}
+		if candidates > 0 {
+			return db.ErrConflict
+		}
+		if err := tx.Model(&models.Candidate{}).Where("stage_id = ?", id).Update("stage_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.InterviewFeedback{}).Where("stage_id = ?", id).Update("stage_id", nil).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&models.PipelineStage{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (p *PostgresDB) CreateCandidate(c *models.Candidate) error {
+	return p.db.Create(c).Error
+}
+
+func (p *PostgresDB) GetCandidate(id int) (*models.Candidate, error) {
+	var c models.Candidate
+	if err := p.db.First(&c, id).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (p *PostgresDB) ListCandidates(filter models.CandidateFilter) ([]models.Candidate, error) {
+	query := p.db.Order("created_at, id")
+	if filter.RequisitionID != nil {
+		query = query.Where("requisition_id = ?", *filter.RequisitionID)
+	}
+	if filter.StageID != nil {
+		query = query.Where("stage_id = ?", *filter.StageID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	var candidates []models.Candidate
+	if err := query.Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// UpdateCandidate saves the candidate's details, stage and status. It
+// returns db.ErrConflict unless the candidate is still active, as hired,
+// rejected and withdrawn candidates are closed.
+func (p *PostgresDB) UpdateCandidate(c *models.Candidate) error {
+	result := p.db.Model(c).Where("status = ?", models.CandidateStatusActive).
+		Select("first_name", "last_name", "email", "phone", "source", "stage_id", "status", "notes", "updated_at").
+		Updates(c)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+func (p *PostgresDB) CreateInterviewFeedback(f *models.InterviewFeedback) error {
+	return p.db.Create(f).Error
+}
+
+func (p *PostgresDB) ListInterviewFeedback(candidateID int) ([]models.InterviewFeedback, error) {
+	var feedback []models.InterviewFeedback
+	if err := p.db.Where("candidate_id = ?", candidateID).Order("created_at, id").Find(&feedback).Error; err != nil {
+		return nil, err
+	}
+	return feedback, nil
+}
+
+// HireCandidate creates emp for candidate c, starting their employment
+// history on tr's effective date, records tr and marks c hired. The
+// requisition is filled once it has as many hires as openings. It returns
+// db.ErrConflict when c is no longer active or the requisition no longer
+// open.
+func (p *PostgresDB) HireCandidate(c *models.Candidate, emp *models.Employee, tr *models.StatusTransition) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := createEmployeeTx(tx, emp, tr.EffectiveDate); err != nil {
+			return err
+		}
+
+		tr.EmployeeID = emp.ID
+		if tr.AppliedAt != nil {
+			if err := applyStatus(tx, tr); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(tr).Error; err != nil {
+			return err
+		}
+
+		c.Status = models.CandidateStatusHired
+		c.EmployeeID = &emp.ID
+		c.StartDate = &tr.EffectiveDate
+		result := tx.Model(c).
+			Where("status = ? AND requisition_id IN (SELECT id FROM requisitions WHERE status = ?)",
+				models.CandidateStatusActive, models.RequisitionStatusOpen).
+			Updates(map[string]any{"status": c.Status, "employee_id": emp.ID, "start_date": tr.EffectiveDate,
+				"updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return db.ErrConflict
+		}
+
+		return tx.Model(&models.Requisition{}).
+			Where("id = ? AND openings <= (SELECT count(*) FROM candidates WHERE requisition_id = ? AND status = ?)",
+				c.RequisitionID, c.RequisitionID, models.CandidateStatusHired).
+			Updates(map[string]any{"status": models.RequisitionStatusFilled, "updated_at": time.Now()}).Error
+	})
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *PostgresDB) CreateReviewTemplate(tpl *models.ReviewTemplate) error {
+	return p.db.Create(tpl).Error
+}
+
+func (p *PostgresDB) GetReviewTemplate(id int) (*models.ReviewTemplate, error) {
+	var tpl models.ReviewTemplate
+	if err := p.db.First(&tpl, id).Error; err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+func (p *PostgresDB) ListReviewTemplates() ([]models.ReviewTemplate, error) {
+	var templates []models.ReviewTemplate
+	if err := p.db.Order("id").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (p *PostgresDB) UpdateReviewTemplate(tpl *models.ReviewTemplate) error {
+	result := p.db.Model(tpl).Select("name", "questions", "rating_scale", "rating_labels", "updated_at").Updates(tpl)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDB) DeleteReviewTemplate(id int) error {
+	return p.db.Delete(&models.ReviewTemplate{}, id).Error
+}
+
+func (p *PostgresDB) CreateReviewCycle(cycle *models.ReviewCycle) error {
+	return p.db.Create(cycle).Error
+}
+
+func (p *PostgresDB) GetReviewCycle(id int) (*models.ReviewCycle, error) {
+	var cycle models.ReviewCycle
+	if err := p.db.First(&cycle, id).Error; err != nil {
+		return nil, err
+	}
+	return &cycle, nil
+}
+
+// ListReviewCycles returns the cycles with status, or all of them when
+// status is empty, newest first.
+func (p *PostgresDB) ListReviewCycles(status models.ReviewCycleStatus) ([]models.ReviewCycle, error) {
+	query := p.db.Order("id DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var cycles []models.ReviewCycle
+	if err := query.Find(&cycles).Error; err != nil {
+		return nil, err
+	}
+	return cycles, nil
+}
+
+// DeleteReviewCycle deletes a draft cycle. It returns db.ErrConflict for a
+// cycle that has been opened.
+func (p *PostgresDB) DeleteReviewCycle(id int) error {
+	result := p.db.Where("status = ?", models.ReviewCycleDraft).Delete(&models.ReviewCycle{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// moveReviewCycle moves the cycle from one status to another, returning
+// db.ErrConflict when it is no longer in from.
+func moveReviewCycle(tx *gorm.DB, id int, from, to models.ReviewCycleStatus) error {
+	updates := map[string]any{"status": to, "updated_at": time.Now()}
+	if to == models.ReviewCycleReleased {
+		updates["released_at"] = time.Now()
+	}
+	result := tx.Model(&models.ReviewCycle{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// OpenReviewCycle opens a draft cycle and creates its reviews in the same
+// transaction.
+func (p *PostgresDB) OpenReviewCycle(id int, reviews []models.Review) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if err := moveReviewCycle(tx, id, models.ReviewCycleDraft, models.ReviewCycleOpen); err != nil {
+			return err
+		}
+		if len(reviews) == 0 {
+			return nil
+		}
+		return tx.Create(&reviews).Error
+	})
+}
+
+// AdvanceReviewCycle moves the cycle on from one status to the next.
+func (p *PostgresDB) AdvanceReviewCycle(id int, from, to models.ReviewCycleStatus) error {
+	return moveReviewCycle(p.db, id, from, to)
+}
+
+func (p *PostgresDB) ListReviews(filter models.ReviewFilter) ([]models.Review, error) {
+	query := p.db.Order("cycle_id, subject_id, kind, reviewer_id")
+	if filter.CycleID != nil {
+		query = query.Where("cycle_id = ?", *filter.CycleID)
+	}
+	if filter.SubjectID != nil {
+		query = query.Where("subject_id = ?", *filter.SubjectID)
+	}
+	if filter.ReviewerID != nil {
+		query = query.Where("reviewer_id = ?", *filter.ReviewerID)
+	}
+	var reviews []models.Review
+	if err := query.Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (p *PostgresDB) GetReview(id int) (*models.Review, error) {
+	var rev models.Review
+	if err := p.db.First(&rev, id).Error; err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// UpdateReview saves the review's answers. It returns db.ErrConflict when
+// the review was submitted in the meantime.
+func (p *PostgresDB) UpdateReview(rev *models.Review) error {
+	result := p.db.Model(rev).Where("submitted_at IS NULL").
+		Select("answers", "rating", "submitted_at", "answered_by_admin_id", "updated_at").Updates(rev)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// ListReviewsToRemind returns the unsubmitted reviews of open cycles that
+// are within their cycle's reminder period on date and have not yet been
+// reminded.
+func (p *PostgresDB) ListReviewsToRemind(date models.Date) ([]models.Review, error) {
+	var reviews []models.Review
+	err := p.db.Joins("JOIN review_cycles ON review_cycles.id = reviews.cycle_id").
+		Where("review_cycles.status = ? AND reviews.submitted_at IS NULL AND reviews.reminded_on IS NULL",
+			models.ReviewCycleOpen).
+		Where("review_cycles.due_date - review_cycles.reminder_days <= ?", date).
+		Order("reviews.reviewer_id, reviews.id").Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// MarkReviewsReminded records that the reviewers of ids were reminded on
+// date.
+func (p *PostgresDB) MarkReviewsReminded(ids []int, date models.Date) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return p.db.Model(&models.Review{}).Where("id IN ?", ids).UpdateColumn("reminded_on", date).Error
+}
+
+func (p *PostgresDB) ListReviewCalibrations(cycleID int) ([]models.ReviewCalibration, error) {
+	var calibrations []models.ReviewCalibration
+	if err := p.db.Where("cycle_id = ?", cycleID).Order("employee_id").Find(&calibrations).Error; err != nil {
+		return nil, err
+	}
+	return calibrations, nil
+}
+
+// SetReviewCalibration records the employee's final rating in the cycle,
+// replacing any earlier one.
+func (p *PostgresDB) SetReviewCalibration(c *models.ReviewCalibration) error {
+	return p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cycle_id"}, {Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "note", "admin_id", "updated_at"}),
+	}).Create(c).Error
+}
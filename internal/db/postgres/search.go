@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"database/sql"
+	"employees/internal/models"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+// employeeDocument is the text searched by the full-text index. It must stay
+// identical to the expression in employees_search_idx for the index to be used.
+const employeeDocument = `to_tsvector('simple', coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '') || ' ' || coalesce(address, ''))`
+
+// highlight returns the ts_headline of column with the matches delimited by
+// models.HighlightStart and models.HighlightStop, which are first removed
+// from the column's value so that they can only come from a match.
+func highlight(column string) string {
+	delims := models.HighlightStart + models.HighlightStop
+	return `ts_headline('simple', translate(` + column + `, '` + delims + `', ''), to_tsquery('simple', @tsq), ` +
+		`'StartSel=` + models.HighlightStart + `, StopSel=` + models.HighlightStop + `, HighlightAll=true')`
+}
+
+// setupSearch enables pg_trgm and creates the indexes backing SearchEmployees.
+func setupSearch(db *gorm.DB) error {
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE INDEX IF NOT EXISTS employees_search_idx ON employees USING GIN (` + employeeDocument + `)`,
+		`CREATE INDEX IF NOT EXISTS employees_first_name_trgm_idx ON employees USING GIN (first_name gin_trgm_ops)`,
+		`CREATE INDEX IF NOT EXISTS employees_last_name_trgm_idx ON employees USING GIN (last_name gin_trgm_ops)`,
+		`CREATE INDEX IF NOT EXISTS employees_email_trgm_idx ON employees USING GIN (email gin_trgm_ops)`,
+		`CREATE INDEX IF NOT EXISTS employees_address_trgm_idx ON employees USING GIN (address gin_trgm_ops)`,
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to set up employee search: %w", err)
+		}
+	}
+	return nil
+}
+
+type searchRow struct {
+	models.Employee
+	Rank               float64
+	FirstNameHighlight string
+	LastNameHighlight  string
+	EmailHighlight     string
+	AddressHighlight   string
+}
+
+// SearchEmployees combines prefix full-text matching with trigram similarity
+// so that partial and misspelled names still find the right people.
+func (p *PostgresDB) SearchEmployees(query string, limit int) ([]models.EmployeeSearchResult, error) {
+	tsQuery := prefixTSQuery(query)
+	if tsQuery == "" {
+		return []models.EmployeeSearchResult{}, nil
+	}
+
+	stmt := `
+SELECT employees.*,
+	ts_rank(` + employeeDocument + `, to_tsquery('simple', @tsq)) +
+	GREATEST(
+		similarity(first_name, @q),
+		similarity(last_name, @q),
+		similarity(first_name || ' ' || last_name, @q),
+		similarity(email, @q),
+		word_similarity(@q, coalesce(address, ''))
+	) AS rank,
+	` + highlight("first_name") + ` AS first_name_highlight,
+	` + highlight("last_name") + ` AS last_name_highlight,
+	` + highlight("email") + ` AS email_highlight,
+	` + highlight("coalesce(address, '')") + ` AS address_highlight
+FROM employees
+WHERE ` + employeeDocument + ` @@ to_tsquery('simple', @tsq)
+	OR first_name % @q
+	OR last_name % @q
+	OR (first_name || ' ' || last_name) % @q
+	OR email % @q
+	OR @q <% coalesce(address, '')
+ORDER BY rank DESC, id
+LIMIT @limit`
+
+	var rows []searchRow
+	err := p.db.Raw(stmt,
+		sql.Named("q", query),
+		sql.Named("tsq", tsQuery),
+		sql.Named("limit", limit),
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.EmployeeSearchResult, 0, len(rows))
+	for _, row := range rows {
+		highlights := map[string]string{}
+		addHighlight(highlights, "firstName", row.FirstNameHighlight)
+		addHighlight(highlights, "lastName", row.LastNameHighlight)
+		addHighlight(highlights, "email", row.EmailHighlight)
+		addHighlight(highlights, "address", row.AddressHighlight)
+		results = append(results, models.EmployeeSearchResult{
+			Employee:   row.Employee,
+			Rank:       row.Rank,
+			Highlights: highlights,
+		})
+	}
+	return results, nil
+}
+
+// prefixTSQuery turns free text into a tsquery requiring every term as a
+// prefix, e.g. "jo smi" becomes "jo:* & smi:*". Punctuation is dropped so
+// user input can never produce a malformed tsquery.
+func prefixTSQuery(query string) string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+func addHighlight(highlights map[string]string, field, value string) {
+	if marked, ok := models.MarkHighlights(value); ok {
+		highlights[field] = marked
+	}
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *PostgresDB) CreateSkill(skill *models.Skill) error {
+	return p.db.Create(skill).Error
+}
+
+func (p *PostgresDB) GetSkill(id int) (*models.Skill, error) {
+	var skill models.Skill
+	if err := p.db.First(&skill, id).Error; err != nil {
+		return nil, err
+	}
+	return &skill, nil
+}
+
+func (p *PostgresDB) ListSkills() ([]models.Skill, error) {
+	var skills []models.Skill
+	if err := p.db.Order("name").Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+func (p *PostgresDB) UpdateSkill(skill *models.Skill) error {
+	return p.db.Save(skill).Error
+}
+
+// DeleteSkill removes the skill and every employee's rating in it. Its
+// children move up to its parent.
+func (p *PostgresDB) DeleteSkill(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var skill models.Skill
+		if err := tx.First(&skill, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Skill{}).Where("parent_id = ?", id).
+			Update("parent_id", skill.ParentID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.EmployeeSkill{}, "skill_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&skill).Error
+	})
+}
+
+// SetEmployeeSkill records the employee's level in the skill, replacing
+// any earlier rating. es is reloaded afterwards, as an update of an earlier
+// rating keeps its ID and creation time.
+func (p *PostgresDB) SetEmployeeSkill(es *models.EmployeeSkill) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "employee_id"}, {Name: "skill_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"level", "updated_at"}),
+		}).Create(es).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("employee_id = ? AND skill_id = ?", es.EmployeeID, es.SkillID).First(es).Error
+	})
+}
+
+func (p *PostgresDB) ListEmployeeSkills(employeeID int) ([]models.EmployeeSkill, error) {
+	var skills []models.EmployeeSkill
+	if err := p.db.Where("employee_id = ?", employeeID).Order("level DESC, skill_id").Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+func (p *PostgresDB) DeleteEmployeeSkill(employeeID, skillID int) error {
+	return p.db.Delete(&models.EmployeeSkill{}, "employee_id = ? AND skill_id = ?", employeeID, skillID).Error
+}
+
+// FindEmployeesBySkills returns the employees meeting every requirement.
+func (p *PostgresDB) FindEmployeesBySkills(reqs []models.SkillRequirement) ([]models.Employee, error) {
+	query := p.db.Order("id")
+	for _, req := range reqs {
+		query = query.Where("EXISTS (SELECT 1 FROM employee_skills WHERE employee_skills.employee_id = employees.id "+
+			"AND employee_skills.skill_id = ? AND employee_skills.level >= ?)", req.SkillID, req.MinLevel)
+	}
+	var emps []models.Employee
+	if err := query.Find(&emps).Error; err != nil {
+		return nil, err
+	}
+	return emps, nil
+}
+
+func (p *PostgresDB) CreateCertification(cert *models.Certification) error {
+	return p.db.Create(cert).Error
+}
+
+func (p *PostgresDB) GetCertification(id int) (*models.Certification, error) {
+	var cert models.Certification
+	if err := p.db.First(&cert, id).Error; err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func (p *PostgresDB) ListCertifications(employeeID int) ([]models.Certification, error) {
+	var certs []models.Certification
+	if err := p.db.Where("employee_id = ?", employeeID).Order("issued_on DESC, id DESC").Find(&certs).Error; err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+func (p *PostgresDB) UpdateCertification(cert *models.Certification) error {
+	return p.db.Save(cert).Error
+}
+
+func (p *PostgresDB) DeleteCertification(id int) error {
+	return p.db.Delete(&models.Certification{}, id).Error
+}
+
+// ListExpiringCertifications returns certifications that expire on or
+// before date, soonest first, including any already expired.
+func (p *PostgresDB) ListExpiringCertifications(date models.Date) ([]models.Certification, error) {
+	var certs []models.Certification
+	if err := p.db.Where("expires_on <= ?", date).Order("expires_on, id").Find(&certs).Error; err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// MarkCertificationsAlerted records that the expiry alert for each of ids
+// went out on date.
+func (p *PostgresDB) MarkCertificationsAlerted(ids []int, date models.Date) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return p.db.Model(&models.Certification{}).Where("id IN ?", ids).UpdateColumn("alerted_on", date).Error
+}
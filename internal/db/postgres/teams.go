@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"employees/internal/db"
+	"employees/internal/models"
+
+	"gorm.io/gorm"
+)
+
+func (p *PostgresDB) CreateTeam(team *models.Team) error {
+	return p.db.Create(team).Error
+}
+
+func (p *PostgresDB) GetTeam(id int) (*models.Team, error) {
+	var team models.Team
+	if err := p.db.First(&team, id).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (p *PostgresDB) ListTeams() ([]models.Team, error) {
+	var teams []models.Team
+	if err := p.db.Order("name").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (p *PostgresDB) UpdateTeam(team *models.Team) error {
+	result := p.db.Model(team).Select("name", "description", "department_id", "lead_id", "updated_at").Updates(team)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteTeam deletes the team along with its memberships. It returns
+// db.ErrConflict while the team still owns objectives.
+func (p *PostgresDB) DeleteTeam(id int) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var objectives int64
+		if err := tx.Model(&models.Objective{}).Where("team_id = ?", id).Count(&objectives).Error; err != nil {
+			return err
+		}
+		if objectives > 0 {
+			return db.ErrConflict
+		}
+		if err := tx.Delete(&models.Assignment{}, "team_id = ?", id).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&models.Team{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (p *PostgresDB) CreateAssignment(a *models.Assignment) error {
+	return p.db.Create(a).Error
+}
+
+func (p *PostgresDB) GetAssignment(id int) (*models.Assignment, error) {
+	var a models.Assignment
+	if err := p.db.First(&a, id).Error; err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (p *PostgresDB) ListAssignments(filter models.AssignmentFilter) ([]models.Assignment, error) {
+	query := p.db.Order("employee_id, start_date, id")
+	if len(filter.EmployeeIDs) > 0 {
+		query = query.Where("employee_id IN ?", filter.EmployeeIDs)
+	}
+	if filter.TeamID != nil {
+		query = query.Where("team_id = ?", *filter.TeamID)
+	}
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.To != nil {
+		query = query.Where("start_date <= ?", *filter.To)
+	}
+	if filter.From != nil {
+		query = query.Where("(end_date IS NULL OR end_date >= ?)", *filter.From)
+	}
+	var assignments []models.Assignment
+	if err := query.Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (p *PostgresDB) UpdateAssignment(a *models.Assignment) error {
+	result := p.db.Model(a).Select("role", "allocation", "start_date", "end_date", "updated_at").Updates(a)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDB) DeleteAssignment(id int) error {
+	return p.db.Delete(&models.Assignment{}, id).Error
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"employees/internal/models"
+	"employees/internal/timesheet"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *PostgresDB) CreateProject(project *models.Project) error {
+	return p.db.Create(project).Error
+}
+
+func (p *PostgresDB) GetProject(id int) (*models.Project, error) {
+	var project models.Project
+	if err := p.db.First(&project, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (p *PostgresDB) ListProjects() ([]models.Project, error) {
+	var projects []models.Project
+	if err := p.db.Order("code").Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (p *PostgresDB) CreateTimeEntry(entry *models.TimeEntry) error {
+	return p.db.Create(entry).Error
+}
+
+func (p *PostgresDB) GetTimeEntry(id int) (*models.TimeEntry, error) {
+	var entry models.TimeEntry
+	if err := p.db.First(&entry, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (p *PostgresDB) UpdateTimeEntry(entry *models.TimeEntry) error {
+	return p.db.Save(entry).Error
+}
+
+func (p *PostgresDB) DeleteTimeEntry(id int) error {
+	return p.db.Delete(&models.TimeEntry{}, "id = ?", id).Error
+}
+
+// GetOpenTimeEntry returns the employee's clock entry that has not been
+// clocked out yet, or nil when they are not clocked in.
+func (p *PostgresDB) GetOpenTimeEntry(employeeID int) (*models.TimeEntry, error) {
+	var entry models.TimeEntry
+	err := p.db.Where("employee_id = ? AND source = ? AND clock_out IS NULL", employeeID, models.TimeEntrySourceClock).
+		First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (p *PostgresDB) ListTimeEntries(filter models.TimeEntryFilter) ([]models.TimeEntry, error) {
+	query := p.db.Where("date BETWEEN ? AND ?", filter.From, filter.To)
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+
+	var entries []models.TimeEntry
+	if err := query.Order("date, id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetTimesheet returns the employee's timesheet for the week starting on
+// weekStart, or an unsaved draft when nothing has been submitted yet.
+func (p *PostgresDB) GetTimesheet(employeeID int, weekStart models.Date) (*models.Timesheet, error) {
+	var ts models.Timesheet
+	err := p.db.Where("employee_id = ? AND week_start = ?", employeeID, weekStart).First(&ts).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.Timesheet{EmployeeID: employeeID, WeekStart: weekStart, Status: models.TimesheetStatusDraft}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+func (p *PostgresDB) GetTimesheetByID(id int) (*models.Timesheet, error) {
+	var ts models.Timesheet
+	if err := p.db.First(&ts, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+func (p *PostgresDB) SaveTimesheet(ts *models.Timesheet) error {
+	return p.db.Save(ts).Error
+}
+
+func (p *PostgresDB) ListTimesheets(status models.TimesheetStatus) ([]models.Timesheet, error) {
+	var sheets []models.Timesheet
+	if err := p.db.Where("status = ?", status).Order("week_start, employee_id").Find(&sheets).Error; err != nil {
+		return nil, err
+	}
+	return sheets, nil
+}
+
+// GetOvertimePolicy returns the configured policy, or timesheet.DefaultPolicy
+// when none has been saved.
+func (p *PostgresDB) GetOvertimePolicy() (*models.OvertimePolicy, error) {
+	var policy models.OvertimePolicy
+	err := p.db.First(&policy, "id = ?", timesheet.DefaultPolicy.ID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		policy = timesheet.DefaultPolicy
+		return &policy, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SetOvertimePolicy replaces the single overtime policy row.
+func (p *PostgresDB) SetOvertimePolicy(policy *models.OvertimePolicy) error {
+	policy.ID = timesheet.DefaultPolicy.ID
+	return p.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(policy).Error
+}
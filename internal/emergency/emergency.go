@@ -0,0 +1,68 @@
+// Package emergency validates employees' emergency contacts.
+package emergency
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid emergency contact")
+
+// MaxPhoneNumbers bounds how many numbers a single contact may list.
+const MaxPhoneNumbers = 5
+
+var e164 = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// formatting is the punctuation people commonly type inside phone numbers.
+var formatting = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "\u00a0", "")
+
+// NormalizePhone strips spaces and punctuation from number and checks that
+// what remains is an E.164 number: a plus sign, a country code and at most
+// fifteen digits in all.
+func NormalizePhone(number string) (string, error) {
+	normalized := formatting.Replace(strings.TrimSpace(number))
+	if strings.HasPrefix(normalized, "00") {
+		normalized = "+" + normalized[2:]
+	}
+	if !e164.MatchString(normalized) {
+		return "", fmt.Errorf("%w: %q is not an E.164 phone number", ErrInvalid, number)
+	}
+	return normalized, nil
+}
+
+// Validate trims the contact's fields, normalizes its phone numbers and
+// checks that it has a name and between one and MaxPhoneNumbers distinct
+// numbers.
+func Validate(c *models.EmergencyContact) error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Relationship = strings.TrimSpace(c.Relationship)
+	if c.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if c.Priority < 0 {
+		return fmt.Errorf("%w: priority must not be negative", ErrInvalid)
+	}
+	if len(c.PhoneNumbers) == 0 {
+		return fmt.Errorf("%w: at least one phone number is required", ErrInvalid)
+	}
+	if len(c.PhoneNumbers) > MaxPhoneNumbers {
+		return fmt.Errorf("%w: at most %d phone numbers are allowed", ErrInvalid, MaxPhoneNumbers)
+	}
+
+	seen := map[string]bool{}
+	for i, number := range c.PhoneNumbers {
+		normalized, err := NormalizePhone(number)
+		if err != nil {
+			return err
+		}
+		if seen[normalized] {
+			return fmt.Errorf("%w: %s is listed twice", ErrInvalid, normalized)
+		}
+		seen[normalized] = true
+		c.PhoneNumbers[i] = normalized
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package emergency
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "+14155552671", want: "+14155552671"},
+		{input: " +1 (415) 555-2671 ", want: "+14155552671"},
+		{input: "+44 20.7946.0958", want: "+442079460958"},
+		{input: "0049 30 123456", want: "+4930123456"},
+		{input: "4155552671", wantErr: true},
+		{input: "+0123456", wantErr: true},
+		{input: "+1234567890123456", wantErr: true},
+		{input: "+1 415 CALL-NOW", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizePhone(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		contact models.EmergencyContact
+		wantErr bool
+	}{
+		{
+			name:    "Valid",
+			contact: models.EmergencyContact{Name: " Ana Lima ", Relationship: "spouse", PhoneNumbers: []string{"+55 11 91234-5678"}},
+		},
+		{
+			name:    "Missing Name",
+			contact: models.EmergencyContact{PhoneNumbers: []string{"+5511912345678"}},
+			wantErr: true,
+		},
+		{
+			name:    "No Phone Numbers",
+			contact: models.EmergencyContact{Name: "Ana"},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate After Normalizing",
+			contact: models.EmergencyContact{Name: "Ana", PhoneNumbers: []string{"+5511912345678", "+55 11 912345678"}},
+			wantErr: true,
+		},
+		{
+			name:    "Too Many Numbers",
+			contact: models.EmergencyContact{Name: "Ana", PhoneNumbers: []string{"+11", "+12", "+13", "+14", "+15", "+16"}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative Priority",
+			contact: models.EmergencyContact{Name: "Ana", Priority: -1, PhoneNumbers: []string{"+5511912345678"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.contact)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Ana Lima", tt.contact.Name)
+			assert.Equal(t, []string{"+5511912345678"}, tt.contact.PhoneNumbers)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Package expense validates expense claims, converts their items into the
+// claim's currency, checks them against category policy and moves claims
+// through approval.
+package expense
+
+import (
+	"employees/internal/compensation"
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrInvalid           = errors.New("invalid expense claim")
+	ErrInvalidTransition = errors.New("invalid expense claim status transition")
+)
+
+// ValidateCategory trims the category's name and checks its limits.
+func ValidateCategory(c *models.ExpenseCategory) error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.AccountCode = strings.TrimSpace(c.AccountCode)
+	if c.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	for currency, limit := range c.Limits {
+		if !compensation.ValidCurrency(currency) {
+			return fmt.Errorf("%w: limit currency %q: %v", ErrInvalid, currency, compensation.ErrInvalidCurrency)
+		}
+		if limit <= 0 {
+			return fmt.Errorf("%w: limit in %s must be positive", ErrInvalid, currency)
+		}
+	}
+	return nil
+}
+
+// Prepare validates the claim and its items, converts each item into the
+// claim's currency and totals them. An item in the claim's own currency
+// has an exchange rate of 1.
+func Prepare(claim *models.ExpenseClaim) error {
+	claim.Title = strings.TrimSpace(claim.Title)
+	if claim.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalid)
+	}
+	if !compensation.ValidCurrency(claim.Currency) {
+		return fmt.Errorf("%w: %v", ErrInvalid, compensation.ErrInvalidCurrency)
+	}
+
+	claim.Total = 0
+	for i := range claim.Items {
+		item := &claim.Items[i]
+		item.Description = strings.TrimSpace(item.Description)
+		item.Justification = strings.TrimSpace(item.Justification)
+		switch {
+		case item.CategoryID == 0:
+			return fmt.Errorf("%w: item %d: categoryId is required", ErrInvalid, i)
+		case item.Date.IsZero():
+			return fmt.Errorf("%w: item %d: date is required", ErrInvalid, i)
+		case item.Description == "":
+			return fmt.Errorf("%w: item %d: description is required", ErrInvalid, i)
+		case item.Amount <= 0:
+			return fmt.Errorf("%w: item %d: amount must be positive", ErrInvalid, i)
+		case !compensation.ValidCurrency(item.Currency):
+			return fmt.Errorf("%w: item %d: %v", ErrInvalid, i, compensation.ErrInvalidCurrency)
+		}
+		if item.Currency == claim.Currency {
+			item.ExchangeRate = 1
+		} else if item.ExchangeRate <= 0 {
+			return fmt.Errorf("%w: item %d: exchangeRate to %s is required", ErrInvalid, i, claim.Currency)
+		}
+		item.ClaimAmount = Convert(item.Amount, item.Currency, claim.Currency, item.ExchangeRate)
+		claim.Total += item.ClaimAmount
+	}
+	return nil
+}
+
+// Convert turns amount in the minor unit of from into the minor unit of to,
+// where rate is the price of one unit of from in to.
+func Convert(amount int64, from, to string, rate float64) int64 {
+	scale := math.Pow10(compensation.Decimals(to) - compensation.Decimals(from))
+	return int64(math.Round(float64(amount) * rate * scale))
+}
+
+// Check marks the items above their category's limit and returns what
+// keeps the claim from being submitted: an unknown category, a missing
+// receipt where the category requires one, or an item over the limit
+// without a justification. An item is held to its category's limit in the
+// claim's currency, or failing that in its own.
+func Check(claim *models.ExpenseClaim, categories map[int]models.ExpenseCategory) []models.PolicyViolation {
+	var violations []models.PolicyViolation
+	if len(claim.Items) == 0 {
+		violations = append(violations, models.PolicyViolation{Item: -1, Reason: "claim has no items"})
+	}
+	for i := range claim.Items {
+		item := &claim.Items[i]
+		cat, ok := categories[item.CategoryID]
+		if !ok {
+			violations = append(violations, models.PolicyViolation{Item: i, Reason: "unknown category"})
+			continue
+		}
+		if cat.ReceiptRequired && item.ReceiptDocumentID == nil {
+			violations = append(violations, models.PolicyViolation{Item: i, Reason: cat.Name + " requires a receipt"})
+		}
+
+		item.OverLimit = false
+		if limit, ok := cat.Limits[claim.Currency]; ok {
+			item.OverLimit = item.ClaimAmount > limit
+		} else if limit, ok := cat.Limits[item.Currency]; ok {
+			item.OverLimit = item.Amount > limit
+		}
+		if item.OverLimit && item.Justification == "" {
+			violations = append(violations, models.PolicyViolation{Item: i,
+				Reason: "exceeds the " + cat.Name + " limit; add a justification"})
+		}
+	}
+	return violations
+}
+
+// Editable reports whether the claim may still be changed by the employee.
+func Editable(status models.ExpenseClaimStatus) bool {
+	return status == models.ExpenseClaimStatusDraft || status == models.ExpenseClaimStatusRejected
+}
+
+// Decide moves the claim to status. Drafts and rejected claims may be
+// submitted; a submitted claim is approved by a manager, then by finance,
+// and may be rejected at either step.
+func Decide(claim *models.ExpenseClaim, status models.ExpenseClaimStatus) error {
+	from := claim.Status
+	switch {
+	case Editable(from) && status == models.ExpenseClaimStatusSubmitted:
+	case from == models.ExpenseClaimStatusSubmitted && status == models.ExpenseClaimStatusManagerApproved:
+	case from == models.ExpenseClaimStatusManagerApproved && status == models.ExpenseClaimStatusApproved:
+	case (from == models.ExpenseClaimStatusSubmitted || from == models.ExpenseClaimStatusManagerApproved) &&
+		status == models.ExpenseClaimStatusRejected:
+	default:
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, status)
+	}
+	claim.Status = status
+	return nil
+}
@@ -0,0 +1,82 @@
+package expense
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t, int64(1085), Convert(1000, "EUR", "USD", 1.085))
+	assert.Equal(t, int64(670000), Convert(1000000, "JPY", "EUR", 0.0067))
+	assert.Equal(t, int64(1490), Convert(1600, "USD", "JPY", 93.1))
+}
+
+func TestPrepare(t *testing.T) {
+	day := models.NewDate(2025, time.May, 6)
+	claim := models.ExpenseClaim{Title: " Berlin offsite ", Currency: "EUR", Items: []models.ExpenseItem{
+		{CategoryID: 1, Date: day, Description: "Hotel", Amount: 24000, Currency: "EUR", ExchangeRate: 3},
+		{CategoryID: 2, Date: day, Description: "Taxi", Amount: 3000, Currency: "USD", ExchangeRate: 0.9},
+	}}
+	require.NoError(t, Prepare(&claim))
+	assert.Equal(t, "Berlin offsite", claim.Title)
+	assert.Equal(t, 1.0, claim.Items[0].ExchangeRate)
+	assert.Equal(t, int64(2700), claim.Items[1].ClaimAmount)
+	assert.Equal(t, int64(26700), claim.Total)
+
+	invalid := []models.ExpenseClaim{
+		{Currency: "EUR"},
+		{Title: "Trip", Currency: "euro"},
+		{Title: "Trip", Currency: "EUR", Items: []models.ExpenseItem{{Date: day, Description: "Taxi", Amount: 1, Currency: "EUR"}}},
+		{Title: "Trip", Currency: "EUR", Items: []models.ExpenseItem{{CategoryID: 1, Date: day, Description: "Taxi", Currency: "EUR"}}},
+		{Title: "Trip", Currency: "EUR", Items: []models.ExpenseItem{{CategoryID: 1, Date: day, Description: "Taxi", Amount: 1, Currency: "USD"}}},
+	}
+	for _, claim := range invalid {
+		assert.ErrorIs(t, Prepare(&claim), ErrInvalid)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	categories := map[int]models.ExpenseCategory{
+		1: {ID: 1, Name: "Meals", Limits: map[string]int64{"EUR": 5000}},
+		2: {ID: 2, Name: "Travel", ReceiptRequired: true, Limits: map[string]int64{"USD": 100000}},
+	}
+	claim := models.ExpenseClaim{Currency: "EUR", Items: []models.ExpenseItem{
+		{CategoryID: 1, Amount: 4000, Currency: "EUR", ClaimAmount: 4000},
+		{CategoryID: 1, Amount: 8000, Currency: "EUR", ClaimAmount: 8000, Justification: "Client dinner"},
+		{CategoryID: 1, Amount: 6000, Currency: "EUR", ClaimAmount: 6000},
+		{CategoryID: 2, Amount: 120000, Currency: "USD", ClaimAmount: 108000, ReceiptDocumentID: intPtr(4)},
+		{CategoryID: 2, Amount: 1000, Currency: "USD", ClaimAmount: 900},
+		{CategoryID: 9, Amount: 1000, Currency: "EUR", ClaimAmount: 1000},
+	}}
+
+	violations := Check(&claim, categories)
+
+	assert.Equal(t, []bool{false, true, true, true, false, false}, []bool{claim.Items[0].OverLimit, claim.Items[1].OverLimit,
+		claim.Items[2].OverLimit, claim.Items[3].OverLimit, claim.Items[4].OverLimit, claim.Items[5].OverLimit})
+	var items []int
+	for _, v := range violations {
+		items = append(items, v.Item)
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+
+	assert.Len(t, Check(&models.ExpenseClaim{}, categories), 1)
+}
+
+func TestDecide(t *testing.T) {
+	claim := models.ExpenseClaim{Status: models.ExpenseClaimStatusDraft}
+	assert.ErrorIs(t, Decide(&claim, models.ExpenseClaimStatusApproved), ErrInvalidTransition)
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusSubmitted))
+	assert.ErrorIs(t, Decide(&claim, models.ExpenseClaimStatusApproved), ErrInvalidTransition)
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusManagerApproved))
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusRejected))
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusSubmitted))
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusManagerApproved))
+	require.NoError(t, Decide(&claim, models.ExpenseClaimStatusApproved))
+	assert.ErrorIs(t, Decide(&claim, models.ExpenseClaimStatusRejected), ErrInvalidTransition)
+}
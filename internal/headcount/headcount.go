@@ -0,0 +1,193 @@
+// Package headcount validates the headcount plan and compares it with the
+// headcount actually employed over time.
+package headcount
+
+import (
+	"employees/internal/compensation"
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid headcount plan")
+
+// maxPoints bounds a report so that a wide range at a weekly interval
+// cannot ask for an unbounded series.
+const maxPoints = 260
+
+// Validate trims the position's title and defaults it to open. Positions
+// only become filled by having an employee assigned.
+func Validate(p *models.PlannedPosition) error {
+	p.Title = strings.TrimSpace(p.Title)
+	if p.Status == "" {
+		p.Status = models.PlannedPositionStatusOpen
+	}
+	switch {
+	case p.Title == "":
+		return fmt.Errorf("%w: title is required", ErrInvalid)
+	case p.DepartmentID == 0:
+		return fmt.Errorf("%w: departmentId is required", ErrInvalid)
+	case p.Budget < 0:
+		return fmt.Errorf("%w: budget cannot be negative", ErrInvalid)
+	case !compensation.ValidCurrency(p.Currency):
+		return fmt.Errorf("%w: %v", ErrInvalid, compensation.ErrInvalidCurrency)
+	case p.OpenedOn.IsZero():
+		return fmt.Errorf("%w: openedOn is required", ErrInvalid)
+	case p.ClosedOn != nil && !p.ClosedOn.After(p.OpenedOn.Time):
+		return fmt.Errorf("%w: closedOn must be after openedOn", ErrInvalid)
+	case p.Status != models.PlannedPositionStatusOpen && p.Status != models.PlannedPositionStatusFrozen:
+		return fmt.Errorf("%w: status must be open or frozen", ErrInvalid)
+	}
+	return nil
+}
+
+// Dates returns the days a report from from to to samples: from itself and
+// then every week or month after it up to to. Months are counted from
+// from, so a report starting on the 31st samples the end of each month.
+func Dates(from, to models.Date, interval string) ([]models.Date, error) {
+	var nth func(i int) models.Date
+	switch interval {
+	case "", "month":
+		nth = func(i int) models.Date {
+			d := from.AddDate(0, i, 0)
+			if d.Day() != from.Day() {
+				d = d.AddDate(0, 0, -d.Day())
+			}
+			return models.DateOf(d)
+		}
+	case "week":
+		nth = func(i int) models.Date { return from.AddDays(7 * i) }
+	default:
+		return nil, fmt.Errorf("%w: interval must be week or month", ErrInvalid)
+	}
+
+	var dates []models.Date
+	for i, d := 0, from; !d.After(to.Time); i, d = i+1, nth(i+1) {
+		if len(dates) == maxPoints {
+			return nil, fmt.Errorf("%w: a report covers at most %d points", ErrInvalid, maxPoints)
+		}
+		dates = append(dates, d)
+	}
+	return dates, nil
+}
+
+// Employed reports whether an employee in status counts towards headcount.
+func Employed(status models.EmployeeStatus) bool {
+	return status == models.EmployeeStatusOnboarding ||
+		status == models.EmployeeStatusActive ||
+		status == models.EmployeeStatusOnLeave
+}
+
+// StatusOn replays an employee's applied transitions, ordered by effective
+// date, to find their status on d. Before the first transition they were
+// in its From status; without any they have always had current.
+func StatusOn(transitions []models.StatusTransition, current models.EmployeeStatus, d models.Date) models.EmployeeStatus {
+	if len(transitions) == 0 {
+		return current
+	}
+	status := transitions[0].From
+	for _, tr := range transitions {
+		if tr.EffectiveDate.After(d.Time) {
+			break
+		}
+		status = tr.To
+	}
+	return status
+}
+
+// Untracked returns a stand-in position for each employee in emps who has
+// none among positions, the positions overlapping a report ending on to.
+// It holds their current department from the day they were created, so
+// that employees whose history predates position tracking still count.
+// Employees created after to or outside any department are left out.
+func Untracked(positions []models.Position, emps []models.Employee, to models.Date) []models.Position {
+	tracked := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		tracked[pos.EmployeeID] = true
+	}
+	var untracked []models.Position
+	for _, emp := range emps {
+		created := models.DateOf(emp.CreatedAt)
+		if tracked[emp.ID] || emp.DepartmentID == nil || created.After(to.Time) {
+			continue
+		}
+		untracked = append(untracked, models.Position{
+			EmployeeID:    emp.ID,
+			JobTitle:      emp.JobTitle,
+			DepartmentID:  emp.DepartmentID,
+			ManagerID:     emp.ManagerID,
+			EffectiveFrom: created,
+		})
+	}
+	return untracked
+}
+
+// Report compares the plan with actual headcount per department on each
+// of dates. Actual headcount comes from the employees' position history
+// and status transitions; current holds each employee's status today.
+func Report(dates []models.Date, planned []models.PlannedPosition, positions []models.Position,
+	transitions []models.StatusTransition, current map[int]models.EmployeeStatus) []models.HeadcountPoint {
+	byEmployee := make(map[int][]models.StatusTransition)
+	for _, tr := range transitions {
+		byEmployee[tr.EmployeeID] = append(byEmployee[tr.EmployeeID], tr)
+	}
+	for _, trs := range byEmployee {
+		sort.SliceStable(trs, func(i, j int) bool { return trs[i].EffectiveDate.Before(trs[j].EffectiveDate.Time) })
+	}
+
+	departments := make(map[int]bool)
+	for _, p := range planned {
+		departments[p.DepartmentID] = true
+	}
+	for _, pos := range positions {
+		if pos.DepartmentID != nil {
+			departments[*pos.DepartmentID] = true
+		}
+	}
+	ids := make([]int, 0, len(departments))
+	for id := range departments {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var points []models.HeadcountPoint
+	for _, d := range dates {
+		byDepartment := make(map[int]*models.HeadcountPoint, len(ids))
+		for _, id := range ids {
+			points = append(points, models.HeadcountPoint{Date: d, DepartmentID: id})
+		}
+		for i := len(points) - len(ids); i < len(points); i++ {
+			byDepartment[points[i].DepartmentID] = &points[i]
+		}
+
+		for _, p := range planned {
+			if !p.ActiveOn(d) {
+				continue
+			}
+			point := byDepartment[p.DepartmentID]
+			point.Planned++
+			if p.Status == models.PlannedPositionStatusFrozen {
+				point.Frozen++
+			}
+			if point.Budget == nil {
+				point.Budget = make(map[string]int64)
+			}
+			point.Budget[p.Currency] += p.Budget
+		}
+		for _, pos := range positions {
+			if pos.DepartmentID == nil || !pos.ActiveOn(d) {
+				continue
+			}
+			if Employed(StatusOn(byEmployee[pos.EmployeeID], current[pos.EmployeeID], d)) {
+				byDepartment[*pos.DepartmentID].Actual++
+			}
+		}
+		for _, id := range ids {
+			point := byDepartment[id]
+			point.Variance = point.Actual - point.Planned
+		}
+	}
+	return points
+}
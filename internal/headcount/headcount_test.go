@@ -0,0 +1,112 @@
+package headcount
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func date(month time.Month, day int) models.Date {
+	return models.NewDate(2025, month, day)
+}
+
+func TestValidate(t *testing.T) {
+	p := models.PlannedPosition{Title: " Data Engineer ", DepartmentID: 2, Budget: 12000000, Currency: "EUR",
+		OpenedOn: date(time.January, 1)}
+	require.NoError(t, Validate(&p))
+	assert.Equal(t, "Data Engineer", p.Title)
+	assert.Equal(t, models.PlannedPositionStatusOpen, p.Status)
+
+	closed := date(time.January, 1)
+	tests := []models.PlannedPosition{
+		{DepartmentID: 2, Currency: "EUR", OpenedOn: date(time.January, 1)},
+		{Title: "SRE", Currency: "EUR", OpenedOn: date(time.January, 1)},
+		{Title: "SRE", DepartmentID: 2, Currency: "eur", OpenedOn: date(time.January, 1)},
+		{Title: "SRE", DepartmentID: 2, Currency: "EUR"},
+		{Title: "SRE", DepartmentID: 2, Currency: "EUR", OpenedOn: date(time.January, 1), ClosedOn: &closed},
+		{Title: "SRE", DepartmentID: 2, Currency: "EUR", OpenedOn: date(time.January, 1), Status: models.PlannedPositionStatusFilled},
+	}
+	for _, p := range tests {
+		assert.ErrorIs(t, Validate(&p), ErrInvalid)
+	}
+}
+
+func TestDates(t *testing.T) {
+	dates, err := Dates(date(time.January, 31), date(time.April, 30), "month")
+	require.NoError(t, err)
+	assert.Equal(t, []models.Date{date(time.January, 31), date(time.February, 28), date(time.March, 31),
+		date(time.April, 30)}, dates)
+
+	dates, err = Dates(date(time.June, 2), date(time.June, 20), "week")
+	require.NoError(t, err)
+	assert.Equal(t, []models.Date{date(time.June, 2), date(time.June, 9), date(time.June, 16)}, dates)
+
+	_, err = Dates(date(time.June, 2), date(time.June, 20), "day")
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, err = Dates(date(time.June, 2), models.NewDate(2035, time.June, 2), "week")
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestStatusOn(t *testing.T) {
+	transitions := []models.StatusTransition{
+		{From: models.EmployeeStatusCandidate, To: models.EmployeeStatusOnboarding, EffectiveDate: date(time.March, 1)},
+		{From: models.EmployeeStatusOnboarding, To: models.EmployeeStatusActive, EffectiveDate: date(time.April, 1)},
+	}
+	assert.Equal(t, models.EmployeeStatusCandidate, StatusOn(transitions, models.EmployeeStatusActive, date(time.February, 1)))
+	assert.Equal(t, models.EmployeeStatusOnboarding, StatusOn(transitions, models.EmployeeStatusActive, date(time.March, 1)))
+	assert.Equal(t, models.EmployeeStatusActive, StatusOn(transitions, models.EmployeeStatusActive, date(time.May, 1)))
+	assert.Equal(t, models.EmployeeStatusOnLeave, StatusOn(nil, models.EmployeeStatusOnLeave, date(time.May, 1)))
+}
+
+func TestReport(t *testing.T) {
+	closed := date(time.March, 1)
+	planned := []models.PlannedPosition{
+		{DepartmentID: 2, Budget: 100, Currency: "EUR", OpenedOn: date(time.January, 1)},
+		{DepartmentID: 2, Budget: 50, Currency: "EUR", OpenedOn: date(time.January, 1), Status: models.PlannedPositionStatusFrozen},
+		{DepartmentID: 2, Budget: 70, Currency: "USD", OpenedOn: date(time.January, 1), ClosedOn: &closed},
+	}
+	movedOut := date(time.February, 15)
+	positions := []models.Position{
+		{EmployeeID: 1, DepartmentID: intPtr(2), EffectiveFrom: date(time.January, 1)},
+		{EmployeeID: 2, DepartmentID: intPtr(2), EffectiveFrom: date(time.January, 1), EffectiveTo: &movedOut},
+		{EmployeeID: 2, DepartmentID: intPtr(3), EffectiveFrom: movedOut},
+		{EmployeeID: 3, DepartmentID: intPtr(2), EffectiveFrom: date(time.January, 1)},
+	}
+	transitions := []models.StatusTransition{
+		{EmployeeID: 3, From: models.EmployeeStatusActive, To: models.EmployeeStatusTerminated, EffectiveDate: date(time.March, 10)},
+	}
+	current := map[int]models.EmployeeStatus{
+		1: models.EmployeeStatusActive,
+		2: models.EmployeeStatusActive,
+		3: models.EmployeeStatusTerminated,
+	}
+
+	points := Report([]models.Date{date(time.February, 1), date(time.April, 1)}, planned, positions, transitions, current)
+
+	require.Len(t, points, 4)
+	assert.Equal(t, models.HeadcountPoint{Date: date(time.February, 1), DepartmentID: 2, Planned: 3, Frozen: 1,
+		Actual: 3, Variance: 0, Budget: map[string]int64{"EUR": 150, "USD": 70}}, points[0])
+	assert.Equal(t, models.HeadcountPoint{Date: date(time.February, 1), DepartmentID: 3}, points[1])
+	assert.Equal(t, models.HeadcountPoint{Date: date(time.April, 1), DepartmentID: 2, Planned: 2, Frozen: 1,
+		Actual: 1, Variance: -1, Budget: map[string]int64{"EUR": 150}}, points[2])
+	assert.Equal(t, models.HeadcountPoint{Date: date(time.April, 1), DepartmentID: 3, Actual: 1, Variance: 1}, points[3])
+}
+
+func TestUntracked(t *testing.T) {
+	positions := []models.Position{{EmployeeID: 1, DepartmentID: intPtr(2), EffectiveFrom: date(time.January, 1)}}
+	emps := []models.Employee{
+		{ID: 1, DepartmentID: intPtr(3)},
+		{ID: 2, DepartmentID: intPtr(2), JobTitle: "Engineer", CreatedAt: time.Date(2024, time.June, 3, 23, 0, 0, 0, time.UTC)},
+		{ID: 3, DepartmentID: intPtr(2), CreatedAt: time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, CreatedAt: time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	assert.Equal(t, []models.Position{
+		{EmployeeID: 2, JobTitle: "Engineer", DepartmentID: intPtr(2), EffectiveFrom: models.NewDate(2024, time.June, 3)},
+	}, Untracked(positions, emps, date(time.April, 1)))
+}
@@ -0,0 +1,108 @@
+package holidays
+
+import (
+	"employees/internal/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleOn(t *testing.T) {
+	tests := []struct {
+		rule string
+		year int
+		want models.Date
+	}{
+		{rule: "December 25", year: 2025, want: models.NewDate(2025, time.December, 25)},
+		{rule: "last Monday of May", year: 2025, want: models.NewDate(2025, time.May, 26)},
+		{rule: "first Monday of September", year: 2025, want: models.NewDate(2025, time.September, 1)},
+		{rule: "fourth Thursday of November", year: 2025, want: models.NewDate(2025, time.November, 27)},
+		{rule: "Easter", year: 2025, want: models.NewDate(2025, time.April, 20)},
+		{rule: "Easter -2", year: 2024, want: models.NewDate(2024, time.March, 29)},
+		{rule: "Easter +1", year: 2026, want: models.NewDate(2026, time.April, 6)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := ParseRule(tt.rule)
+			require.NoError(t, err)
+			got, ok := r.On(tt.year)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	leap, err := ParseRule("February 29")
+	require.NoError(t, err)
+	_, ok := leap.On(2025)
+	assert.False(t, ok)
+
+	for _, bad := range []string{"", "Smarch 3", "fifth Monday of May", "June 31", "Easter soon"} {
+		_, err := ParseRule(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestExpand(t *testing.T) {
+	rules := []models.HolidayRule{{CalendarID: 3, Name: "Memorial Day", Rule: "last Monday of May"}}
+	got, err := Expand(rules, models.NewDate(2024, time.June, 1), models.NewDate(2026, time.May, 31))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, models.NewDate(2025, time.May, 26), got[0].Date)
+	assert.Equal(t, 3, got[0].CalendarID)
+}
+
+func TestParseICal(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20251225",
+		"DTEND;VALUE=DATE:20251227",
+		"SUMMARY:Christmas and",
+		"  Boxing Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20260101T000000Z",
+		"SUMMARY:New Year\\, observed",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	got, err := ParseICal(strings.NewReader(ics))
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, models.NewDate(2025, time.December, 26), got[1].Date)
+	assert.Equal(t, "Christmas and Boxing Day", got[1].Name)
+	assert.Equal(t, "New Year, observed", got[2].Name)
+
+	_, err = ParseICal(strings.NewReader("BEGIN:VEVENT\nSUMMARY:Broken\nEND:VEVENT\n"))
+	assert.Error(t, err)
+}
+
+func TestParseICalSameDay(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250317",
+		"SUMMARY:St Patrick's Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250317",
+		"SUMMARY:St Patrick's Day (Northern Ireland)",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250317",
+		"SUMMARY:St Patrick's Day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	got, err := ParseICal(strings.NewReader(ics))
+	require.NoError(t, err)
+	assert.Equal(t, []models.Holiday{{Date: models.NewDate(2025, time.March, 17),
+		Name: "St Patrick's Day / St Patrick's Day (Northern Ireland)"}}, got)
+}
@@ -0,0 +1,113 @@
+package holidays
+
+import (
+	"bufio"
+	"employees/internal/models"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// nameSeparator joins the names of holidays merged onto one day.
+const nameSeparator = " / "
+
+// ParseICal reads the all-day VEVENTs of an iCalendar (RFC 5545) file as
+// holidays. Multi-day events produce one holiday per day; DTEND is
+// exclusive as the RFC specifies. Recurrence rules are not expanded, so
+// recurring holidays should be entered as rules instead. Events falling
+// on the same day, such as regional or observed variants, are merged into
+// one holiday whose name joins theirs.
+func ParseICal(r io.Reader) ([]models.Holiday, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var holidays []models.Holiday
+	byDate := make(map[models.Date]int)
+	var inEvent bool
+	var summary string
+	var start, end models.Date
+	for n, line := range lines {
+		name, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent, summary, start, end = true, "", models.Date{}, models.Date{}
+		case name == "END" && value == "VEVENT":
+			inEvent = false
+			if start.IsZero() {
+				return nil, fmt.Errorf("line %d: event %q has no DTSTART", n+1, summary)
+			}
+			if end.IsZero() || !end.After(start.Time) {
+				end = start.AddDays(1)
+			}
+			for d := start; d.Before(end.Time); d = d.AddDays(1) {
+				i, ok := byDate[d]
+				if !ok {
+					byDate[d] = len(holidays)
+					holidays = append(holidays, models.Holiday{Date: d, Name: summary})
+					continue
+				}
+				if !slices.Contains(strings.Split(holidays[i].Name, nameSeparator), summary) {
+					holidays[i].Name += nameSeparator + summary
+				}
+			}
+		case !inEvent:
+		case name == "SUMMARY":
+			summary = unescape(value)
+		case name == "DTSTART", name == "DTEND":
+			d, err := parseICalDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			if name == "DTSTART" {
+				start = d
+			} else {
+				end = d
+			}
+		}
+	}
+	return holidays, nil
+}
+
+// unfold joins continuation lines, which start with a space or tab.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty separates a content line into its name and value, dropping
+// any parameters such as VALUE=DATE.
+func splitProperty(line string) (name, value string) {
+	head, value, _ := strings.Cut(line, ":")
+	name, _, _ = strings.Cut(head, ";")
+	return strings.ToUpper(name), value
+}
+
+// parseICalDate accepts DATE values and, for feeds that publish holidays
+// with a time, the date part of DATE-TIME values.
+func parseICalDate(value string) (models.Date, error) {
+	if len(value) < 8 || (len(value) > 8 && value[8] != 'T') {
+		return models.Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	t, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return models.Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	return models.DateOf(t), nil
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(s)
+}
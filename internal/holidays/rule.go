@@ -0,0 +1,152 @@
+// Package holidays computes holiday dates from yearly rules and reads them
+// from iCalendar files.
+package holidays
+
+import (
+	"employees/internal/models"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule is a parsed yearly holiday rule. The accepted forms are
+//
+//	December 25
+//	last Monday of May
+//	first|second|third|fourth Thursday of November
+//	Easter
+//	Easter -2, Easter +1
+type Rule struct {
+	month      time.Month
+	day        int
+	weekday    time.Weekday
+	occurrence int // 1-4, or -1 for the last occurrence; 0 for fixed dates
+	easter     bool
+	offset     int
+}
+
+var ordinals = map[string]int{"first": 1, "second": 2, "third": 3, "fourth": 4, "last": -1}
+
+func ParseRule(s string) (Rule, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return Rule{}, fmt.Errorf("empty holiday rule")
+	}
+
+	if fields[0] == "easter" {
+		r := Rule{easter: true}
+		if len(fields) == 2 {
+			offset, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return Rule{}, fmt.Errorf("invalid Easter offset in %q", s)
+			}
+			r.offset = offset
+		} else if len(fields) > 2 {
+			return Rule{}, fmt.Errorf("invalid holiday rule %q", s)
+		}
+		return r, nil
+	}
+
+	if len(fields) == 2 {
+		month, ok := parseMonth(fields[0])
+		day, err := strconv.Atoi(fields[1])
+		if !ok || err != nil || day < 1 || day > daysIn(month, 2024) {
+			return Rule{}, fmt.Errorf("invalid holiday rule %q", s)
+		}
+		return Rule{month: month, day: day}, nil
+	}
+
+	if len(fields) == 4 && fields[2] == "of" {
+		occurrence, ok := ordinals[fields[0]]
+		weekday, okDay := parseWeekday(fields[1])
+		month, okMonth := parseMonth(fields[3])
+		if !ok || !okDay || !okMonth {
+			return Rule{}, fmt.Errorf("invalid holiday rule %q", s)
+		}
+		return Rule{month: month, weekday: weekday, occurrence: occurrence}, nil
+	}
+
+	return Rule{}, fmt.Errorf("invalid holiday rule %q", s)
+}
+
+// On returns the date the rule falls on in year. It reports false for a
+// fixed 29 February in a non-leap year.
+func (r Rule) On(year int) (models.Date, bool) {
+	switch {
+	case r.easter:
+		return easter(year).AddDays(r.offset), true
+	case r.occurrence == 0:
+		if r.day > daysIn(r.month, year) {
+			return models.Date{}, false
+		}
+		return models.NewDate(year, r.month, r.day), true
+	case r.occurrence > 0:
+		first := models.NewDate(year, r.month, 1)
+		shift := (int(r.weekday) - int(first.Weekday()) + 7) % 7
+		return first.AddDays(shift + 7*(r.occurrence-1)), true
+	default:
+		last := models.NewDate(year, r.month, daysIn(r.month, year))
+		shift := (int(last.Weekday()) - int(r.weekday) + 7) % 7
+		return last.AddDays(-shift), true
+	}
+}
+
+// Expand returns the holidays produced by the calendar's rules between from
+// and to inclusive.
+func Expand(rules []models.HolidayRule, from, to models.Date) ([]models.Holiday, error) {
+	var out []models.Holiday
+	for _, hr := range rules {
+		rule, err := ParseRule(hr.Rule)
+		if err != nil {
+			return nil, err
+		}
+		for year := from.Year(); year <= to.Year(); year++ {
+			d, ok := rule.On(year)
+			if !ok || d.Before(from.Time) || d.After(to.Time) {
+				continue
+			}
+			out = append(out, models.Holiday{CalendarID: hr.CalendarID, Date: d, Name: hr.Name})
+		}
+	}
+	return out, nil
+}
+
+// easter returns Easter Sunday in the Gregorian calendar using the
+// anonymous Gregorian algorithm.
+func easter(year int) models.Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return models.NewDate(year, time.Month(month), day)
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func parseMonth(s string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.ToLower(m.String()) == s {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == s {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,131 @@
+// Package leave holds the calculations behind leave requests and balances:
+// working day counts, monthly accrual and the request approval workflow.
+package leave
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrInvalidTransition = errors.New("invalid leave status transition")
+
+func IsWeekend(d models.Date) bool {
+	return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+}
+
+// HolidaySet returns a lookup reporting whether a day is one of holidays.
+func HolidaySet(holidays []models.Holiday) func(models.Date) bool {
+	days := make(map[models.Date]bool, len(holidays))
+	for _, h := range holidays {
+		days[h.Date] = true
+	}
+	return func(d models.Date) bool {
+		return days[d]
+	}
+}
+
+// WorkingDays counts the days charged for leave from start to end inclusive,
+// skipping weekends and holidays. A half day at either end counts 0.5 when
+// that day would otherwise be charged; a single day with either flag set is
+// half a day.
+func WorkingDays(start, end models.Date, halfStart, halfEnd bool, isHoliday func(models.Date) bool) float64 {
+	charged := func(d models.Date) bool {
+		return !IsWeekend(d) && (isHoliday == nil || !isHoliday(d))
+	}
+
+	days := 0.0
+	for d := start; !d.After(end.Time); d = d.AddDays(1) {
+		if charged(d) {
+			days++
+		}
+	}
+
+	if start == end {
+		if (halfStart || halfEnd) && charged(start) {
+			return 0.5
+		}
+		return days
+	}
+	if halfStart && charged(start) {
+		days -= 0.5
+	}
+	if halfEnd && charged(end) {
+		days -= 0.5
+	}
+	return days
+}
+
+// Accrued returns the days earned under policy by asOf: a twelfth of
+// DaysPerYear for every month completed since StartDate.
+func Accrued(policy models.AccrualPolicy, asOf models.Date) float64 {
+	start := policy.StartDate
+	if asOf.Before(start.Time) {
+		return 0
+	}
+	months := (asOf.Year()-start.Year())*12 + int(asOf.Month()-start.Month())
+	if asOf.Day() < start.Day() {
+		months--
+	}
+	return round(float64(months) * policy.DaysPerYear / 12)
+}
+
+// Balance combines accrual, the adjustment audit trail and pending requests
+// into the balance of one leave type. policy may be nil for leave types the
+// employee does not accrue.
+func Balance(employeeID, leaveTypeID int, policy *models.AccrualPolicy, adjustments []models.BalanceAdjustment, pending []models.LeaveRequest, asOf models.Date) models.LeaveBalance {
+	b := models.LeaveBalance{EmployeeID: employeeID, LeaveTypeID: leaveTypeID, AsOf: asOf}
+	if policy != nil {
+		b.Accrued = Accrued(*policy, asOf)
+	}
+	for _, adj := range adjustments {
+		if adj.LeaveTypeID == leaveTypeID {
+			b.Adjusted += adj.Days
+		}
+	}
+	for _, req := range pending {
+		if req.LeaveTypeID == leaveTypeID && req.Status == models.LeaveStatusPending {
+			b.Pending += req.Days
+		}
+	}
+	b.Adjusted = round(b.Adjusted)
+	b.Pending = round(b.Pending)
+	b.Available = round(b.Accrued + b.Adjusted - b.Pending)
+	return b
+}
+
+// Decide moves req to status and returns the balance adjustment the change
+// requires, if any. Pending requests may be approved, rejected or
+// cancelled; approved requests may only be cancelled, which refunds them.
+func Decide(req *models.LeaveRequest, status models.LeaveStatus) (*models.BalanceAdjustment, error) {
+	var days float64
+	var reason string
+	switch {
+	case req.Status == models.LeaveStatusPending && status == models.LeaveStatusApproved:
+		days, reason = -req.Days, fmt.Sprintf("Leave request %d approved", req.ID)
+	case req.Status == models.LeaveStatusPending && (status == models.LeaveStatusRejected || status == models.LeaveStatusCancelled):
+	case req.Status == models.LeaveStatusApproved && status == models.LeaveStatusCancelled:
+		days, reason = req.Days, fmt.Sprintf("Leave request %d cancelled", req.ID)
+	default:
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, req.Status, status)
+	}
+
+	req.Status = status
+	if days == 0 {
+		return nil, nil
+	}
+	id := req.ID
+	return &models.BalanceAdjustment{
+		EmployeeID:     req.EmployeeID,
+		LeaveTypeID:    req.LeaveTypeID,
+		Days:           days,
+		Reason:         reason,
+		LeaveRequestID: &id,
+	}, nil
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,79 @@
+package leave
+
+import (
+	"employees/internal/models"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkingDays(t *testing.T) {
+	// 2025-05-05 is a Monday.
+	mon := models.NewDate(2025, time.May, 5)
+	holidays := HolidaySet([]models.Holiday{{Date: mon.AddDays(3)}})
+
+	tests := []struct {
+		name      string
+		start     models.Date
+		end       models.Date
+		halfStart bool
+		halfEnd   bool
+		want      float64
+	}{
+		{name: "Full Week", start: mon, end: mon.AddDays(6), want: 4},
+		{name: "Over Weekend", start: mon.AddDays(4), end: mon.AddDays(7), want: 2},
+		{name: "Half Days", start: mon, end: mon.AddDays(2), halfStart: true, halfEnd: true, want: 2},
+		{name: "Single Half Day", start: mon, end: mon, halfStart: true, want: 0.5},
+		{name: "Half Day On Holiday", start: mon.AddDays(3), end: mon.AddDays(4), halfStart: true, want: 1},
+		{name: "Weekend Only", start: mon.AddDays(5), end: mon.AddDays(6), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WorkingDays(tt.start, tt.end, tt.halfStart, tt.halfEnd, holidays))
+		})
+	}
+}
+
+func TestBalance(t *testing.T) {
+	policy := &models.AccrualPolicy{DaysPerYear: 24, StartDate: models.NewDate(2025, time.January, 15)}
+
+	assert.Equal(t, 0.0, Accrued(*policy, models.NewDate(2025, time.February, 14)))
+	assert.Equal(t, 2.0, Accrued(*policy, models.NewDate(2025, time.February, 15)))
+	assert.Equal(t, 0.0, Accrued(*policy, models.NewDate(2024, time.December, 1)))
+
+	b := Balance(1, 2, policy,
+		[]models.BalanceAdjustment{{LeaveTypeID: 2, Days: -3}, {LeaveTypeID: 5, Days: 10}},
+		[]models.LeaveRequest{{LeaveTypeID: 2, Status: models.LeaveStatusPending, Days: 1.5}},
+		models.NewDate(2025, time.July, 20))
+
+	assert.Equal(t, 12.0, b.Accrued)
+	assert.Equal(t, -3.0, b.Adjusted)
+	assert.Equal(t, 1.5, b.Pending)
+	assert.Equal(t, 7.5, b.Available)
+}
+
+func TestDecide(t *testing.T) {
+	req := &models.LeaveRequest{ID: 7, EmployeeID: 1, LeaveTypeID: 2, Days: 3, Status: models.LeaveStatusPending}
+
+	adj, err := Decide(req, models.LeaveStatusApproved)
+	require.NoError(t, err)
+	require.NotNil(t, adj)
+	assert.Equal(t, -3.0, adj.Days)
+	assert.Equal(t, models.LeaveStatusApproved, req.Status)
+
+	_, err = Decide(req, models.LeaveStatusRejected)
+	assert.True(t, errors.Is(err, ErrInvalidTransition))
+
+	adj, err = Decide(req, models.LeaveStatusCancelled)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, adj.Days)
+
+	rejected := &models.LeaveRequest{Status: models.LeaveStatusPending}
+	adj, err = Decide(rejected, models.LeaveStatusRejected)
+	require.NoError(t, err)
+	assert.Nil(t, adj)
+}
@@ -0,0 +1,83 @@
+// Package lifecycle defines which employee status transitions are allowed
+// and runs hooks when one happens.
+package lifecycle
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrInvalidTransition = errors.New("invalid employee status transition")
+
+// allowed maps each status to the statuses it may move to. Terminated
+// employees can only come back by being rehired as candidates.
+var allowed = map[models.EmployeeStatus][]models.EmployeeStatus{
+	models.EmployeeStatusCandidate:  {models.EmployeeStatusOnboarding, models.EmployeeStatusActive, models.EmployeeStatusTerminated},
+	models.EmployeeStatusOnboarding: {models.EmployeeStatusActive, models.EmployeeStatusTerminated},
+	models.EmployeeStatusActive:     {models.EmployeeStatusOnLeave, models.EmployeeStatusTerminated},
+	models.EmployeeStatusOnLeave:    {models.EmployeeStatusActive, models.EmployeeStatusTerminated},
+	models.EmployeeStatusTerminated: {models.EmployeeStatusCandidate},
+}
+
+// Valid reports whether status is a known employee status.
+func Valid(status models.EmployeeStatus) bool {
+	_, ok := allowed[status]
+	return ok
+}
+
+// ValidInitial reports whether a new employee may be created with status.
+func ValidInitial(status models.EmployeeStatus) bool {
+	return status == models.EmployeeStatusCandidate ||
+		status == models.EmployeeStatusOnboarding ||
+		status == models.EmployeeStatusActive
+}
+
+// Transition validates moving an employee from one status to another.
+func Transition(from, to models.EmployeeStatus) error {
+	for _, next := range allowed[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+}
+
+// Hook runs after a transition has been applied. emp already has the new
+// status.
+type Hook func(emp models.Employee, tr models.StatusTransition) error
+
+// Hooks holds the hooks to run per target status.
+type Hooks struct {
+	mu    sync.RWMutex
+	hooks map[models.EmployeeStatus][]Hook
+}
+
+// On registers hook for transitions into status, or for every transition
+// when status is empty.
+func (h *Hooks) On(status models.EmployeeStatus, hook Hook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.hooks == nil {
+		h.hooks = map[models.EmployeeStatus][]Hook{}
+	}
+	h.hooks[status] = append(h.hooks[status], hook)
+}
+
+// Run calls the hooks for every transition, then those for tr.To, in
+// registration order. A failing hook does not stop the others; their
+// errors are joined.
+func (h *Hooks) Run(emp models.Employee, tr models.StatusTransition) error {
+	h.mu.RLock()
+	hooks := append(append([]Hook{}, h.hooks[""]...), h.hooks[tr.To]...)
+	h.mu.RUnlock()
+
+	var errs []error
+	for _, hook := range hooks {
+		if err := hook(emp, tr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
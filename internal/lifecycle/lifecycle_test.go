@@ -0,0 +1,64 @@
+package lifecycle
+
+import (
+	"employees/internal/models"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		from, to models.EmployeeStatus
+		ok       bool
+	}{
+		{models.EmployeeStatusCandidate, models.EmployeeStatusOnboarding, true},
+		{models.EmployeeStatusOnboarding, models.EmployeeStatusActive, true},
+		{models.EmployeeStatusActive, models.EmployeeStatusOnLeave, true},
+		{models.EmployeeStatusOnLeave, models.EmployeeStatusActive, true},
+		{models.EmployeeStatusActive, models.EmployeeStatusTerminated, true},
+		{models.EmployeeStatusTerminated, models.EmployeeStatusCandidate, true},
+		{models.EmployeeStatusTerminated, models.EmployeeStatusActive, false},
+		{models.EmployeeStatusCandidate, models.EmployeeStatusOnLeave, false},
+		{models.EmployeeStatusActive, models.EmployeeStatusActive, false},
+		{"retired", models.EmployeeStatusActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"_to_"+string(tt.to), func(t *testing.T) {
+			err := Transition(tt.from, tt.to)
+			if tt.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidTransition)
+			}
+		})
+	}
+}
+
+func TestHooks(t *testing.T) {
+	var hooks Hooks
+	var calls []string
+	hooks.On("", func(emp models.Employee, tr models.StatusTransition) error {
+		calls = append(calls, "any")
+		return nil
+	})
+	hooks.On(models.EmployeeStatusTerminated, func(emp models.Employee, tr models.StatusTransition) error {
+		calls = append(calls, "terminated")
+		return errors.New("revoke access failed")
+	})
+	hooks.On(models.EmployeeStatusTerminated, func(emp models.Employee, tr models.StatusTransition) error {
+		calls = append(calls, "terminated again")
+		return nil
+	})
+
+	err := hooks.Run(models.Employee{ID: 1}, models.StatusTransition{To: models.EmployeeStatusOnLeave})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"any"}, calls)
+
+	calls = nil
+	err = hooks.Run(models.Employee{ID: 1}, models.StatusTransition{To: models.EmployeeStatusTerminated})
+	assert.EqualError(t, err, "revoke access failed")
+	assert.Equal(t, []string{"any", "terminated", "terminated again"}, calls)
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// AddressType distinguishes an employee's addresses; each employee has at
+// most one address of each type.
+type AddressType string
+
+const (
+	AddressTypeHome    AddressType = "home"
+	AddressTypeMailing AddressType = "mailing"
+)
+
+// Address is a postal address. Country is an ISO 3166-1 alpha-2 code.
+// NeedsReview marks addresses carried over from the old free-text field
+// that could not be parsed with confidence.
+type Address struct {
+	ID          int         `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID  int         `json:"employeeId" gorm:"not null;uniqueIndex:idx_address_employee_type"`
+	Type        AddressType `json:"type" gorm:"not null;uniqueIndex:idx_address_employee_type"`
+	Line1       string      `json:"line1" gorm:"not null"`
+	Line2       string      `json:"line2,omitempty"`
+	City        string      `json:"city"`
+	Region      string      `json:"region,omitempty"`
+	PostalCode  string      `json:"postalCode,omitempty"`
+	Country     string      `json:"country" gorm:"size:2;index"`
+	NeedsReview bool        `json:"needsReview,omitempty"`
+	CreatedAt   time.Time   `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time   `json:"updatedAt" gorm:"autoUpdateTime"`
+}
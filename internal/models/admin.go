@@ -6,12 +6,39 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// PermissionCompensation lets an admin read and change salaries and
+	// bonuses. Without it compensation is never included in a response.
+	PermissionCompensation = "compensation"
+	// PermissionHR lets an admin read and change personal data beyond the
+	// basic profile, such as emergency contacts.
+	PermissionHR = "hr"
+	// PermissionFinance lets an admin manage the headcount plan and its
+	// budgets, give expense claims their final approval and export
+	// approved claims for accounting.
+	PermissionFinance = "finance"
+	// PermissionSuperadmin lets an admin grant any permission and manage
+	// other admins' accounts. The first one is seeded at startup.
+	PermissionSuperadmin = "superadmin"
+)
+
 type Admin struct {
-	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
-	Email     string    `json:"email" gorm:"uniqueIndex;not null"`
-	Password  string    `json:"password" gorm:"not null"`
-	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
-	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Email       string    `json:"email" gorm:"uniqueIndex;not null"`
+	Password    string    `json:"password" gorm:"not null"`
+	Permissions []string  `json:"permissions,omitempty" gorm:"serializer:json"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// HasPermission reports whether the admin has been granted permission.
+func (a *Admin) HasPermission(permission string) bool {
+	for _, p := range a.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
 }
 
 func Hash(password string) ([]byte, error) {
@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+type AssetStatus string
+
+const (
+	AssetStatusAvailable AssetStatus = "available"
+	AssetStatusAssigned  AssetStatus = "assigned"
+	AssetStatusInRepair  AssetStatus = "in_repair"
+	AssetStatusRetired   AssetStatus = "retired"
+	AssetStatusLost      AssetStatus = "lost"
+)
+
+// Asset is a piece of equipment such as a laptop or badge. EmployeeID is
+// whoever has it checked out, and is only set while Status is assigned.
+type Asset struct {
+	ID           int         `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Type         string      `json:"type" gorm:"index;not null"`
+	SerialNumber string      `json:"serialNumber" gorm:"uniqueIndex;not null"`
+	Model        string      `json:"model,omitempty"`
+	PurchaseDate *Date       `json:"purchaseDate,omitempty"`
+	Status       AssetStatus `json:"status" gorm:"index;not null;default:available"`
+	EmployeeID   *int        `json:"employeeId,omitempty" gorm:"index"`
+	Notes        string      `json:"notes,omitempty"`
+	CreatedAt    time.Time   `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time   `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AssetFilter narrows ListAssets. Zero fields are not filtered on.
+// HolderStatuses selects assets checked out to employees in one of those
+// statuses.
+type AssetFilter struct {
+	Type           string
+	Status         AssetStatus
+	EmployeeID     *int
+	HolderStatuses []EmployeeStatus
+}
+
+// AssetAssignment is one check-out of an asset to an employee, open until
+// CheckedInAt is set.
+type AssetAssignment struct {
+	ID               int        `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	AssetID          int        `json:"assetId" gorm:"index;not null"`
+	EmployeeID       int        `json:"employeeId" gorm:"index;not null"`
+	CheckedOutAt     time.Time  `json:"checkedOutAt" gorm:"not null"`
+	CheckedOutBy     *int       `json:"checkedOutBy,omitempty"`
+	CheckedInAt      *time.Time `json:"checkedInAt,omitempty"`
+	CheckedInBy      *int       `json:"checkedInBy,omitempty"`
+	CheckOutNote     string     `json:"checkOutNote,omitempty"`
+	CheckInNote      string     `json:"checkInNote,omitempty"`
+	ReturnedAsStatus string     `json:"returnedAsStatus,omitempty"`
+}
+
+// AssetAssignmentFilter narrows ListAssetAssignments. Nil fields are not
+// filtered on.
+type AssetAssignmentFilter struct {
+	AssetID    *int
+	EmployeeID *int
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+type ChecklistKind string
+
+const (
+	ChecklistKindOnboarding  ChecklistKind = "onboarding"
+	ChecklistKindOffboarding ChecklistKind = "offboarding"
+)
+
+// Task owner roles resolved against the employee a checklist is for.
+const (
+	OwnerRoleManager  = "manager"
+	OwnerRoleEmployee = "employee"
+)
+
+// ChecklistTemplate describes the tasks to create when an employee starts
+// or leaves. A nil DepartmentID or empty ContractType matches any employee;
+// every matching template is instantiated.
+type ChecklistTemplate struct {
+	ID           int            `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name         string         `json:"name" gorm:"not null"`
+	Kind         ChecklistKind  `json:"kind" gorm:"index;not null"`
+	DepartmentID *int           `json:"departmentId,omitempty"`
+	ContractType string         `json:"contractType,omitempty"`
+	Tasks        []TemplateTask `json:"tasks" gorm:"serializer:json"`
+	CreatedAt    time.Time      `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// TemplateTask is a task in a template. It is owned by OwnerAdminID, or by
+// the employee's manager or the employee themselves when OwnerRole is set.
+// The task falls due DueDays after the checklist starts.
+type TemplateTask struct {
+	Title        string `json:"title"`
+	OwnerAdminID *int   `json:"ownerAdminId,omitempty"`
+	OwnerRole    string `json:"ownerRole,omitempty"`
+	DueDays      int    `json:"dueDays"`
+}
+
+// Checklist is a template instantiated for one employee.
+type Checklist struct {
+	ID         int             `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID int             `json:"employeeId" gorm:"index;not null"`
+	TemplateID int             `json:"templateId"`
+	Name       string          `json:"name"`
+	Kind       ChecklistKind   `json:"kind" gorm:"not null"`
+	StartDate  Date            `json:"startDate" gorm:"not null"`
+	Tasks      []ChecklistTask `json:"tasks" gorm:"foreignKey:ChecklistID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// ChecklistTask is one task to be done for an employee.
+type ChecklistTask struct {
+	ID                 int        `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	ChecklistID        int        `json:"checklistId" gorm:"index;not null"`
+	EmployeeID         int        `json:"employeeId" gorm:"index;not null"`
+	Title              string     `json:"title" gorm:"not null"`
+	OwnerAdminID       *int       `json:"ownerAdminId,omitempty" gorm:"index"`
+	OwnerEmployeeID    *int       `json:"ownerEmployeeId,omitempty" gorm:"index"`
+	DueDate            Date       `json:"dueDate" gorm:"index;not null"`
+	CompletedAt        *time.Time `json:"completedAt,omitempty"`
+	CompletedByAdminID *int       `json:"completedByAdminId,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ChecklistTaskFilter narrows ListChecklistTasks. Nil fields are not
+// filtered on; OverdueOn selects open tasks due before that day.
+type ChecklistTaskFilter struct {
+	OwnerAdminID    *int
+	OwnerEmployeeID *int
+	EmployeeID      *int
+	Open            bool
+	OverdueOn       *Date
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// PayFrequency is how often BasePay is paid.
+type PayFrequency string
+
+const (
+	PayFrequencyHourly   PayFrequency = "hourly"
+	PayFrequencyWeekly   PayFrequency = "weekly"
+	PayFrequencyBiweekly PayFrequency = "biweekly"
+	PayFrequencyMonthly  PayFrequency = "monthly"
+	PayFrequencyAnnual   PayFrequency = "annual"
+)
+
+// Compensation is one period of an employee's pay history. Amounts are in
+// the minor unit of Currency (cents for USD). EffectiveTo is exclusive and
+// nil for the current record; records of one employee never overlap.
+//
+// Compensation is deliberately not part of Employee: it is only returned by
+// handlers that check PermissionCompensation.
+type Compensation struct {
+	ID            int          `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID    int          `json:"employeeId" gorm:"index;not null"`
+	BasePay       int64        `json:"basePay" gorm:"not null"`
+	Currency      string       `json:"currency" gorm:"size:3;not null"`
+	PayFrequency  PayFrequency `json:"payFrequency" gorm:"not null"`
+	EffectiveFrom Date         `json:"effectiveFrom" gorm:"not null"`
+	EffectiveTo   *Date        `json:"effectiveTo,omitempty"`
+	Reason        string       `json:"reason,omitempty"`
+	CreatedAt     time.Time    `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// ActiveOn reports whether the record covers the given day.
+func (c Compensation) ActiveOn(d Date) bool {
+	return !c.EffectiveFrom.After(d.Time) && (c.EffectiveTo == nil || c.EffectiveTo.After(d.Time))
+}
+
+// Bonus is a one-off payment on top of base pay.
+type Bonus struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID int       `json:"employeeId" gorm:"index;not null"`
+	Amount     int64     `json:"amount" gorm:"not null"`
+	Currency   string    `json:"currency" gorm:"size:3;not null"`
+	AwardedOn  Date      `json:"awardedOn" gorm:"not null"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+type ContractType string
+
+const (
+	ContractTypePermanent  ContractType = "permanent"
+	ContractTypeFixedTerm  ContractType = "fixed_term"
+	ContractTypeContractor ContractType = "contractor"
+)
+
+// Contract is one of an employee's employment contracts. EndDate is the
+// last day it covers; permanent contracts have none. Either side must give
+// NoticePeriodDays of notice, so a contract that is not to be renewed has
+// to be acted on by EndDate less the notice period.
+//
+// EndAlertedOn and ProbationAlertedOn record when the alerts went out and
+// are cleared whenever the dates they are about change. EndedAt is set once
+// the contract's end has been handled: the employee was terminated, or the
+// contract was recorded after it had already ended.
+type Contract struct {
+	ID                 int          `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID         int          `json:"employeeId" gorm:"index;not null"`
+	Type               ContractType `json:"type" gorm:"not null"`
+	StartDate          Date         `json:"startDate" gorm:"not null"`
+	EndDate            *Date        `json:"endDate,omitempty" gorm:"index"`
+	NoticePeriodDays   int          `json:"noticePeriodDays"`
+	ProbationEndsOn    *Date        `json:"probationEndsOn,omitempty" gorm:"index"`
+	Notes              string       `json:"notes,omitempty"`
+	EndAlertedOn       *Date        `json:"endAlertedOn,omitempty"`
+	ProbationAlertedOn *Date        `json:"probationAlertedOn,omitempty"`
+	EndedAt            *time.Time   `json:"endedAt,omitempty"`
+	CreatedAt          time.Time    `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time    `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ActiveOn reports whether the contract covers the given day.
+func (c Contract) ActiveOn(d Date) bool {
+	return !c.StartDate.After(d.Time) && (c.EndDate == nil || !c.EndDate.Before(d.Time))
+}
+
+// NoticeBy returns the last day notice can be given for the contract to
+// end on its end date, or nil for a contract without one.
+func (c Contract) NoticeBy() *Date {
+	if c.EndDate == nil {
+		return nil
+	}
+	d := c.EndDate.AddDays(-c.NoticePeriodDays)
+	return &d
+}
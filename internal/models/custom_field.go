@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+type CustomFieldType string
+
+const (
+	CustomFieldString CustomFieldType = "string"
+	CustomFieldNumber CustomFieldType = "number"
+	CustomFieldDate   CustomFieldType = "date"
+	CustomFieldEnum   CustomFieldType = "enum"
+)
+
+// CustomFieldDefinition describes an extra employee attribute that admins
+// add without a code change. Values live in Employee.CustomFields under
+// Key. Pattern and MaxLength apply to strings, Min and Max to numbers and
+// Options lists the allowed values of an enum.
+type CustomFieldDefinition struct {
+	ID        int             `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Key       string          `json:"key" gorm:"uniqueIndex;not null"`
+	Label     string          `json:"label"`
+	Type      CustomFieldType `json:"type" gorm:"not null"`
+	Required  bool            `json:"required"`
+	Pattern   string          `json:"pattern,omitempty"`
+	MaxLength *int            `json:"maxLength,omitempty"`
+	Min       *float64        `json:"min,omitempty"`
+	Max       *float64        `json:"max,omitempty"`
+	Options   []string        `json:"options,omitempty" gorm:"serializer:json"`
+	CreatedAt time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time       `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type Department struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name      string    `json:"name" gorm:"uniqueIndex;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type DocumentCategory string
+
+const (
+	DocumentCategoryContract    DocumentCategory = "contract"
+	DocumentCategoryID          DocumentCategory = "id"
+	DocumentCategoryCertificate DocumentCategory = "certificate"
+	DocumentCategoryReceipt     DocumentCategory = "receipt"
+	DocumentCategoryOther       DocumentCategory = "other"
+)
+
+// Document is a file attached to an employee. The contents live in the
+// blob store under StorageKey; SHA256 is the hex digest of the contents.
+type Document struct {
+	ID                int              `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID        int              `json:"employeeId" gorm:"index;not null"`
+	Category          DocumentCategory `json:"category" gorm:"not null"`
+	FileName          string           `json:"fileName" gorm:"not null"`
+	ContentType       string           `json:"contentType" gorm:"not null"`
+	Size              int64            `json:"size"`
+	SHA256            string           `json:"sha256" gorm:"size:64;not null"`
+	StorageKey        string           `json:"-" gorm:"uniqueIndex;not null"`
+	ExpiresOn         *Date            `json:"expiresOn,omitempty" gorm:"index"`
+	UploadedByAdminID *int             `json:"uploadedByAdminId,omitempty"`
+	CreatedAt         time.Time        `json:"createdAt" gorm:"autoCreateTime"`
+}
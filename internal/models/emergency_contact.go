@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// EmergencyContact is someone to call when an employee is in an incident.
+// Contacts are tried in ascending Priority; PhoneNumbers are in E.164 form
+// and tried in order.
+type EmergencyContact struct {
+	ID           int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID   int       `json:"employeeId" gorm:"index;not null"`
+	Name         string    `json:"name" gorm:"not null"`
+	Relationship string    `json:"relationship"`
+	PhoneNumbers []string  `json:"phoneNumbers" gorm:"serializer:json"`
+	Priority     int       `json:"priority" gorm:"not null"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -2,12 +2,26 @@ package models
 
 import "time"
 
+// Employee is a member of staff. DepartmentID and ManagerID are nil for
+// employees outside any department and for the top of the reporting line.
+// AddressText is every address on one line, kept in step with Addresses so
+// that search can match on it. CustomFields holds values for the admin
+// defined CustomFieldDefinitions, by key.
 type Employee struct {
-	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
-	FirstName string    `json:"firstName" gorm:"not null"`
-	LastName  string    `json:"lastName" gorm:"not null"`
-	Email     string    `json:"email" gorm:"uniqueIndex;not null"`
-	Address   string    `json:"address"`
-	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
-	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+	ID           int            `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	FirstName    string         `json:"firstName" gorm:"not null"`
+	LastName     string         `json:"lastName" gorm:"not null"`
+	Email        string         `json:"email" gorm:"uniqueIndex;not null"`
+	JobTitle     string         `json:"jobTitle"`
+	DepartmentID *int           `json:"departmentId,omitempty" gorm:"index"`
+	ManagerID    *int           `json:"managerId,omitempty" gorm:"index"`
+	LocationID   *int           `json:"locationId,omitempty" gorm:"index"`
+	Status       EmployeeStatus `json:"status" gorm:"index;not null;default:active"`
+	ContractType string         `json:"contractType,omitempty"`
+	PhotoURL     string         `json:"photoUrl,omitempty"`
+	Addresses    []Address      `json:"addresses,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+	AddressText  string         `json:"-" gorm:"column:address"`
+	CustomFields map[string]any `json:"customFields,omitempty" gorm:"type:jsonb;serializer:json"`
+	CreatedAt    time.Time      `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updatedAt" gorm:"autoUpdateTime"`
 }
@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// ExpenseCategory is a kind of expense such as travel or meals. Limits
+// caps a single item, by currency in minor units; AccountCode is the
+// ledger account the accounting export books the category to.
+type ExpenseCategory struct {
+	ID              int              `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name            string           `json:"name" gorm:"uniqueIndex;not null"`
+	AccountCode     string           `json:"accountCode,omitempty"`
+	Limits          map[string]int64 `json:"limits,omitempty" gorm:"type:jsonb;serializer:json"`
+	ReceiptRequired bool             `json:"receiptRequired"`
+	CreatedAt       time.Time        `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time        `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+type ExpenseClaimStatus string
+
+const (
+	ExpenseClaimStatusDraft           ExpenseClaimStatus = "draft"
+	ExpenseClaimStatusSubmitted       ExpenseClaimStatus = "submitted"
+	ExpenseClaimStatusManagerApproved ExpenseClaimStatus = "manager_approved"
+	ExpenseClaimStatusApproved        ExpenseClaimStatus = "approved"
+	ExpenseClaimStatusRejected        ExpenseClaimStatus = "rejected"
+)
+
+// ExpenseClaim is an employee's request to be reimbursed, in Currency, for
+// its items. Submitted claims are approved by a manager of the employee and
+// then by finance; either can reject them, after which the employee may
+// edit and resubmit. Total is the sum of the items' ClaimAmount.
+type ExpenseClaim struct {
+	ID                int                `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID        int                `json:"employeeId" gorm:"index;not null"`
+	Title             string             `json:"title" gorm:"not null"`
+	Currency          string             `json:"currency" gorm:"size:3;not null"`
+	Total             int64              `json:"total" gorm:"not null"`
+	Status            ExpenseClaimStatus `json:"status" gorm:"index;not null;default:draft"`
+	Items             []ExpenseItem      `json:"items" gorm:"foreignKey:ClaimID;constraint:OnDelete:CASCADE"`
+	SubmittedAt       *time.Time         `json:"submittedAt,omitempty"`
+	ManagerApproverID *int               `json:"managerApproverId,omitempty"`
+	ManagerApprovedAt *time.Time         `json:"managerApprovedAt,omitempty"`
+	ManagerAdminID    *int               `json:"managerAdminId,omitempty"`
+	FinanceAdminID    *int               `json:"financeAdminId,omitempty"`
+	ApprovedAt        *time.Time         `json:"approvedAt,omitempty" gorm:"index"`
+	RejectedAt        *time.Time         `json:"rejectedAt,omitempty"`
+	DecisionNote      string             `json:"decisionNote,omitempty"`
+	CreatedAt         time.Time          `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time          `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ExpenseItem is one expense on a claim. Amount is what was spent, in the
+// minor unit of Currency; ExchangeRate converts it into ClaimAmount in the
+// claim's currency. The receipt is one of the employee's documents.
+// OverLimit marks an item above its category's limit, which needs a
+// Justification.
+type ExpenseItem struct {
+	ID                int     `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	ClaimID           int     `json:"claimId" gorm:"index;not null"`
+	CategoryID        int     `json:"categoryId" gorm:"not null"`
+	Date              Date    `json:"date" gorm:"not null"`
+	Description       string  `json:"description" gorm:"not null"`
+	Amount            int64   `json:"amount" gorm:"not null"`
+	Currency          string  `json:"currency" gorm:"size:3;not null"`
+	ExchangeRate      float64 `json:"exchangeRate" gorm:"not null"`
+	ClaimAmount       int64   `json:"claimAmount" gorm:"not null"`
+	ReceiptDocumentID *int    `json:"receiptDocumentId,omitempty"`
+	Justification     string  `json:"justification,omitempty"`
+	OverLimit         bool    `json:"overLimit"`
+}
+
+// ExpenseClaimFilter narrows ListExpenseClaims. Zero fields are not
+// filtered on; ApprovedFrom and ApprovedTo bound the day of final approval.
+type ExpenseClaimFilter struct {
+	EmployeeID   *int
+	Status       ExpenseClaimStatus
+	ApprovedFrom *Date
+	ApprovedTo   *Date
+}
+
+// PolicyViolation is why an item keeps its claim from being submitted.
+// Item is the item's index in the claim.
+type PolicyViolation struct {
+	Item   int    `json:"item"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// EmployeeFilter narrows ListEmployees. Nil or empty fields are not
+// filtered on; Statuses matches any of the listed statuses and Country
+// any employee with an address in that country. CustomFields matches
+// employees holding every listed value.
+type EmployeeFilter struct {
+	DepartmentID *int
+	Statuses     []EmployeeStatus
+	Country      string
+	CustomFields map[string]any
+}
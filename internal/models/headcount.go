@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+type PlannedPositionStatus string
+
+const (
+	PlannedPositionStatusOpen   PlannedPositionStatus = "open"
+	PlannedPositionStatusFilled PlannedPositionStatus = "filled"
+	PlannedPositionStatusFrozen PlannedPositionStatus = "frozen"
+)
+
+// PlannedPosition is a budgeted seat in a department's headcount plan. It
+// counts towards the plan from OpenedOn until the exclusive ClosedOn, and
+// is filled while EmployeeID holds it. Budget is the annual cost in the
+// minor unit of Currency. Frozen positions stay in the plan but may not be
+// filled.
+//
+// Not to be confused with Position, which is a period of one employee's
+// employment history.
+type PlannedPosition struct {
+	ID           int                   `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Title        string                `json:"title" gorm:"not null"`
+	DepartmentID int                   `json:"departmentId" gorm:"index;not null"`
+	Budget       int64                 `json:"budget" gorm:"not null"`
+	Currency     string                `json:"currency" gorm:"size:3;not null"`
+	Status       PlannedPositionStatus `json:"status" gorm:"index;not null;default:open"`
+	EmployeeID   *int                  `json:"employeeId,omitempty" gorm:"uniqueIndex"`
+	OpenedOn     Date                  `json:"openedOn" gorm:"not null"`
+	ClosedOn     *Date                 `json:"closedOn,omitempty"`
+	CreatedAt    time.Time             `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time             `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ActiveOn reports whether the position is part of the plan on the given
+// day.
+func (p PlannedPosition) ActiveOn(d Date) bool {
+	return !p.OpenedOn.After(d.Time) && (p.ClosedOn == nil || p.ClosedOn.After(d.Time))
+}
+
+// PlannedPositionFilter narrows ListPlannedPositions. Zero fields are not
+// filtered on.
+type PlannedPositionFilter struct {
+	DepartmentID *int
+	Status       PlannedPositionStatus
+	EmployeeID   *int
+}
+
+// HeadcountPoint compares a department's plan with its actual headcount
+// on one day. Planned counts positions in the plan that day, including the
+// Frozen ones that are frozen now; Budget sums their budgets per currency.
+// Actual counts employees in the department who were onboarding, active or
+// on leave that day.
+type HeadcountPoint struct {
+	Date         Date             `json:"date"`
+	DepartmentID int              `json:"departmentId"`
+	Planned      int              `json:"planned"`
+	Frozen       int              `json:"frozen"`
+	Actual       int              `json:"actual"`
+	Variance     int              `json:"variance"`
+	Budget       map[string]int64 `json:"budget,omitempty"`
+}
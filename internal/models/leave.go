@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+type LeaveType struct {
+	ID   int    `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name string `json:"name" gorm:"uniqueIndex;not null"`
+	Paid bool   `json:"paid"`
+	// AllowNegative lets requests exceed the available balance, as is usual
+	// for sick leave.
+	AllowNegative bool      `json:"allowNegative"`
+	CreatedAt     time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AccrualPolicy grants an employee DaysPerYear of a leave type, accrued in
+// equal monthly instalments from StartDate.
+type AccrualPolicy struct {
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID  int       `json:"employeeId" gorm:"uniqueIndex:idx_accrual_employee_type;not null"`
+	LeaveTypeID int       `json:"leaveTypeId" gorm:"uniqueIndex:idx_accrual_employee_type;not null"`
+	DaysPerYear float64   `json:"daysPerYear"`
+	StartDate   Date      `json:"startDate" gorm:"not null"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+type LeaveStatus string
+
+const (
+	LeaveStatusPending   LeaveStatus = "pending"
+	LeaveStatusApproved  LeaveStatus = "approved"
+	LeaveStatusRejected  LeaveStatus = "rejected"
+	LeaveStatusCancelled LeaveStatus = "cancelled"
+)
+
+// LeaveRequest covers StartDate to EndDate inclusive. HalfDayStart and
+// HalfDayEnd take only the afternoon of the first day and the morning of the
+// last. Days is the number of working days charged and is computed by the
+// server.
+type LeaveRequest struct {
+	ID           int         `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID   int         `json:"employeeId" gorm:"index;not null"`
+	LeaveTypeID  int         `json:"leaveTypeId" gorm:"not null"`
+	StartDate    Date        `json:"startDate" gorm:"not null"`
+	EndDate      Date        `json:"endDate" gorm:"not null"`
+	HalfDayStart bool        `json:"halfDayStart"`
+	HalfDayEnd   bool        `json:"halfDayEnd"`
+	Days         float64     `json:"days"`
+	Reason       string      `json:"reason"`
+	Status       LeaveStatus `json:"status" gorm:"index;not null"`
+	// ApproverID is the manager who decided the request, DecidedByAdminID
+	// the HR admin. At least one is set once the request is decided.
+	ApproverID       *int       `json:"approverId,omitempty"`
+	DecidedByAdminID *int       `json:"decidedByAdminId,omitempty"`
+	DecisionNote     string     `json:"decisionNote,omitempty"`
+	DecidedAt        *time.Time `json:"decidedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// BalanceAdjustment is the audit trail of a leave balance. Approved
+// requests, cancellations of approved requests and manual corrections each
+// add one row; rows are never updated or deleted.
+type BalanceAdjustment struct {
+	ID             int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID     int       `json:"employeeId" gorm:"index:idx_adjustment_employee_type;not null"`
+	LeaveTypeID    int       `json:"leaveTypeId" gorm:"index:idx_adjustment_employee_type;not null"`
+	Days           float64   `json:"days"`
+	Reason         string    `json:"reason" gorm:"not null"`
+	LeaveRequestID *int      `json:"leaveRequestId,omitempty"`
+	AdminID        *int      `json:"adminId,omitempty"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// LeaveBalance is computed on demand and never stored. Available is
+// Accrued plus Adjusted minus Pending; Adjusted is already net of leave
+// taken.
+type LeaveBalance struct {
+	EmployeeID  int     `json:"employeeId"`
+	LeaveTypeID int     `json:"leaveTypeId"`
+	AsOf        Date    `json:"asOf"`
+	Accrued     float64 `json:"accrued"`
+	Adjusted    float64 `json:"adjusted"`
+	Pending     float64 `json:"pending"`
+	Available   float64 `json:"available"`
+}
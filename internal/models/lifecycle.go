@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// EmployeeStatus is where an employee is in their lifecycle. It only
+// changes through a StatusTransition.
+type EmployeeStatus string
+
+const (
+	EmployeeStatusCandidate  EmployeeStatus = "candidate"
+	EmployeeStatusOnboarding EmployeeStatus = "onboarding"
+	EmployeeStatusActive     EmployeeStatus = "active"
+	EmployeeStatusOnLeave    EmployeeStatus = "on_leave"
+	EmployeeStatusTerminated EmployeeStatus = "terminated"
+)
+
+// StatusTransition records a change of employee status. Transitions with a
+// future EffectiveDate stay pending, with a nil AppliedAt, until that day.
+type StatusTransition struct {
+	ID            int            `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID    int            `json:"employeeId" gorm:"index;not null"`
+	From          EmployeeStatus `json:"from" gorm:"not null"`
+	To            EmployeeStatus `json:"to" gorm:"not null"`
+	Reason        string         `json:"reason" gorm:"not null"`
+	EffectiveDate Date           `json:"effectiveDate" gorm:"index;not null"`
+	AdminID       *int           `json:"adminId,omitempty"`
+	AppliedAt     *time.Time     `json:"appliedAt,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt" gorm:"autoCreateTime"`
+}
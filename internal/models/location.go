@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// Location is an office. Employees at a location observe the holidays of its
+// calendar.
+type Location struct {
+	ID                int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name              string    `json:"name" gorm:"uniqueIndex;not null"`
+	CountryCode       string    `json:"countryCode" gorm:"size:2"`
+	HolidayCalendarID *int      `json:"holidayCalendarId,omitempty" gorm:"index"`
+	CreatedAt         time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+type HolidayCalendar struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name      string    `json:"name" gorm:"uniqueIndex;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// Holiday is a one-off dated holiday in a calendar, entered by hand or
+// imported from an iCalendar file.
+type Holiday struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	CalendarID int       `json:"calendarId" gorm:"uniqueIndex:idx_holiday_calendar_date"`
+	Date       Date      `json:"date" gorm:"uniqueIndex:idx_holiday_calendar_date;not null"`
+	Name       string    `json:"name" gorm:"not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// HolidayRule is a holiday recurring every year, such as "last Monday of
+// May" or "Easter -2". See holidays.ParseRule for the accepted forms.
+type HolidayRule struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	CalendarID int       `json:"calendarId" gorm:"index;not null"`
+	Name       string    `json:"name" gorm:"not null"`
+	Rule       string    `json:"rule" gorm:"not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
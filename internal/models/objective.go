@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+// Objective is a goal for a quarter, such as "2025-Q3". It belongs to one
+// employee, team or department, and may be aligned to a parent objective
+// that it contributes to.
+type Objective struct {
+	ID           int         `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Title        string      `json:"title" gorm:"not null"`
+	Description  string      `json:"description,omitempty"`
+	Quarter      string      `json:"quarter" gorm:"index;not null"`
+	EmployeeID   *int        `json:"employeeId,omitempty" gorm:"index"`
+	DepartmentID *int        `json:"departmentId,omitempty" gorm:"index"`
+	TeamID       *int        `json:"teamId,omitempty" gorm:"index"`
+	ParentID     *int        `json:"parentId,omitempty" gorm:"index"`
+	KeyResults   []KeyResult `json:"keyResults,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time   `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time   `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// KeyResult is a measurable outcome of an objective, moving from
+// StartValue towards TargetValue. The target may be below the start for
+// results that should go down. Weight sets its share of the objective's
+// progress.
+type KeyResult struct {
+	ID           int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	ObjectiveID  int       `json:"objectiveId" gorm:"index;not null"`
+	Title        string    `json:"title" gorm:"not null"`
+	Unit         string    `json:"unit,omitempty"`
+	StartValue   float64   `json:"startValue"`
+	TargetValue  float64   `json:"targetValue"`
+	CurrentValue float64   `json:"currentValue"`
+	Weight       float64   `json:"weight" gorm:"not null;default:1"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// CheckIn records a key result's value at a point in time.
+type CheckIn struct {
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	KeyResultID int       `json:"keyResultId" gorm:"index;not null"`
+	Value       float64   `json:"value"`
+	Note        string    `json:"note,omitempty"`
+	AdminID     *int      `json:"adminId,omitempty"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// ObjectiveFilter narrows ListObjectives. Zero fields are not filtered on.
+// ManagerID selects the objectives of the manager's direct reports.
+type ObjectiveFilter struct {
+	Quarter      string
+	EmployeeID   *int
+	DepartmentID *int
+	TeamID       *int
+	ManagerID    *int
+	ParentID     *int
+}
+
+// ObjectiveProgress is an objective's progress, from 0 to 1, rolled up
+// from its key results and the objectives aligned to it.
+type ObjectiveProgress struct {
+	ObjectiveID int                 `json:"objectiveId"`
+	Title       string              `json:"title"`
+	Quarter     string              `json:"quarter"`
+	Progress    float64             `json:"progress"`
+	KeyResults  []KeyResultProgress `json:"keyResults,omitempty"`
+	Children    []ObjectiveProgress `json:"children,omitempty"`
+}
+
+type KeyResultProgress struct {
+	KeyResultID int     `json:"keyResultId"`
+	Title       string  `json:"title"`
+	Progress    float64 `json:"progress"`
+}
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+type PayrollRunStatus string
+
+const (
+	PayrollRunStatusDraft  PayrollRunStatus = "draft"
+	PayrollRunStatusLocked PayrollRunStatus = "locked"
+)
+
+// PayrollRun is gross-to-net pay for every employee in scope over one pay
+// period. Drafts can be recalculated; a locked run is an immutable snapshot
+// that also keeps the rule set it was calculated with.
+type PayrollRun struct {
+	ID               int              `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	PeriodStart      Date             `json:"periodStart" gorm:"not null"`
+	PeriodEnd        Date             `json:"periodEnd" gorm:"not null"`
+	PayFrequency     PayFrequency     `json:"payFrequency" gorm:"not null"`
+	Currency         string           `json:"currency" gorm:"size:3;not null"`
+	DepartmentID     *int             `json:"departmentId,omitempty"`
+	RuleSet          string           `json:"ruleSet" gorm:"not null"`
+	RuleSetSource    string           `json:"ruleSetSource,omitempty" gorm:"type:text"`
+	Status           PayrollRunStatus `json:"status" gorm:"index;not null"`
+	Skipped          []int            `json:"skipped,omitempty" gorm:"serializer:json"`
+	CreatedByAdminID *int             `json:"createdByAdminId,omitempty"`
+	LockedByAdminID  *int             `json:"lockedByAdminId,omitempty"`
+	LockedAt         *time.Time       `json:"lockedAt,omitempty"`
+	Payslips         []Payslip        `json:"payslips,omitempty" gorm:"foreignKey:RunID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time        `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time        `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// PayslipLine is one deduction on a payslip. PreTax deductions reduce the
+// taxable amount seen by the rules after them.
+type PayslipLine struct {
+	Name   string `json:"name"`
+	Amount int64  `json:"amount"`
+	PreTax bool   `json:"preTax,omitempty"`
+}
+
+// Payslip is one employee's result in a payroll run. Amounts are in the
+// minor unit of Currency.
+type Payslip struct {
+	ID              int           `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	RunID           int           `json:"runId" gorm:"uniqueIndex:idx_payslip_run_employee;not null"`
+	EmployeeID      int           `json:"employeeId" gorm:"uniqueIndex:idx_payslip_run_employee;not null"`
+	EmployeeName    string        `json:"employeeName"`
+	Currency        string        `json:"currency" gorm:"size:3;not null"`
+	BasePay         int64         `json:"basePay"`
+	Bonus           int64         `json:"bonus"`
+	Gross           int64         `json:"gross"`
+	Taxable         int64         `json:"taxable"`
+	Deductions      []PayslipLine `json:"deductions" gorm:"serializer:json"`
+	TotalDeductions int64         `json:"totalDeductions"`
+	Net             int64         `json:"net"`
+	CreatedAt       time.Time     `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Position is one period of an employee's employment history. EffectiveTo
+// is exclusive and nil for the open-ended latest position; positions of the
+// same employee never overlap.
+type Position struct {
+	ID            int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID    int       `json:"employeeId" gorm:"index;not null"`
+	JobTitle      string    `json:"jobTitle"`
+	DepartmentID  *int      `json:"departmentId,omitempty"`
+	ManagerID     *int      `json:"managerId,omitempty"`
+	EffectiveFrom Date      `json:"effectiveFrom" gorm:"not null"`
+	EffectiveTo   *Date     `json:"effectiveTo,omitempty"`
+	CreatedAt     time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// ActiveOn reports whether the position covers the given day.
+func (p Position) ActiveOn(d Date) bool {
+	return !p.EffectiveFrom.After(d.Time) && (p.EffectiveTo == nil || p.EffectiveTo.After(d.Time))
+}
+
+// ApplyTo copies the position's fields onto the employee record.
+func (p Position) ApplyTo(emp *Employee) {
+	emp.JobTitle = p.JobTitle
+	emp.DepartmentID = p.DepartmentID
+	emp.ManagerID = p.ManagerID
+}
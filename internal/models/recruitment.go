@@ -0,0 +1,109 @@
+package models
+
+import "time"
+
+type RequisitionStatus string
+
+const (
+	RequisitionStatusOpen      RequisitionStatus = "open"
+	RequisitionStatusOnHold    RequisitionStatus = "on_hold"
+	RequisitionStatusFilled    RequisitionStatus = "filled"
+	RequisitionStatusCancelled RequisitionStatus = "cancelled"
+)
+
+// Requisition is an approved opening to hire for. Hires take their job
+// title, department, location and manager from it unless the offer says
+// otherwise. It becomes filled once Openings candidates have been hired.
+type Requisition struct {
+	ID              int               `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Title           string            `json:"title" gorm:"not null"`
+	Description     string            `json:"description,omitempty"`
+	DepartmentID    *int              `json:"departmentId,omitempty" gorm:"index"`
+	LocationID      *int              `json:"locationId,omitempty"`
+	HiringManagerID *int              `json:"hiringManagerId,omitempty" gorm:"index"`
+	Openings        int               `json:"openings" gorm:"not null;default:1"`
+	Status          RequisitionStatus `json:"status" gorm:"index;not null;default:open"`
+	CreatedAt       time.Time         `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time         `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// PipelineStage is one step candidates move through, such as a phone
+// screen or an onsite. Stages are shown in Position order.
+type PipelineStage struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name      string    `json:"name" gorm:"uniqueIndex;not null"`
+	Position  int       `json:"position" gorm:"not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+type CandidateStatus string
+
+const (
+	CandidateStatusActive    CandidateStatus = "active"
+	CandidateStatusHired     CandidateStatus = "hired"
+	CandidateStatusRejected  CandidateStatus = "rejected"
+	CandidateStatusWithdrawn CandidateStatus = "withdrawn"
+)
+
+// Candidate is someone applying for a requisition. StageID is where they
+// are in the pipeline while active. Once hired, EmployeeID is the employee
+// record created for them and StartDate their first day.
+type Candidate struct {
+	ID            int             `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	RequisitionID int             `json:"requisitionId" gorm:"index;not null"`
+	FirstName     string          `json:"firstName" gorm:"not null"`
+	LastName      string          `json:"lastName" gorm:"not null"`
+	Email         string          `json:"email" gorm:"index;not null"`
+	Phone         string          `json:"phone,omitempty"`
+	Source        string          `json:"source,omitempty"`
+	StageID       *int            `json:"stageId,omitempty" gorm:"index"`
+	Status        CandidateStatus `json:"status" gorm:"index;not null;default:active"`
+	Notes         string          `json:"notes,omitempty"`
+	EmployeeID    *int            `json:"employeeId,omitempty"`
+	StartDate     *Date           `json:"startDate,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time       `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// CandidateFilter narrows ListCandidates. Zero fields are not filtered on.
+type CandidateFilter struct {
+	RequisitionID *int
+	StageID       *int
+	Status        CandidateStatus
+}
+
+type Recommendation string
+
+const (
+	RecommendationStrongYes Recommendation = "strong_yes"
+	RecommendationYes       Recommendation = "yes"
+	RecommendationNo        Recommendation = "no"
+	RecommendationStrongNo  Recommendation = "strong_no"
+)
+
+// InterviewFeedback is one interviewer's verdict on a candidate at a
+// stage. Rating runs from 1 to 5.
+type InterviewFeedback struct {
+	ID             int            `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	CandidateID    int            `json:"candidateId" gorm:"index;not null"`
+	StageID        *int           `json:"stageId,omitempty"`
+	InterviewerID  *int           `json:"interviewerId,omitempty"`
+	Rating         int            `json:"rating" gorm:"not null"`
+	Recommendation Recommendation `json:"recommendation" gorm:"not null"`
+	Notes          string         `json:"notes,omitempty"`
+	AdminID        *int           `json:"adminId,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// Offer is what a candidate accepted: their first day and anything that
+// differs from the requisition. Nil or empty fields fall back to it.
+type Offer struct {
+	StartDate    Date           `json:"startDate"`
+	JobTitle     string         `json:"jobTitle,omitempty"`
+	DepartmentID *int           `json:"departmentId,omitempty"`
+	LocationID   *int           `json:"locationId,omitempty"`
+	ManagerID    *int           `json:"managerId,omitempty"`
+	ContractType string         `json:"contractType,omitempty"`
+	CustomFields map[string]any `json:"customFields,omitempty"`
+}
@@ -0,0 +1,137 @@
+package models
+
+import "time"
+
+type ReviewCycleStatus string
+
+// A cycle is set up as a draft, opened to collect feedback, closed for HR
+// to calibrate and finally released to the people reviewed.
+const (
+	ReviewCycleDraft       ReviewCycleStatus = "draft"
+	ReviewCycleOpen        ReviewCycleStatus = "open"
+	ReviewCycleCalibration ReviewCycleStatus = "calibration"
+	ReviewCycleReleased    ReviewCycleStatus = "released"
+)
+
+type ReviewKind string
+
+const (
+	ReviewKindSelf    ReviewKind = "self"
+	ReviewKindManager ReviewKind = "manager"
+	ReviewKindPeer    ReviewKind = "peer"
+)
+
+type QuestionType string
+
+const (
+	QuestionTypeRating QuestionType = "rating"
+	QuestionTypeText   QuestionType = "text"
+)
+
+// ReviewQuestion is asked of every reviewer whose kind is in Kinds, or of
+// every reviewer when Kinds is empty. Rating questions are answered on the
+// cycle's rating scale.
+type ReviewQuestion struct {
+	Key      string       `json:"key"`
+	Text     string       `json:"text"`
+	Type     QuestionType `json:"type"`
+	Required bool         `json:"required,omitempty"`
+	Kinds    []ReviewKind `json:"kinds,omitempty"`
+}
+
+// ReviewTemplate is a reusable set of questions with the rating scale they
+// are answered on, 1 to RatingScale. RatingLabels, when set, names each
+// point of the scale from the lowest up.
+type ReviewTemplate struct {
+	ID           int              `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name         string           `json:"name" gorm:"not null"`
+	Questions    []ReviewQuestion `json:"questions" gorm:"serializer:json"`
+	RatingScale  int              `json:"ratingScale" gorm:"not null"`
+	RatingLabels []string         `json:"ratingLabels,omitempty" gorm:"serializer:json"`
+	CreatedAt    time.Time        `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time        `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ReviewCycle is one round of reviews. The template's questions and scale
+// are copied in when the cycle is created, so later template edits do not
+// change a cycle in progress. It covers the active employees of
+// DepartmentID, or of every department when nil. Each is reviewed by
+// themselves, their manager and up to PeerCount colleagues who share that
+// manager. Reviewers are reminded ReminderDays before DueDate.
+type ReviewCycle struct {
+	ID           int               `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name         string            `json:"name" gorm:"not null"`
+	TemplateID   int               `json:"templateId"`
+	Questions    []ReviewQuestion  `json:"questions" gorm:"serializer:json"`
+	RatingScale  int               `json:"ratingScale" gorm:"not null"`
+	RatingLabels []string          `json:"ratingLabels,omitempty" gorm:"serializer:json"`
+	DepartmentID *int              `json:"departmentId,omitempty"`
+	PeerCount    int               `json:"peerCount"`
+	DueDate      Date              `json:"dueDate" gorm:"not null"`
+	ReminderDays int               `json:"reminderDays"`
+	Status       ReviewCycleStatus `json:"status" gorm:"index;not null;default:draft"`
+	ReleasedAt   *time.Time        `json:"releasedAt,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time         `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ReviewAnswer answers the question with Key: Rating for rating questions,
+// Text for text ones.
+type ReviewAnswer struct {
+	Key    string `json:"key"`
+	Rating *int   `json:"rating,omitempty"`
+	Text   string `json:"text,omitempty"`
+}
+
+// Review is the feedback one employee gives another, or themselves, in a
+// cycle. Rating is the reviewer's overall rating on the cycle's scale.
+// AnsweredByAdminID records who last saved the answers on the reviewer's
+// behalf.
+type Review struct {
+	ID                int            `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	CycleID           int            `json:"cycleId" gorm:"uniqueIndex:idx_review_assignment;not null"`
+	SubjectID         int            `json:"subjectId" gorm:"uniqueIndex:idx_review_assignment;index;not null"`
+	ReviewerID        int            `json:"reviewerId" gorm:"uniqueIndex:idx_review_assignment;index;not null"`
+	Kind              ReviewKind     `json:"kind" gorm:"uniqueIndex:idx_review_assignment;not null"`
+	Answers           []ReviewAnswer `json:"answers,omitempty" gorm:"serializer:json"`
+	Rating            *int           `json:"rating,omitempty"`
+	SubmittedAt       *time.Time     `json:"submittedAt,omitempty"`
+	RemindedOn        *Date          `json:"remindedOn,omitempty"`
+	AnsweredByAdminID *int           `json:"answeredByAdminId,omitempty"`
+	CreatedAt         time.Time      `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time      `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// ReviewFilter narrows ListReviews. Nil fields are not filtered on.
+type ReviewFilter struct {
+	CycleID    *int
+	SubjectID  *int
+	ReviewerID *int
+}
+
+// ReviewCalibration is the final rating HR settles on for an employee in a
+// cycle.
+type ReviewCalibration struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	CycleID    int       `json:"cycleId" gorm:"uniqueIndex:idx_review_calibration;not null"`
+	EmployeeID int       `json:"employeeId" gorm:"uniqueIndex:idx_review_calibration;not null"`
+	Rating     int       `json:"rating" gorm:"not null"`
+	Note       string    `json:"note,omitempty"`
+	AdminID    *int      `json:"adminId,omitempty"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// CalibrationRow summarises the ratings one employee received in a cycle.
+// PeerRating is the mean of the submitted peer ratings.
+type CalibrationRow struct {
+	EmployeeID    int      `json:"employeeId"`
+	Name          string   `json:"name"`
+	DepartmentID  *int     `json:"departmentId,omitempty"`
+	SelfRating    *int     `json:"selfRating,omitempty"`
+	ManagerRating *int     `json:"managerRating,omitempty"`
+	PeerRating    *float64 `json:"peerRating,omitempty"`
+	PeerCount     int      `json:"peerCount"`
+	FinalRating   *int     `json:"finalRating,omitempty"`
+	Note          string   `json:"note,omitempty"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"html"
+	"strings"
+)
+
+// HighlightStart and HighlightStop delimit a matched fragment of a value
+// before it is escaped. They are control characters, so they cannot be
+// confused with markup, and are stripped from values before matching.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+var (
+	highlightTags   = strings.NewReplacer(HighlightStart, "<mark>", HighlightStop, "</mark>")
+	highlightDelims = strings.NewReplacer(HighlightStart, "", HighlightStop, "")
+)
+
+// EmployeeSearchResult is a single ranked hit returned by an employee search.
+// Highlights maps a JSON field name (firstName, lastName, email, address) to
+// its HTML-escaped value with the matched fragments wrapped in <mark></mark>.
+type EmployeeSearchResult struct {
+	Employee   Employee          `json:"employee"`
+	Rank       float64           `json:"rank"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+// StripHighlightDelimiters removes any HighlightStart and HighlightStop from
+// value so that only the matcher can add them.
+func StripHighlightDelimiters(value string) string {
+	return highlightDelims.Replace(value)
+}
+
+// MarkHighlights HTML-escapes a value whose matched fragments are delimited
+// by HighlightStart and HighlightStop and turns the delimiters into <mark>
+// tags. It returns false when nothing in the value matched.
+func MarkHighlights(delimited string) (string, bool) {
+	if !strings.Contains(delimited, HighlightStart) {
+		return "", false
+	}
+	return highlightTags.Replace(html.EscapeString(delimited)), true
+}
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// Proficiency levels run from MinProficiency (aware) to MaxProficiency
+// (expert).
+const (
+	MinProficiency = 1
+	MaxProficiency = 5
+)
+
+// Skill is an entry in the skills taxonomy. Skills nest under a parent,
+// such as "Go" under "Programming languages".
+type Skill struct {
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name        string    `json:"name" gorm:"uniqueIndex;not null"`
+	Description string    `json:"description,omitempty"`
+	ParentID    *int      `json:"parentId,omitempty" gorm:"index"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// EmployeeSkill is an employee's proficiency in a skill.
+type EmployeeSkill struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID int       `json:"employeeId" gorm:"not null;uniqueIndex:idx_employee_skill"`
+	SkillID    int       `json:"skillId" gorm:"not null;uniqueIndex:idx_employee_skill;index"`
+	Level      int       `json:"level" gorm:"not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// SkillRequirement asks for at least MinLevel in a skill.
+type SkillRequirement struct {
+	SkillID  int
+	MinLevel int
+}
+
+// Certification is a qualification an employee holds. DocumentID points at
+// the uploaded evidence. AlertedOn records when the expiry alert went out
+// and is cleared whenever the expiry date changes.
+type Certification struct {
+	ID           int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID   int       `json:"employeeId" gorm:"index;not null"`
+	Name         string    `json:"name" gorm:"not null"`
+	Issuer       string    `json:"issuer,omitempty"`
+	CredentialID string    `json:"credentialId,omitempty"`
+	IssuedOn     Date      `json:"issuedOn" gorm:"not null"`
+	ExpiresOn    *Date     `json:"expiresOn,omitempty" gorm:"index"`
+	DocumentID   *int      `json:"documentId,omitempty"`
+	AlertedOn    *Date     `json:"alertedOn,omitempty"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// Team is a standing group of people, such as a squad within a department.
+type Team struct {
+	ID           int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Name         string    `json:"name" gorm:"uniqueIndex;not null"`
+	Description  string    `json:"description,omitempty"`
+	DepartmentID *int      `json:"departmentId,omitempty" gorm:"index"`
+	LeadID       *int      `json:"leadId,omitempty"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// Assignment places an employee on a team or a project, with exactly one
+// of TeamID and ProjectID set. Allocation is the percentage of their time
+// it takes from StartDate to EndDate inclusive, or indefinitely when
+// EndDate is nil.
+type Assignment struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID int       `json:"employeeId" gorm:"index;not null"`
+	TeamID     *int      `json:"teamId,omitempty" gorm:"index"`
+	ProjectID  *int      `json:"projectId,omitempty" gorm:"index"`
+	Role       string    `json:"role,omitempty"`
+	Allocation int       `json:"allocation" gorm:"not null"`
+	StartDate  Date      `json:"startDate" gorm:"not null"`
+	EndDate    *Date     `json:"endDate,omitempty"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AssignmentFilter narrows ListAssignments. Nil fields are not filtered
+// on; From and To, when set, select assignments overlapping that range.
+type AssignmentFilter struct {
+	EmployeeIDs []int
+	TeamID      *int
+	ProjectID   *int
+	From        *Date
+	To          *Date
+}
+
+// Overallocation is a stretch of days from From to To inclusive on which
+// an employee's assignments add up to more than 100%.
+type Overallocation struct {
+	EmployeeID    int   `json:"employeeId"`
+	From          Date  `json:"from"`
+	To            *Date `json:"to,omitempty"`
+	Allocation    int   `json:"allocation"`
+	AssignmentIDs []int `json:"assignmentIds"`
+}
+
+// WeeklyCapacity is how much of an employee's week starting on Monday
+// WeekStart is allocated, averaged over its weekdays, and how much is left.
+type WeeklyCapacity struct {
+	EmployeeID    int     `json:"employeeId"`
+	WeekStart     Date    `json:"weekStart"`
+	Allocated     float64 `json:"allocated"`
+	Available     float64 `json:"available"`
+	Overallocated bool    `json:"overallocated"`
+}
@@ -0,0 +1,97 @@
+package models
+
+import "time"
+
+type Project struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	Code      string    `json:"code" gorm:"uniqueIndex;not null"`
+	Name      string    `json:"name" gorm:"not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+const (
+	TimeEntrySourceClock  = "clock"
+	TimeEntrySourceManual = "manual"
+)
+
+// TimeEntry is time worked on Date, optionally against a project. Clock
+// entries are open (ClockOut nil, Minutes zero) until the employee clocks
+// out; manual entries only carry Minutes.
+type TimeEntry struct {
+	ID         int        `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID int        `json:"employeeId" gorm:"index:idx_time_entry_employee_date;not null"`
+	ProjectID  *int       `json:"projectId,omitempty" gorm:"index"`
+	Date       Date       `json:"date" gorm:"index:idx_time_entry_employee_date;not null"`
+	ClockIn    *time.Time `json:"clockIn,omitempty"`
+	ClockOut   *time.Time `json:"clockOut,omitempty"`
+	Minutes    int        `json:"minutes"`
+	Source     string     `json:"source" gorm:"not null"`
+	Note       string     `json:"note,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// TimeEntryFilter narrows ListTimeEntries. Nil ids are not filtered on;
+// From and To are inclusive.
+type TimeEntryFilter struct {
+	EmployeeID *int
+	ProjectID  *int
+	From       Date
+	To         Date
+}
+
+type TimesheetStatus string
+
+const (
+	TimesheetStatusDraft     TimesheetStatus = "draft"
+	TimesheetStatusSubmitted TimesheetStatus = "submitted"
+	TimesheetStatusApproved  TimesheetStatus = "approved"
+	TimesheetStatusRejected  TimesheetStatus = "rejected"
+)
+
+// Timesheet tracks the submission and approval of one employee's entries
+// for the week starting on Monday WeekStart. Entries in a submitted or
+// approved week cannot be changed.
+type Timesheet struct {
+	ID               int             `json:"id" gorm:"primaryKey;autoIncrement:true"`
+	EmployeeID       int             `json:"employeeId" gorm:"uniqueIndex:idx_timesheet_employee_week;not null"`
+	WeekStart        Date            `json:"weekStart" gorm:"uniqueIndex:idx_timesheet_employee_week;not null"`
+	Status           TimesheetStatus `json:"status" gorm:"index;not null"`
+	SubmittedAt      *time.Time      `json:"submittedAt,omitempty"`
+	DecidedAt        *time.Time      `json:"decidedAt,omitempty"`
+	DecidedByAdminID *int            `json:"decidedByAdminId,omitempty"`
+	DecisionNote     string          `json:"decisionNote,omitempty"`
+	CreatedAt        time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time       `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// OvertimePolicy decides which hours are overtime. Time beyond
+// DailyThresholdMinutes in a day, then beyond WeeklyThresholdMinutes of the
+// remaining regular time in a week, is overtime; a zero threshold disables
+// that check. Weekend work is paid at WeekendMultiplier instead when it is
+// set.
+type OvertimePolicy struct {
+	ID                     int       `json:"id" gorm:"primaryKey"`
+	DailyThresholdMinutes  int       `json:"dailyThresholdMinutes"`
+	WeeklyThresholdMinutes int       `json:"weeklyThresholdMinutes"`
+	OvertimeMultiplier     float64   `json:"overtimeMultiplier"`
+	WeekendMultiplier      float64   `json:"weekendMultiplier"`
+	UpdatedAt              time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// TimeSummary totals hours for an employee, a project or a pay period.
+// PayableHours weights overtime and weekend hours by their multipliers.
+type TimeSummary struct {
+	EmployeeID    *int            `json:"employeeId,omitempty"`
+	ProjectID     *int            `json:"projectId,omitempty"`
+	From          Date            `json:"from"`
+	To            Date            `json:"to"`
+	TotalHours    float64         `json:"totalHours"`
+	RegularHours  float64         `json:"regularHours"`
+	OvertimeHours float64         `json:"overtimeHours"`
+	WeekendHours  float64         `json:"weekendHours"`
+	PayableHours  float64         `json:"payableHours"`
+	ByProject     map[int]float64 `json:"byProject,omitempty"`
+	ByEmployee    map[int]float64 `json:"byEmployee,omitempty"`
+}
@@ -0,0 +1,141 @@
+// Package okr validates objectives and key results and rolls their
+// progress up the alignment tree.
+package okr
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid objective")
+
+var quarterPattern = regexp.MustCompile(`^\d{4}-Q[1-4]$`)
+
+// ValidQuarter reports whether quarter is written as "2025-Q3".
+func ValidQuarter(quarter string) bool {
+	return quarterPattern.MatchString(quarter)
+}
+
+// ValidateObjective trims the objective's text and checks that it has a
+// title, a quarter and exactly one owner, along with its key results.
+func ValidateObjective(obj *models.Objective) error {
+	obj.Title = strings.TrimSpace(obj.Title)
+	obj.Quarter = strings.ToUpper(strings.TrimSpace(obj.Quarter))
+	switch {
+	case obj.Title == "":
+		return fmt.Errorf("%w: title is required", ErrInvalid)
+	case !ValidQuarter(obj.Quarter):
+		return fmt.Errorf("%w: quarter must look like 2025-Q3", ErrInvalid)
+	case owners(obj) != 1:
+		return fmt.Errorf("%w: set exactly one of employeeId, teamId and departmentId", ErrInvalid)
+	case obj.ParentID != nil && *obj.ParentID == obj.ID:
+		return fmt.Errorf("%w: an objective cannot be aligned to itself", ErrInvalid)
+	}
+	for i := range obj.KeyResults {
+		if err := ValidateKeyResult(&obj.KeyResults[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func owners(obj *models.Objective) int {
+	n := 0
+	for _, id := range []*int{obj.EmployeeID, obj.TeamID, obj.DepartmentID} {
+		if id != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// ValidateKeyResult checks a key result, defaulting its weight to 1 and
+// its current value to the start value when neither is given.
+func ValidateKeyResult(kr *models.KeyResult) error {
+	kr.Title = strings.TrimSpace(kr.Title)
+	if kr.Weight == 0 {
+		kr.Weight = 1
+	}
+	if kr.ID == 0 && kr.CurrentValue == 0 {
+		kr.CurrentValue = kr.StartValue
+	}
+	switch {
+	case kr.Title == "":
+		return fmt.Errorf("%w: key result title is required", ErrInvalid)
+	case kr.TargetValue == kr.StartValue:
+		return fmt.Errorf("%w: key result %q needs a target different from its start", ErrInvalid, kr.Title)
+	case kr.Weight < 0:
+		return fmt.Errorf("%w: key result %q weight cannot be negative", ErrInvalid, kr.Title)
+	}
+	return nil
+}
+
+// KeyResultProgress is how far the key result has moved from its start to
+// its target, between 0 and 1.
+func KeyResultProgress(kr models.KeyResult) float64 {
+	if kr.TargetValue == kr.StartValue {
+		return 0
+	}
+	p := (kr.CurrentValue - kr.StartValue) / (kr.TargetValue - kr.StartValue)
+	return math.Max(0, math.Min(1, p))
+}
+
+// Rollup computes the progress of objective rootID from objectives, which
+// must hold it and everything aligned below it. An objective's progress is
+// the weighted mean of its key results together with its aligned
+// objectives, each of which counts with weight 1. An objective with
+// neither has made no progress.
+func Rollup(objectives []models.Objective, rootID int) (models.ObjectiveProgress, error) {
+	byID := map[int]models.Objective{}
+	children := map[int][]int{}
+	for _, obj := range objectives {
+		byID[obj.ID] = obj
+		if obj.ParentID != nil {
+			children[*obj.ParentID] = append(children[*obj.ParentID], obj.ID)
+		}
+	}
+	if _, ok := byID[rootID]; !ok {
+		return models.ObjectiveProgress{}, fmt.Errorf("objective %d not found", rootID)
+	}
+
+	visiting := map[int]bool{}
+	var rollup func(id int) (models.ObjectiveProgress, error)
+	rollup = func(id int) (models.ObjectiveProgress, error) {
+		if visiting[id] {
+			return models.ObjectiveProgress{}, fmt.Errorf("%w: objective %d is aligned to itself", ErrInvalid, id)
+		}
+		visiting[id] = true
+		defer delete(visiting, id)
+
+		obj := byID[id]
+		p := models.ObjectiveProgress{ObjectiveID: obj.ID, Title: obj.Title, Quarter: obj.Quarter}
+		var sum, weight float64
+		for _, kr := range obj.KeyResults {
+			progress := KeyResultProgress(kr)
+			p.KeyResults = append(p.KeyResults, models.KeyResultProgress{KeyResultID: kr.ID, Title: kr.Title, Progress: progress})
+			sum += progress * kr.Weight
+			weight += kr.Weight
+		}
+		ids := children[id]
+		sort.Ints(ids)
+		for _, childID := range ids {
+			child, err := rollup(childID)
+			if err != nil {
+				return p, err
+			}
+			p.Children = append(p.Children, child)
+			sum += child.Progress
+			weight++
+		}
+		if weight > 0 {
+			p.Progress = sum / weight
+		}
+		return p, nil
+	}
+	return rollup(rootID)
+}
@@ -0,0 +1,65 @@
+package okr
+
+import (
+	"employees/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestValidateObjective(t *testing.T) {
+	obj := models.Objective{Title: " Ship v2 ", Quarter: "2025-q3", EmployeeID: intPtr(3),
+		KeyResults: []models.KeyResult{{Title: "Beta customers", StartValue: 0, TargetValue: 10}}}
+	require.NoError(t, ValidateObjective(&obj))
+	assert.Equal(t, "Ship v2", obj.Title)
+	assert.Equal(t, "2025-Q3", obj.Quarter)
+	assert.Equal(t, 1.0, obj.KeyResults[0].Weight)
+
+	tests := map[string]models.Objective{
+		"Bad Quarter":     {Title: "Grow", Quarter: "Q3 2025", EmployeeID: intPtr(3)},
+		"No Owner":        {Title: "Grow", Quarter: "2025-Q3"},
+		"Two Owners":      {Title: "Grow", Quarter: "2025-Q3", EmployeeID: intPtr(3), DepartmentID: intPtr(1)},
+		"Aligned To Self": {ID: 4, Title: "Grow", Quarter: "2025-Q3", EmployeeID: intPtr(3), ParentID: intPtr(4)},
+		"Flat Key Result": {Title: "Grow", Quarter: "2025-Q3", EmployeeID: intPtr(3),
+			KeyResults: []models.KeyResult{{Title: "Revenue", StartValue: 5, TargetValue: 5}}},
+	}
+	for name, obj := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateObjective(&obj), ErrInvalid)
+		})
+	}
+}
+
+func TestKeyResultProgress(t *testing.T) {
+	assert.Equal(t, 0.5, KeyResultProgress(models.KeyResult{StartValue: 0, TargetValue: 10, CurrentValue: 5}))
+	assert.Equal(t, 0.25, KeyResultProgress(models.KeyResult{StartValue: 100, TargetValue: 60, CurrentValue: 90}))
+	assert.Equal(t, 1.0, KeyResultProgress(models.KeyResult{StartValue: 0, TargetValue: 10, CurrentValue: 12}))
+	assert.Equal(t, 0.0, KeyResultProgress(models.KeyResult{StartValue: 0, TargetValue: 10, CurrentValue: -3}))
+}
+
+func TestRollup(t *testing.T) {
+	objectives := []models.Objective{
+		{ID: 1, Title: "Company", KeyResults: []models.KeyResult{
+			{ID: 10, StartValue: 0, TargetValue: 100, CurrentValue: 50, Weight: 2},
+		}},
+		{ID: 2, Title: "Team", ParentID: intPtr(1), KeyResults: []models.KeyResult{
+			{ID: 20, StartValue: 0, TargetValue: 4, CurrentValue: 4, Weight: 1},
+			{ID: 21, StartValue: 0, TargetValue: 4, CurrentValue: 0, Weight: 1},
+		}},
+		{ID: 3, Title: "Person", ParentID: intPtr(2)},
+	}
+
+	p, err := Rollup(objectives, 1)
+	require.NoError(t, err)
+	// Team: (1 + 0 + person 0) / 3; company: (0.5*2 + team) / 3.
+	require.Len(t, p.Children, 1)
+	assert.InDelta(t, 1.0/3, p.Children[0].Progress, 1e-9)
+	assert.InDelta(t, (1+1.0/3)/3, p.Progress, 1e-9)
+	assert.Len(t, p.KeyResults, 1)
+
+	_, err = Rollup(objectives, 9)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+// Package orgchart turns the manager references on employees into a tree and
+// renders it as Graphviz DOT, SVG or nested JSON.
+package orgchart
+
+import (
+	"employees/internal/models"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Node is one employee in the chart. The JSON shape (id, name, children)
+// is what most front-end tree and org chart libraries consume directly.
+type Node struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Email        string  `json:"email"`
+	DepartmentID *int    `json:"departmentId,omitempty"`
+	Children     []*Node `json:"children"`
+}
+
+// Build arranges employees into a forest. An employee becomes a root when
+// their manager is not among emps, or when rootID is set and matches them.
+// maxDepth limits how many levels below the roots are kept; zero means no
+// limit. Employees caught in a reporting cycle are promoted to roots so that
+// nobody silently disappears from the chart.
+func Build(emps []models.Employee, rootID *int, maxDepth int) []*Node {
+	nodes := make(map[int]*Node, len(emps))
+	for _, emp := range emps {
+		nodes[emp.ID] = &Node{
+			ID:           emp.ID,
+			Name:         strings.TrimSpace(emp.FirstName + " " + emp.LastName),
+			Email:        emp.Email,
+			DepartmentID: emp.DepartmentID,
+			Children:     []*Node{},
+		}
+	}
+
+	children := map[int][]int{}
+	var roots []int
+	for _, emp := range emps {
+		isRoot := rootID != nil && emp.ID == *rootID
+		if !isRoot && emp.ManagerID != nil {
+			if _, ok := nodes[*emp.ManagerID]; ok {
+				children[*emp.ManagerID] = append(children[*emp.ManagerID], emp.ID)
+				continue
+			}
+		}
+		if rootID == nil || isRoot {
+			roots = append(roots, emp.ID)
+		}
+	}
+
+	// Reports cut off by maxDepth are still marked visited so that they are
+	// not mistaken for cycle members below.
+	visited := map[int]bool{}
+	var attach func(id, depth int, keep bool)
+	attach = func(id, depth int, keep bool) {
+		visited[id] = true
+		keepKids := keep && (maxDepth == 0 || depth < maxDepth)
+		kids := children[id]
+		sort.Ints(kids)
+		for _, kid := range kids {
+			if visited[kid] {
+				continue
+			}
+			if keepKids {
+				nodes[id].Children = append(nodes[id].Children, nodes[kid])
+			}
+			attach(kid, depth+1, keepKids)
+		}
+	}
+
+	sort.Ints(roots)
+	forest := []*Node{}
+	for _, id := range roots {
+		attach(id, 0, true)
+		forest = append(forest, nodes[id])
+	}
+
+	if rootID == nil {
+		ids := make([]int, 0, len(nodes))
+		for id := range nodes {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			if !visited[id] {
+				attach(id, 0, true)
+				forest = append(forest, nodes[id])
+			}
+		}
+	}
+	return forest
+}
+
+// Filter keeps the employees for which keep returns true. Each one's
+// ManagerID is pointed at their nearest kept manager, so reports of a
+// manager who is left out stay in the chart under the next one up; with no
+// kept manager above them it is cleared.
+func Filter(emps []models.Employee, keep func(models.Employee) bool) []models.Employee {
+	byID := make(map[int]models.Employee, len(emps))
+	for _, emp := range emps {
+		byID[emp.ID] = emp
+	}
+
+	var kept []models.Employee
+	for _, emp := range emps {
+		if !keep(emp) {
+			continue
+		}
+		seen := map[int]bool{emp.ID: true}
+		managerID := emp.ManagerID
+		for managerID != nil {
+			manager, ok := byID[*managerID]
+			if !ok || seen[manager.ID] {
+				managerID = nil
+				break
+			}
+			if keep(manager) {
+				break
+			}
+			seen[manager.ID] = true
+			managerID = manager.ManagerID
+		}
+		emp.ManagerID = managerID
+		kept = append(kept, emp)
+	}
+	return kept
+}
+
+// DOT renders the forest as a Graphviz digraph with edges from manager to
+// report.
+func DOT(forest []*Node) string {
+	var b strings.Builder
+	b.WriteString("digraph orgchart {\n")
+	b.WriteString("\trankdir=TB;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		fmt.Fprintf(&b, "\t\"%d\" [label=\"%s\\n%s\"];\n", n.ID, dotEscape(n.Name), dotEscape(n.Email))
+		for _, c := range n.Children {
+			fmt.Fprintf(&b, "\t\"%d\" -> \"%d\";\n", n.ID, c.ID)
+			walk(c)
+		}
+	}
+	for _, root := range forest {
+		walk(root)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
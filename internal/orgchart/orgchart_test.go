@@ -0,0 +1,100 @@
+package orgchart
+
+import (
+	"employees/internal/models"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+var staff = []models.Employee{
+	{ID: 1, FirstName: "Ada", LastName: "Boss", Email: "ada@example.com"},
+	{ID: 2, FirstName: "Bob", LastName: "Lead", Email: "bob@example.com", ManagerID: intPtr(1)},
+	{ID: 3, FirstName: "Cy", LastName: "Dev", Email: "cy@example.com", ManagerID: intPtr(2)},
+	{ID: 4, FirstName: "Di", LastName: "Ops", Email: "di@example.com", ManagerID: intPtr(1)},
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("Full Tree", func(t *testing.T) {
+		forest := Build(staff, nil, 0)
+		require.Len(t, forest, 1)
+		assert.Equal(t, "Ada Boss", forest[0].Name)
+		require.Len(t, forest[0].Children, 2)
+		assert.Equal(t, 3, forest[0].Children[0].Children[0].ID)
+	})
+
+	t.Run("Max Depth", func(t *testing.T) {
+		forest := Build(staff, nil, 1)
+		require.Len(t, forest, 1)
+		assert.Len(t, forest[0].Children, 2)
+		assert.Empty(t, forest[0].Children[0].Children)
+	})
+
+	t.Run("Root Employee", func(t *testing.T) {
+		forest := Build(staff, intPtr(2), 0)
+		require.Len(t, forest, 1)
+		assert.Equal(t, 2, forest[0].ID)
+		assert.Len(t, forest[0].Children, 1)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		cyclic := []models.Employee{
+			{ID: 1, ManagerID: intPtr(2)},
+			{ID: 2, ManagerID: intPtr(1)},
+		}
+		forest := Build(cyclic, nil, 0)
+		require.Len(t, forest, 1)
+		assert.Equal(t, 1, forest[0].ID)
+		assert.Len(t, forest[0].Children, 1)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	emps := []models.Employee{
+		{ID: 1, DepartmentID: intPtr(1)},
+		{ID: 2, DepartmentID: intPtr(2), ManagerID: intPtr(1)},
+		{ID: 3, DepartmentID: intPtr(1), ManagerID: intPtr(2)},
+		{ID: 4, DepartmentID: intPtr(2), ManagerID: intPtr(3)},
+		{ID: 5, DepartmentID: intPtr(1), ManagerID: intPtr(4)},
+		{ID: 6, DepartmentID: intPtr(1), ManagerID: intPtr(9)},
+	}
+
+	kept := Filter(emps, func(emp models.Employee) bool { return *emp.DepartmentID == 1 })
+
+	require.Len(t, kept, 4)
+	managers := map[int]*int{}
+	for _, emp := range kept {
+		managers[emp.ID] = emp.ManagerID
+	}
+	assert.Nil(t, managers[1])
+	assert.Equal(t, intPtr(1), managers[3])
+	assert.Equal(t, intPtr(3), managers[5])
+	assert.Nil(t, managers[6])
+	assert.Equal(t, intPtr(2), emps[2].ManagerID, "input is left alone")
+
+	forest := Build(kept, intPtr(1), 0)
+	require.Len(t, forest, 1)
+	require.Len(t, forest[0].Children, 1)
+	assert.Equal(t, 3, forest[0].Children[0].ID)
+	assert.Equal(t, 5, forest[0].Children[0].Children[0].ID)
+}
+
+func TestDOT(t *testing.T) {
+	dot := DOT(Build(staff[:2], nil, 0))
+	assert.True(t, strings.HasPrefix(dot, "digraph orgchart {"))
+	assert.Contains(t, dot, `"1" [label="Ada Boss\nada@example.com"];`)
+	assert.Contains(t, dot, `"1" -> "2";`)
+}
+
+func TestSVG(t *testing.T) {
+	svg := SVG(Build([]models.Employee{{ID: 1, FirstName: "A&B", Email: "<x>"}}, nil, 0))
+	assert.Contains(t, svg, `<g id="employee-1">`)
+	assert.Contains(t, svg, "A&amp;B")
+	assert.Contains(t, svg, "&lt;x&gt;")
+}
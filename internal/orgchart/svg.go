@@ -0,0 +1,86 @@
+package orgchart
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const (
+	boxWidth  = 180
+	boxHeight = 50
+	hGap      = 20
+	vGap      = 60
+	margin    = 20
+)
+
+type position struct {
+	x, y float64
+}
+
+// SVG lays the forest out top-down and renders it as a standalone SVG
+// document. Leaves take consecutive columns and every manager is centred
+// above their reports, which keeps subtrees from overlapping.
+func SVG(forest []*Node) string {
+	positions := map[*Node]position{}
+	column := 0
+	maxDepth := 0
+
+	var layout func(n *Node, depth int) float64
+	layout = func(n *Node, depth int) float64 {
+		maxDepth = max(maxDepth, depth)
+		y := float64(margin + depth*(boxHeight+vGap))
+		if len(n.Children) == 0 {
+			x := float64(margin + column*(boxWidth+hGap))
+			column++
+			positions[n] = position{x, y}
+			return x
+		}
+		first := layout(n.Children[0], depth+1)
+		last := first
+		for _, c := range n.Children[1:] {
+			last = layout(c, depth+1)
+		}
+		x := (first + last) / 2
+		positions[n] = position{x, y}
+		return x
+	}
+	for _, root := range forest {
+		layout(root, 0)
+	}
+
+	width := 2*margin + max(column, 1)*(boxWidth+hGap) - hGap
+	height := 2*margin + (maxDepth+1)*(boxHeight+vGap) - vGap
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif">`+"\n",
+		width, height, width, height)
+
+	var draw func(n *Node)
+	draw = func(n *Node) {
+		p := positions[n]
+		for _, c := range n.Children {
+			cp := positions[c]
+			midY := p.y + boxHeight + vGap/2
+			fmt.Fprintf(&b, `<path d="M%.1f %.1f V%.1f H%.1f V%.1f" fill="none" stroke="#888"/>`+"\n",
+				p.x+boxWidth/2, p.y+boxHeight, midY, cp.x+boxWidth/2, cp.y)
+		}
+		fmt.Fprintf(&b, `<g id="employee-%d">`+"\n", n.ID)
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%d" height="%d" rx="6" fill="#fff" stroke="#333"/>`+"\n",
+			p.x, p.y, boxWidth, boxHeight)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle" font-size="13">%s</text>`+"\n",
+			p.x+boxWidth/2, p.y+21, html.EscapeString(n.Name))
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle" font-size="10" fill="#555">%s</text>`+"\n",
+			p.x+boxWidth/2, p.y+38, html.EscapeString(n.Email))
+		b.WriteString("</g>\n")
+		for _, c := range n.Children {
+			draw(c)
+		}
+	}
+	for _, root := range forest {
+		draw(root)
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// Package payroll calculates gross-to-net pay from compensation records
+// and configurable deduction rules, and renders payslips.
+package payroll
+
+import (
+	"employees/internal/compensation"
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidPeriod    = errors.New("periodEnd must not be before periodStart")
+	ErrInvalidFrequency = errors.New("payFrequency must be weekly, biweekly, monthly or annual")
+	ErrCurrencyMismatch = errors.New("compensation currency does not match the payroll run")
+)
+
+// ValidateRun checks the parameters of a payroll run before calculating it.
+func ValidateRun(run models.PayrollRun) error {
+	if run.PeriodStart.IsZero() || run.PeriodEnd.Before(run.PeriodStart.Time) {
+		return ErrInvalidPeriod
+	}
+	if run.PayFrequency == models.PayFrequencyHourly || compensation.PeriodsPerYear(run.PayFrequency) == 0 {
+		return ErrInvalidFrequency
+	}
+	if !compensation.ValidCurrency(run.Currency) {
+		return compensation.ErrInvalidCurrency
+	}
+	return nil
+}
+
+// Calculate works out the employee's payslip for the run's period. Base pay
+// is the compensation record in effect at the end of the period converted
+// to the run's pay frequency, with no proration for mid-period changes;
+// bonuses awarded within the period are added on top. Rules then deduct in
+// order.
+func Calculate(emp models.Employee, comp models.Compensation, bonuses []models.Bonus,
+	run models.PayrollRun, rules *RuleSet) (models.Payslip, error) {
+	if comp.Currency != run.Currency {
+		return models.Payslip{}, fmt.Errorf("employee %d: %w", emp.ID, ErrCurrencyMismatch)
+	}
+
+	slip := models.Payslip{
+		RunID:        run.ID,
+		EmployeeID:   emp.ID,
+		EmployeeName: strings.TrimSpace(emp.FirstName + " " + emp.LastName),
+		Currency:     run.Currency,
+		BasePay:      compensation.PerPeriod(comp, run.PayFrequency),
+		Deductions:   []models.PayslipLine{},
+	}
+	for _, b := range bonuses {
+		if b.AwardedOn.Before(run.PeriodStart.Time) || b.AwardedOn.After(run.PeriodEnd.Time) {
+			continue
+		}
+		if b.Currency != run.Currency {
+			return models.Payslip{}, fmt.Errorf("employee %d bonus %d: %w", emp.ID, b.ID, ErrCurrencyMismatch)
+		}
+		slip.Bonus += b.Amount
+	}
+	slip.Gross = slip.BasePay + slip.Bonus
+
+	in := Input{Gross: slip.Gross, Taxable: slip.Gross, PeriodsPerYear: compensation.PeriodsPerYear(run.PayFrequency)}
+	for _, rule := range rules.Rules {
+		amount := rule.Amount(in)
+		if amount == 0 {
+			continue
+		}
+		slip.Deductions = append(slip.Deductions, models.PayslipLine{Name: rule.Name(), Amount: amount, PreTax: rule.PreTax()})
+		slip.TotalDeductions += amount
+		if rule.PreTax() {
+			in.Taxable = max(in.Taxable-amount, 0)
+		}
+	}
+	slip.Taxable = in.Taxable
+	slip.Net = slip.Gross - slip.TotalDeductions
+	return slip, nil
+}
+
+// FormatAmount renders an amount in minor units followed by its currency,
+// e.g. 123456 EUR as "1234.56 EUR".
+func FormatAmount(amount int64, currency string) string {
+	return compensation.FormatAmount(amount, currency) + " " + currency
+}
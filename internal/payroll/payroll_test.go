@@ -0,0 +1,135 @@
+package payroll
+
+import (
+	"bytes"
+	"employees/internal/models"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRun() models.PayrollRun {
+	return models.PayrollRun{
+		ID:           4,
+		PeriodStart:  models.NewDate(2025, time.June, 1),
+		PeriodEnd:    models.NewDate(2025, time.June, 30),
+		PayFrequency: models.PayFrequencyMonthly,
+		Currency:     "EUR",
+		RuleSet:      "progressive",
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	rules, err := LoadRuleSet("testdata/progressive.json")
+	require.NoError(t, err)
+
+	emp := models.Employee{ID: 7, FirstName: "Zoë", LastName: "Müller"}
+	comp := models.Compensation{BasePay: 7200000, Currency: "EUR", PayFrequency: models.PayFrequencyAnnual}
+	bonuses := []models.Bonus{
+		{ID: 1, Amount: 100000, Currency: "EUR", AwardedOn: models.NewDate(2025, time.June, 15)},
+		{ID: 2, Amount: 999999, Currency: "EUR", AwardedOn: models.NewDate(2025, time.July, 1)},
+	}
+
+	slip, err := Calculate(emp, comp, bonuses, testRun(), rules)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Zoë Müller", slip.EmployeeName)
+	assert.Equal(t, int64(600000), slip.BasePay)
+	assert.Equal(t, int64(100000), slip.Bonus)
+	assert.Equal(t, int64(700000), slip.Gross)
+	assert.Equal(t, int64(665000), slip.Taxable)
+	assert.Equal(t, []models.PayslipLine{
+		{Name: "Pension", Amount: 35000, PreTax: true},
+		{Name: "Income tax", Amount: 162667},
+		{Name: "Social security", Amount: 40000},
+		{Name: "Union dues", Amount: 1500},
+	}, slip.Deductions)
+	assert.Equal(t, int64(239167), slip.TotalDeductions)
+	assert.Equal(t, int64(460833), slip.Net)
+}
+
+func TestCalculateCurrencyMismatch(t *testing.T) {
+	rules, err := LoadRuleSet("testdata/flat.json")
+	require.NoError(t, err)
+
+	comp := models.Compensation{BasePay: 500000, Currency: "USD", PayFrequency: models.PayFrequencyMonthly}
+	_, err = Calculate(models.Employee{ID: 1}, comp, nil, testRun(), rules)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+type perHead struct{ amount int64 }
+
+func (p perHead) Name() string       { return "Canteen" }
+func (p perHead) PreTax() bool       { return false }
+func (p perHead) Amount(Input) int64 { return p.amount }
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("canteen", func(config json.RawMessage) (Rule, error) {
+		var c struct {
+			Amount int64 `json:"amount"`
+		}
+		err := json.Unmarshal(config, &c)
+		return perHead{c.Amount}, err
+	})
+
+	rules, err := ParseRuleSet(strings.NewReader(`{"name":"custom","rules":[{"type":"canteen","amount":2500}]}`))
+	require.NoError(t, err)
+
+	comp := models.Compensation{BasePay: 300000, Currency: "EUR", PayFrequency: models.PayFrequencyMonthly}
+	slip, err := Calculate(models.Employee{ID: 1}, comp, nil, testRun(), rules)
+	require.NoError(t, err)
+	assert.Equal(t, int64(297500), slip.Net)
+}
+
+func TestParseRuleSetErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"Missing Name", `{"rules":[]}`},
+		{"Unknown Type", `{"name":"x","rules":[{"type":"lottery","name":"Lottery"}]}`},
+		{"Unbounded Bracket Not Last", `{"name":"x","rules":[{"type":"brackets","name":"Tax","brackets":[{"rate":0.1},{"upTo":100,"rate":0.2}]}]}`},
+		{"Rate Out Of Range", `{"name":"x","rules":[{"type":"percentage","name":"Tax","rate":12}]}`},
+		{"Rule Without Name", `{"name":"x","rules":[{"type":"fixed","amount":1}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRuleSet(strings.NewReader(tt.src))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "1234.56 EUR", FormatAmount(123456, "EUR"))
+	assert.Equal(t, "-0.05 USD", FormatAmount(-5, "USD"))
+	assert.Equal(t, "5000 JPY", FormatAmount(5000, "JPY"))
+	assert.Equal(t, "1.250 KWD", FormatAmount(1250, "KWD"))
+}
+
+func TestPayslipPDF(t *testing.T) {
+	slip := models.Payslip{EmployeeID: 7, EmployeeName: "Zoë (Z) Müller", Currency: "EUR",
+		BasePay: 600000, Gross: 600000, Net: 450000, TotalDeductions: 150000,
+		Deductions: []models.PayslipLine{{Name: "Flat tax", Amount: 150000}}}
+
+	var buf bytes.Buffer
+	require.NoError(t, PayslipPDF(&buf, testRun(), slip))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, `(Zo\353 \(Z\) M\374ller) Tj`)
+	assert.Contains(t, out, "(4500.00 EUR) Tj")
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+	require.NotNil(t, m)
+	offset, _ := strconv.Atoi(m[1])
+	assert.True(t, strings.HasPrefix(out[offset:], "xref\n"))
+}
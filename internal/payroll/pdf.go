@@ -0,0 +1,131 @@
+package payroll
+
+import (
+	"bytes"
+	"employees/internal/models"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// pdfText is one line of text placed at x, y points from the bottom left.
+type pdfText struct {
+	x, y float64
+	font string
+	size float64
+	text string
+}
+
+// PayslipPDF writes the payslip as a single A4 page PDF using the standard
+// Helvetica and Courier fonts, so no font files or PDF library are needed.
+func PayslipPDF(w io.Writer, run models.PayrollRun, slip models.Payslip) error {
+	const left, amountRight = 56.0, 539.0
+	y := 780.0
+	var lines []pdfText
+	text := func(font string, size float64, s string) {
+		lines = append(lines, pdfText{x: left, y: y, font: font, size: size, text: s})
+	}
+	row := func(label string, amount int64, bold bool) {
+		font := "F1"
+		if bold {
+			font = "F2"
+		}
+		lines = append(lines, pdfText{x: left, y: y, font: font, size: 11, text: label})
+		value := FormatAmount(amount, slip.Currency)
+		// Courier glyphs are 0.6em wide, which makes right alignment exact.
+		lines = append(lines, pdfText{x: amountRight - float64(len(value))*0.6*11, y: y, font: "F3", size: 11, text: value})
+		y -= 16
+	}
+
+	text("F2", 18, "Payslip")
+	y -= 28
+	text("F1", 11, slip.EmployeeName)
+	y -= 16
+	text("F1", 11, fmt.Sprintf("Employee #%d", slip.EmployeeID))
+	y -= 16
+	text("F1", 11, fmt.Sprintf("Pay period %s to %s (%s)", run.PeriodStart, run.PeriodEnd, run.PayFrequency))
+	y -= 16
+	text("F1", 11, fmt.Sprintf("Payroll run #%d, rules %s", run.ID, run.RuleSet))
+	y -= 32
+
+	text("F2", 12, "Earnings")
+	y -= 18
+	row("Base pay", slip.BasePay, false)
+	if slip.Bonus != 0 {
+		row("Bonus", slip.Bonus, false)
+	}
+	row("Gross pay", slip.Gross, true)
+	y -= 16
+
+	text("F2", 12, "Deductions")
+	y -= 18
+	for _, d := range slip.Deductions {
+		label := d.Name
+		if d.PreTax {
+			label += " (pre-tax)"
+		}
+		row(label, d.Amount, false)
+	}
+	row("Total deductions", slip.TotalDeductions, true)
+	y -= 16
+
+	row("Net pay", slip.Net, true)
+
+	return writePDF(w, lines)
+}
+
+func writePDF(w io.Writer, lines []pdfText) error {
+	var content bytes.Buffer
+	for _, l := range lines {
+		fmt.Fprintf(&content, "BT /%s %g Tf %.2f %.2f Td (%s) Tj ET\n", l.font, l.size, l.x, l.y, pdfEscape(l.text))
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] " +
+			"/Resources << /Font << /F1 4 0 R /F2 5 0 R /F3 6 0 R >> >> /Contents 7 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// pdfEscape escapes a string for a PDF literal. Latin-1 characters are
+// written as octal codes, which WinAnsiEncoding maps to the same glyphs;
+// anything else becomes '?'.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,255 @@
+package payroll
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+)
+
+// Input is what a rule sees when working out its deduction for one
+// employee and pay period.
+type Input struct {
+	Gross          int64
+	Taxable        int64
+	PeriodsPerYear int64
+}
+
+// Rule computes one deduction. Rules run in rule set order; the amounts of
+// PreTax rules are taken off Taxable before the next rule runs.
+type Rule interface {
+	Name() string
+	PreTax() bool
+	Amount(in Input) int64
+}
+
+// RuleFactory builds a rule from its JSON configuration.
+type RuleFactory func(config json.RawMessage) (Rule, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]RuleFactory{
+		"brackets":   newBrackets,
+		"percentage": newPercentage,
+		"fixed":      newFixed,
+	}
+)
+
+// RegisterRule makes a rule type available to rule set files under kind,
+// replacing any existing registration.
+func RegisterRule(kind string, factory RuleFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[kind] = factory
+}
+
+// RuleSet is a named, ordered list of deduction rules loaded from a file.
+// Source keeps the file contents so a locked run records exactly what it
+// was calculated with.
+type RuleSet struct {
+	Name   string
+	Rules  []Rule
+	Source string
+}
+
+// ParseRuleSet reads a rule set in the form
+//
+//	{"name": "uk-2025", "rules": [
+//	    {"type": "percentage", "name": "Pension", "rate": 0.05, "preTax": true},
+//	    {"type": "brackets", "name": "Income tax", "brackets": [
+//	        {"upTo": 1257000, "rate": 0}, {"upTo": 5027000, "rate": 0.2}, {"rate": 0.4}]},
+//	    {"type": "fixed", "name": "Union dues", "amount": 1500}]}
+//
+// Bracket limits are annual and, like every amount, in minor units.
+func ParseRuleSet(r io.Reader) (*RuleSet, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Name  string            `json:"name"`
+		Rules []json.RawMessage `json:"rules"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(src))
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid rule set: %w", err)
+	}
+	if file.Name == "" {
+		return nil, fmt.Errorf("invalid rule set: name is required")
+	}
+
+	set := &RuleSet{Name: file.Name, Source: string(src)}
+	for i, raw := range file.Rules {
+		var head struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &head); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		factoriesMu.RLock()
+		factory, ok := factories[head.Type]
+		factoriesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("rule %d: unknown type %q", i, head.Type)
+		}
+		rule, err := factory(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		set.Rules = append(set.Rules, rule)
+	}
+	return set, nil
+}
+
+// LoadRuleSet parses the rule set file at path.
+func LoadRuleSet(path string) (*RuleSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseRuleSet(f)
+}
+
+type ruleBase struct {
+	RuleName string `json:"name"`
+	IsPreTax bool   `json:"preTax"`
+}
+
+func (b ruleBase) Name() string { return b.RuleName }
+func (b ruleBase) PreTax() bool { return b.IsPreTax }
+
+func (b ruleBase) validate() error {
+	if b.RuleName == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+// Bracket taxes the part of annual income up to UpTo at Rate. A zero UpTo
+// means no upper limit and must come last.
+type Bracket struct {
+	UpTo int64   `json:"upTo"`
+	Rate float64 `json:"rate"`
+}
+
+// Brackets is progressive tax on annualised taxable pay, spread evenly
+// over the pay periods of the year.
+type Brackets struct {
+	ruleBase
+	Brackets []Bracket `json:"brackets"`
+}
+
+func newBrackets(config json.RawMessage) (Rule, error) {
+	var b Brackets
+	if err := json.Unmarshal(config, &b); err != nil {
+		return nil, err
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	var prev int64
+	for i, br := range b.Brackets {
+		if br.Rate < 0 || br.Rate > 1 {
+			return nil, fmt.Errorf("bracket %d: rate must be between 0 and 1", i)
+		}
+		if br.UpTo == 0 && i != len(b.Brackets)-1 {
+			return nil, fmt.Errorf("bracket %d: only the last bracket may be unbounded", i)
+		}
+		if br.UpTo != 0 && br.UpTo <= prev {
+			return nil, fmt.Errorf("bracket %d: limits must increase", i)
+		}
+		prev = br.UpTo
+	}
+	return b, nil
+}
+
+func (b Brackets) Amount(in Input) int64 {
+	if in.PeriodsPerYear <= 0 || in.Taxable <= 0 {
+		return 0
+	}
+	annual := in.Taxable * in.PeriodsPerYear
+
+	var tax float64
+	var lower int64
+	for _, br := range b.Brackets {
+		upper := br.UpTo
+		if upper == 0 || upper > annual {
+			upper = annual
+		}
+		if upper > lower {
+			tax += float64(upper-lower) * br.Rate
+		}
+		if upper == annual {
+			break
+		}
+		lower = upper
+	}
+	return int64(math.Round(tax / float64(in.PeriodsPerYear)))
+}
+
+// Percentage deducts Rate of gross pay, or of taxable pay when Base is
+// "taxable". A non-zero Cap limits the deduction per period.
+type Percentage struct {
+	ruleBase
+	Rate float64 `json:"rate"`
+	Base string  `json:"base"`
+	Cap  int64   `json:"cap"`
+}
+
+func newPercentage(config json.RawMessage) (Rule, error) {
+	var p Percentage
+	if err := json.Unmarshal(config, &p); err != nil {
+		return nil, err
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if p.Rate < 0 || p.Rate > 1 {
+		return nil, fmt.Errorf("rate must be between 0 and 1")
+	}
+	if p.Base != "" && p.Base != "gross" && p.Base != "taxable" {
+		return nil, fmt.Errorf("base must be gross or taxable")
+	}
+	return p, nil
+}
+
+func (p Percentage) Amount(in Input) int64 {
+	base := in.Gross
+	if p.Base == "taxable" {
+		base = in.Taxable
+	}
+	amount := int64(math.Round(float64(base) * p.Rate))
+	if p.Cap > 0 && amount > p.Cap {
+		amount = p.Cap
+	}
+	return max(amount, 0)
+}
+
+// Fixed deducts the same amount every period.
+type Fixed struct {
+	ruleBase
+	Value int64 `json:"amount"`
+}
+
+func newFixed(config json.RawMessage) (Rule, error) {
+	var f Fixed
+	if err := json.Unmarshal(config, &f); err != nil {
+		return nil, err
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	if f.Value < 0 {
+		return nil, fmt.Errorf("amount must not be negative")
+	}
+	return f, nil
+}
+
+func (f Fixed) Amount(Input) int64 {
+	return f.Value
+}
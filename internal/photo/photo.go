@@ -0,0 +1,133 @@
+// Package photo turns uploaded employee photos into metadata-free images
+// in a fixed set of square sizes, using only the standard library.
+package photo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+var (
+	ErrUnsupportedType = errors.New("photo must be a JPEG, PNG or WebP image")
+	ErrTooLarge        = errors.New("photo dimensions are too large")
+)
+
+// MaxPixels bounds decoded image size so a small, highly compressed upload
+// cannot exhaust memory.
+const MaxPixels = 40_000_000
+
+// Sizes are the square thumbnail edge lengths in pixels, by name.
+var Sizes = map[string]int{
+	"small":  64,
+	"medium": 256,
+	"large":  512,
+}
+
+// Image is one encoded output size.
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// Process decodes data and returns an encoded thumbnail for every entry in
+// Sizes. Re-encoding drops all EXIF and other metadata; a JPEG's EXIF
+// orientation is applied first so the result is upright. PNGs stay PNG to
+// keep transparency.
+//
+// The standard library cannot decode WebP, so WebP photos only have their
+// metadata chunks removed and the same image is returned for every size.
+func Process(data []byte) (map[string]Image, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png":
+	case "image/webp":
+		stripped, err := stripWebP(data)
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[string]Image, len(Sizes))
+		for name := range Sizes {
+			out[name] = Image{Data: stripped, ContentType: contentType}
+		}
+		return out, nil
+	default:
+		return nil, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+	if contentType == "image/jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	out := make(map[string]Image, len(Sizes))
+	for name, size := range Sizes {
+		var buf bytes.Buffer
+		thumb := Thumbnail(img, size)
+		if contentType == "image/png" {
+			err = png.Encode(&buf, thumb)
+		} else {
+			err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[name] = Image{Data: buf.Bytes(), ContentType: contentType}
+	}
+	return out, nil
+}
+
+// Thumbnail crops the centre square of img and scales it to size by size
+// pixels. Each output pixel averages the source pixels it covers, which
+// avoids the aliasing of nearest-neighbour sampling when shrinking. Images
+// smaller than size are scaled up by repetition.
+func Thumbnail(img image.Image, size int) *image.NRGBA {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		sy0 := y0 + y*side/size
+		sy1 := max(y0+(y+1)*side/size, sy0+1)
+		for x := 0; x < size; x++ {
+			sx0 := x0 + x*side/size
+			sx1 := max(x0+(x+1)*side/size, sx0+1)
+
+			var r, g, bl, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					c := color.NRGBA64Model.Convert(img.At(sx, sy)).(color.NRGBA64)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,137 @@
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.NRGBA{R: 255, A: 255}
+	blue = color.NRGBA{B: 255, A: 255}
+)
+
+// halves returns a w by h image, red on the left and blue on the right.
+func halves(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+func TestProcessPNG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halves(300, 200)))
+
+	out, err := Process(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, out, len(Sizes))
+
+	for name, size := range Sizes {
+		assert.Equal(t, "image/png", out[name].ContentType)
+		img, err := png.Decode(bytes.NewReader(out[name].Data))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, size, size), img.Bounds(), name)
+	}
+
+	small, _ := png.Decode(bytes.NewReader(out["small"].Data))
+	assert.Equal(t, red, color.NRGBAModel.Convert(small.At(0, 32)))
+	assert.Equal(t, blue, color.NRGBAModel.Convert(small.At(63, 32)))
+}
+
+// withOrientation inserts an EXIF APP1 segment carrying orientation o
+// straight after the JPEG's start-of-image marker.
+func withOrientation(jpg []byte, o uint16) []byte {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1}
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:], 0x0112)
+	binary.BigEndian.PutUint16(entry[2:], 3)
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], o)
+	tiff = append(append(tiff, entry...), 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	return append(append(append([]byte{}, jpg[:2]...), segment...), jpg[2:]...)
+}
+
+func TestProcessJPEGOrientation(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, halves(64, 32), &jpeg.Options{Quality: 100}))
+	data := withOrientation(buf.Bytes(), 6)
+	require.Equal(t, 6, jpegOrientation(data))
+
+	out, err := Process(data)
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", out["small"].ContentType)
+	assert.NotContains(t, string(out["small"].Data), "Exif")
+
+	// Rotated a quarter turn clockwise, the red left half ends up on top.
+	img, err := jpeg.Decode(bytes.NewReader(out["small"].Data))
+	require.NoError(t, err)
+	top := color.NRGBAModel.Convert(img.At(32, 4)).(color.NRGBA)
+	bottom := color.NRGBAModel.Convert(img.At(32, 60)).(color.NRGBA)
+	assert.Greater(t, top.R, top.B)
+	assert.Greater(t, bottom.B, bottom.R)
+}
+
+func webpChunk(fourCC string, payload []byte) []byte {
+	chunk := append([]byte(fourCC), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(len(payload)))
+	chunk = append(chunk, payload...)
+	if len(payload)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func TestProcessWebPStripsMetadata(t *testing.T) {
+	vp8x := make([]byte, 10)
+	vp8x[0] = 0x08 | 0x04 | 0x10 // EXIF, XMP and alpha
+	body := append([]byte("WEBP"), webpChunk("VP8X", vp8x)...)
+	body = append(body, webpChunk("VP8L", []byte{0x2f, 1, 2, 3, 4})...)
+	body = append(body, webpChunk("EXIF", []byte("Exif\x00\x00GPS"))...)
+	body = append(body, webpChunk("XMP ", []byte("<x:xmpmeta/>"))...)
+	data := append([]byte("RIFF\x00\x00\x00\x00"), body...)
+	binary.LittleEndian.PutUint32(data[4:], uint32(len(body)))
+
+	out, err := Process(data)
+	require.NoError(t, err)
+	got := out["medium"].Data
+
+	assert.Equal(t, "image/webp", out["medium"].ContentType)
+	assert.NotContains(t, string(got), "GPS")
+	assert.NotContains(t, string(got), "xmpmeta")
+	assert.Contains(t, string(got), "VP8L")
+	assert.Equal(t, byte(0x10), got[20])
+	assert.Equal(t, uint32(len(got)-8), binary.LittleEndian.Uint32(got[4:]))
+}
+
+func TestProcessRejectsOtherTypes(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, gif.Encode(&buf, halves(8, 8), nil))
+
+	_, err := Process(buf.Bytes())
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = Process([]byte("not an image"))
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
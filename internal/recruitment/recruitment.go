@@ -0,0 +1,126 @@
+// Package recruitment validates requisitions, candidates and interview
+// feedback, and turns an accepted offer into an employee record.
+package recruitment
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid recruitment data")
+
+var requisitionStatuses = map[models.RequisitionStatus]bool{
+	models.RequisitionStatusOpen:      true,
+	models.RequisitionStatusOnHold:    true,
+	models.RequisitionStatusFilled:    true,
+	models.RequisitionStatusCancelled: true,
+}
+
+var recommendations = map[models.Recommendation]bool{
+	models.RecommendationStrongYes: true,
+	models.RecommendationYes:       true,
+	models.RecommendationNo:        true,
+	models.RecommendationStrongNo:  true,
+}
+
+// ValidateRequisition trims the requisition's title and defaults it to one
+// open position.
+func ValidateRequisition(req *models.Requisition) error {
+	req.Title = strings.TrimSpace(req.Title)
+	if req.Openings == 0 {
+		req.Openings = 1
+	}
+	if req.Status == "" {
+		req.Status = models.RequisitionStatusOpen
+	}
+	switch {
+	case req.Title == "":
+		return fmt.Errorf("%w: title is required", ErrInvalid)
+	case req.Openings < 0:
+		return fmt.Errorf("%w: openings must be positive", ErrInvalid)
+	case !requisitionStatuses[req.Status]:
+		return fmt.Errorf("%w: status must be open, on_hold, filled or cancelled", ErrInvalid)
+	}
+	return nil
+}
+
+// ValidateStage trims the stage's name.
+func ValidateStage(stage *models.PipelineStage) error {
+	stage.Name = strings.TrimSpace(stage.Name)
+	if stage.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	return nil
+}
+
+// ValidateCandidate trims the candidate's details and defaults them to
+// active. Candidates only become hired by accepting an offer.
+func ValidateCandidate(c *models.Candidate) error {
+	c.FirstName = strings.TrimSpace(c.FirstName)
+	c.LastName = strings.TrimSpace(c.LastName)
+	c.Email = strings.TrimSpace(c.Email)
+	c.Phone = strings.TrimSpace(c.Phone)
+	c.Source = strings.TrimSpace(c.Source)
+	if c.Status == "" {
+		c.Status = models.CandidateStatusActive
+	}
+	switch {
+	case c.FirstName == "" || c.LastName == "":
+		return fmt.Errorf("%w: firstName and lastName are required", ErrInvalid)
+	case !strings.Contains(c.Email, "@"):
+		return fmt.Errorf("%w: a valid email is required", ErrInvalid)
+	case c.Status != models.CandidateStatusActive &&
+		c.Status != models.CandidateStatusRejected &&
+		c.Status != models.CandidateStatusWithdrawn:
+		return fmt.Errorf("%w: status must be active, rejected or withdrawn", ErrInvalid)
+	}
+	return nil
+}
+
+// ValidateFeedback checks the rating and recommendation.
+func ValidateFeedback(f *models.InterviewFeedback) error {
+	f.Notes = strings.TrimSpace(f.Notes)
+	switch {
+	case f.Rating < 1 || f.Rating > 5:
+		return fmt.Errorf("%w: rating must be between 1 and 5", ErrInvalid)
+	case !recommendations[f.Recommendation]:
+		return fmt.Errorf("%w: recommendation must be strong_yes, yes, no or strong_no", ErrInvalid)
+	}
+	return nil
+}
+
+// NewHire builds the employee record for a candidate accepting offer on
+// req. The employee starts as a candidate; they move to onboarding on the
+// offer's start date.
+func NewHire(c models.Candidate, req models.Requisition, offer models.Offer) (models.Employee, error) {
+	if offer.StartDate.IsZero() {
+		return models.Employee{}, fmt.Errorf("%w: startDate is required", ErrInvalid)
+	}
+	emp := models.Employee{
+		FirstName:    c.FirstName,
+		LastName:     c.LastName,
+		Email:        c.Email,
+		JobTitle:     req.Title,
+		DepartmentID: req.DepartmentID,
+		LocationID:   req.LocationID,
+		ManagerID:    req.HiringManagerID,
+		Status:       models.EmployeeStatusCandidate,
+		ContractType: strings.TrimSpace(offer.ContractType),
+		CustomFields: offer.CustomFields,
+	}
+	if title := strings.TrimSpace(offer.JobTitle); title != "" {
+		emp.JobTitle = title
+	}
+	if offer.DepartmentID != nil {
+		emp.DepartmentID = offer.DepartmentID
+	}
+	if offer.LocationID != nil {
+		emp.LocationID = offer.LocationID
+	}
+	if offer.ManagerID != nil {
+		emp.ManagerID = offer.ManagerID
+	}
+	return emp, nil
+}
@@ -0,0 +1,64 @@
+package recruitment
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestValidateRequisition(t *testing.T) {
+	req := models.Requisition{Title: " Backend Engineer "}
+	require.NoError(t, ValidateRequisition(&req))
+	assert.Equal(t, "Backend Engineer", req.Title)
+	assert.Equal(t, 1, req.Openings)
+	assert.Equal(t, models.RequisitionStatusOpen, req.Status)
+
+	assert.ErrorIs(t, ValidateRequisition(&models.Requisition{}), ErrInvalid)
+	assert.ErrorIs(t, ValidateRequisition(&models.Requisition{Title: "SRE", Openings: -1}), ErrInvalid)
+	assert.ErrorIs(t, ValidateRequisition(&models.Requisition{Title: "SRE", Status: "closed"}), ErrInvalid)
+}
+
+func TestValidateCandidate(t *testing.T) {
+	c := models.Candidate{FirstName: " Ada ", LastName: "King", Email: " ada@example.com "}
+	require.NoError(t, ValidateCandidate(&c))
+	assert.Equal(t, "Ada", c.FirstName)
+	assert.Equal(t, "ada@example.com", c.Email)
+	assert.Equal(t, models.CandidateStatusActive, c.Status)
+
+	assert.ErrorIs(t, ValidateCandidate(&models.Candidate{FirstName: "Ada", LastName: "King", Email: "ada"}), ErrInvalid)
+	assert.ErrorIs(t, ValidateCandidate(&models.Candidate{FirstName: "Ada", Email: "ada@example.com"}), ErrInvalid)
+	assert.ErrorIs(t, ValidateCandidate(&models.Candidate{FirstName: "Ada", LastName: "King", Email: "ada@example.com",
+		Status: models.CandidateStatusHired}), ErrInvalid)
+}
+
+func TestValidateFeedback(t *testing.T) {
+	assert.NoError(t, ValidateFeedback(&models.InterviewFeedback{Rating: 4, Recommendation: models.RecommendationYes}))
+	assert.ErrorIs(t, ValidateFeedback(&models.InterviewFeedback{Rating: 6, Recommendation: models.RecommendationYes}), ErrInvalid)
+	assert.ErrorIs(t, ValidateFeedback(&models.InterviewFeedback{Rating: 3, Recommendation: "maybe"}), ErrInvalid)
+}
+
+func TestNewHire(t *testing.T) {
+	c := models.Candidate{FirstName: "Ada", LastName: "King", Email: "ada@example.com"}
+	req := models.Requisition{Title: "Backend Engineer", DepartmentID: intPtr(2), HiringManagerID: intPtr(7)}
+	start := models.NewDate(2025, time.September, 1)
+
+	emp, err := NewHire(c, req, models.Offer{StartDate: start})
+	require.NoError(t, err)
+	assert.Equal(t, "Backend Engineer", emp.JobTitle)
+	assert.Equal(t, intPtr(2), emp.DepartmentID)
+	assert.Equal(t, intPtr(7), emp.ManagerID)
+	assert.Equal(t, models.EmployeeStatusCandidate, emp.Status)
+
+	emp, err = NewHire(c, req, models.Offer{StartDate: start, JobTitle: "Senior Backend Engineer", ManagerID: intPtr(9)})
+	require.NoError(t, err)
+	assert.Equal(t, "Senior Backend Engineer", emp.JobTitle)
+	assert.Equal(t, intPtr(9), emp.ManagerID)
+
+	_, err = NewHire(c, req, models.Offer{})
+	assert.ErrorIs(t, err, ErrInvalid)
+}
@@ -0,0 +1,255 @@
+// Package review validates review templates and answers, works out who
+// reviews whom in a cycle and summarises the results for calibration.
+package review
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid review")
+
+const (
+	MinRatingScale = 2
+	MaxRatingScale = 10
+	MaxPeerCount   = 10
+)
+
+// next lists the status each status may move on to.
+var next = map[models.ReviewCycleStatus]models.ReviewCycleStatus{
+	models.ReviewCycleDraft:       models.ReviewCycleOpen,
+	models.ReviewCycleOpen:        models.ReviewCycleCalibration,
+	models.ReviewCycleCalibration: models.ReviewCycleReleased,
+}
+
+// Advance checks that a cycle may move from one status to another. Cycles
+// only move forward, one step at a time.
+func Advance(from, to models.ReviewCycleStatus) error {
+	if next[from] != to || to == "" {
+		return fmt.Errorf("%w: a %s cycle cannot move to %s", ErrInvalid, from, to)
+	}
+	return nil
+}
+
+func validKind(kind models.ReviewKind) bool {
+	switch kind {
+	case models.ReviewKindSelf, models.ReviewKindManager, models.ReviewKindPeer:
+		return true
+	}
+	return false
+}
+
+// ValidateTemplate checks the questions and rating scale of a template.
+func ValidateTemplate(tpl models.ReviewTemplate) error {
+	if strings.TrimSpace(tpl.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if tpl.RatingScale < MinRatingScale || tpl.RatingScale > MaxRatingScale {
+		return fmt.Errorf("%w: ratingScale must be between %d and %d", ErrInvalid, MinRatingScale, MaxRatingScale)
+	}
+	if len(tpl.RatingLabels) > 0 && len(tpl.RatingLabels) != tpl.RatingScale {
+		return fmt.Errorf("%w: ratingLabels must name each of the %d points", ErrInvalid, tpl.RatingScale)
+	}
+	if len(tpl.Questions) == 0 {
+		return fmt.Errorf("%w: at least one question is required", ErrInvalid)
+	}
+	keys := map[string]bool{}
+	for i, q := range tpl.Questions {
+		if q.Key == "" || keys[q.Key] {
+			return fmt.Errorf("%w: question %d needs a unique key", ErrInvalid, i)
+		}
+		keys[q.Key] = true
+		if strings.TrimSpace(q.Text) == "" {
+			return fmt.Errorf("%w: question %q needs text", ErrInvalid, q.Key)
+		}
+		if q.Type != models.QuestionTypeRating && q.Type != models.QuestionTypeText {
+			return fmt.Errorf("%w: question %q type must be rating or text", ErrInvalid, q.Key)
+		}
+		for _, kind := range q.Kinds {
+			if !validKind(kind) {
+				return fmt.Errorf("%w: question %q kinds must be self, manager or peer", ErrInvalid, q.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCycle checks the settings of a new cycle.
+func ValidateCycle(cycle models.ReviewCycle) error {
+	if strings.TrimSpace(cycle.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if cycle.DueDate.IsZero() {
+		return fmt.Errorf("%w: dueDate is required", ErrInvalid)
+	}
+	if cycle.PeerCount < 0 || cycle.PeerCount > MaxPeerCount {
+		return fmt.Errorf("%w: peerCount must be between 0 and %d", ErrInvalid, MaxPeerCount)
+	}
+	if cycle.ReminderDays < 0 {
+		return fmt.Errorf("%w: reminderDays cannot be negative", ErrInvalid)
+	}
+	return nil
+}
+
+// Asks reports whether q is put to reviewers of kind.
+func Asks(q models.ReviewQuestion, kind models.ReviewKind) bool {
+	if len(q.Kinds) == 0 {
+		return true
+	}
+	for _, k := range q.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAnswers checks a review's answers against the cycle's questions.
+// Answers may be saved incomplete; on submit every required question and
+// the overall rating must be answered.
+func ValidateAnswers(cycle models.ReviewCycle, rev models.Review, submit bool) error {
+	inScale := func(r *int) bool { return r != nil && *r >= 1 && *r <= cycle.RatingScale }
+
+	questions := map[string]models.ReviewQuestion{}
+	for _, q := range cycle.Questions {
+		if Asks(q, rev.Kind) {
+			questions[q.Key] = q
+		}
+	}
+	answered := map[string]bool{}
+	for _, a := range rev.Answers {
+		q, ok := questions[a.Key]
+		if !ok {
+			return fmt.Errorf("%w: %q is not a question for %s reviews", ErrInvalid, a.Key, rev.Kind)
+		}
+		if answered[a.Key] {
+			return fmt.Errorf("%w: %q is answered twice", ErrInvalid, a.Key)
+		}
+		switch q.Type {
+		case models.QuestionTypeRating:
+			if !inScale(a.Rating) || a.Text != "" {
+				return fmt.Errorf("%w: %q needs a rating between 1 and %d", ErrInvalid, a.Key, cycle.RatingScale)
+			}
+		case models.QuestionTypeText:
+			if a.Rating != nil {
+				return fmt.Errorf("%w: %q takes a text answer", ErrInvalid, a.Key)
+			}
+			if strings.TrimSpace(a.Text) == "" {
+				continue
+			}
+		}
+		answered[a.Key] = true
+	}
+	if rev.Rating != nil && !inScale(rev.Rating) {
+		return fmt.Errorf("%w: rating must be between 1 and %d", ErrInvalid, cycle.RatingScale)
+	}
+
+	if !submit {
+		return nil
+	}
+	for _, q := range cycle.Questions {
+		if q.Required && Asks(q, rev.Kind) && !answered[q.Key] {
+			return fmt.Errorf("%w: %q must be answered", ErrInvalid, q.Key)
+		}
+	}
+	if rev.Rating == nil {
+		return fmt.Errorf("%w: an overall rating is required", ErrInvalid)
+	}
+	return nil
+}
+
+// Assign works out the reviews for a cycle over emps, the employees it
+// covers. Everyone reviews themselves and is reviewed by their manager.
+// Colleagues sharing a manager review the next PeerCount of their group in
+// ID order, wrapping round, so each of them gives and receives the same
+// number of peer reviews.
+func Assign(cycle models.ReviewCycle, emps []models.Employee) []models.Review {
+	sorted := append([]models.Employee(nil), emps...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	var reviews []models.Review
+	add := func(subject, reviewer int, kind models.ReviewKind) {
+		reviews = append(reviews, models.Review{CycleID: cycle.ID, SubjectID: subject, ReviewerID: reviewer, Kind: kind})
+	}
+
+	teams := map[int][]int{}
+	for _, emp := range sorted {
+		add(emp.ID, emp.ID, models.ReviewKindSelf)
+		if emp.ManagerID != nil && *emp.ManagerID != emp.ID {
+			add(emp.ID, *emp.ManagerID, models.ReviewKindManager)
+			teams[*emp.ManagerID] = append(teams[*emp.ManagerID], emp.ID)
+		}
+	}
+
+	managers := make([]int, 0, len(teams))
+	for id := range teams {
+		managers = append(managers, id)
+	}
+	sort.Ints(managers)
+	for _, manager := range managers {
+		team := teams[manager]
+		peers := min(cycle.PeerCount, len(team)-1)
+		for i, subject := range team {
+			for k := 1; k <= peers; k++ {
+				add(subject, team[(i+k)%len(team)], models.ReviewKindPeer)
+			}
+		}
+	}
+	return reviews
+}
+
+// Calibrate summarises the submitted reviews of each employee in a cycle
+// alongside the final rating HR has given them, if any. Employees are
+// listed in the order given.
+func Calibrate(emps []models.Employee, reviews []models.Review, calibrations []models.ReviewCalibration) []models.CalibrationRow {
+	rows := make([]models.CalibrationRow, len(emps))
+	index := map[int]int{}
+	for i, emp := range emps {
+		rows[i] = models.CalibrationRow{EmployeeID: emp.ID, Name: emp.FirstName + " " + emp.LastName,
+			DepartmentID: emp.DepartmentID}
+		index[emp.ID] = i
+	}
+
+	peerTotals := make([]int, len(emps))
+	for _, rev := range reviews {
+		i, ok := index[rev.SubjectID]
+		if !ok || rev.SubmittedAt == nil || rev.Rating == nil {
+			continue
+		}
+		switch rev.Kind {
+		case models.ReviewKindSelf:
+			rows[i].SelfRating = rev.Rating
+		case models.ReviewKindManager:
+			rows[i].ManagerRating = rev.Rating
+		case models.ReviewKindPeer:
+			peerTotals[i] += *rev.Rating
+			rows[i].PeerCount++
+		}
+	}
+	for i := range rows {
+		if rows[i].PeerCount > 0 {
+			mean := float64(peerTotals[i]) / float64(rows[i].PeerCount)
+			rows[i].PeerRating = &mean
+		}
+	}
+
+	for _, c := range calibrations {
+		if i, ok := index[c.EmployeeID]; ok {
+			rating := c.Rating
+			rows[i].FinalRating = &rating
+			rows[i].Note = c.Note
+		}
+	}
+	return rows
+}
+
+// Seal hides what a review says, leaving who wrote it about whom and
+// whether it has been submitted.
+func Seal(rev models.Review) models.Review {
+	rev.Answers = nil
+	rev.Rating = nil
+	return rev
+}
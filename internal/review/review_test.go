@@ -0,0 +1,123 @@
+package review
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestAdvance(t *testing.T) {
+	assert.NoError(t, Advance(models.ReviewCycleDraft, models.ReviewCycleOpen))
+	assert.NoError(t, Advance(models.ReviewCycleCalibration, models.ReviewCycleReleased))
+	assert.ErrorIs(t, Advance(models.ReviewCycleDraft, models.ReviewCycleReleased), ErrInvalid)
+	assert.ErrorIs(t, Advance(models.ReviewCycleReleased, models.ReviewCycleOpen), ErrInvalid)
+}
+
+func TestValidateTemplate(t *testing.T) {
+	valid := models.ReviewTemplate{Name: "Half-year", RatingScale: 5, Questions: []models.ReviewQuestion{
+		{Key: "impact", Text: "Impact", Type: models.QuestionTypeRating, Required: true},
+		{Key: "growth", Text: "Areas to grow", Type: models.QuestionTypeText, Kinds: []models.ReviewKind{models.ReviewKindManager}},
+	}}
+	require.NoError(t, ValidateTemplate(valid))
+
+	tests := map[string]func(*models.ReviewTemplate){
+		"Scale Too Small":     func(t *models.ReviewTemplate) { t.RatingScale = 1 },
+		"Labels Mismatch":     func(t *models.ReviewTemplate) { t.RatingLabels = []string{"Low", "High"} },
+		"Duplicate Key":       func(t *models.ReviewTemplate) { t.Questions[1].Key = "impact" },
+		"Unknown Type":        func(t *models.ReviewTemplate) { t.Questions[0].Type = "choice" },
+		"Unknown Kind":        func(t *models.ReviewTemplate) { t.Questions[1].Kinds = []models.ReviewKind{"skip"} },
+		"No Questions":        func(t *models.ReviewTemplate) { t.Questions = nil },
+		"Question Needs Text": func(t *models.ReviewTemplate) { t.Questions[0].Text = " " },
+	}
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			tpl := valid
+			tpl.Questions = append([]models.ReviewQuestion(nil), valid.Questions...)
+			change(&tpl)
+			assert.ErrorIs(t, ValidateTemplate(tpl), ErrInvalid)
+		})
+	}
+}
+
+func TestValidateAnswers(t *testing.T) {
+	cycle := models.ReviewCycle{RatingScale: 5, Questions: []models.ReviewQuestion{
+		{Key: "impact", Text: "Impact", Type: models.QuestionTypeRating, Required: true},
+		{Key: "growth", Text: "Areas to grow", Type: models.QuestionTypeText, Required: true,
+			Kinds: []models.ReviewKind{models.ReviewKindManager}},
+	}}
+	peer := models.Review{Kind: models.ReviewKindPeer}
+
+	draft := peer
+	assert.NoError(t, ValidateAnswers(cycle, draft, false))
+	assert.ErrorIs(t, ValidateAnswers(cycle, draft, true), ErrInvalid)
+
+	done := peer
+	done.Answers = []models.ReviewAnswer{{Key: "impact", Rating: intPtr(4)}}
+	done.Rating = intPtr(4)
+	assert.NoError(t, ValidateAnswers(cycle, done, true))
+
+	notAsked := done
+	notAsked.Answers = append(notAsked.Answers, models.ReviewAnswer{Key: "growth", Text: "More design work"})
+	assert.ErrorIs(t, ValidateAnswers(cycle, notAsked, false), ErrInvalid)
+
+	offScale := done
+	offScale.Answers = []models.ReviewAnswer{{Key: "impact", Rating: intPtr(6)}}
+	assert.ErrorIs(t, ValidateAnswers(cycle, offScale, false), ErrInvalid)
+
+	manager := done
+	manager.Kind = models.ReviewKindManager
+	assert.ErrorIs(t, ValidateAnswers(cycle, manager, true), ErrInvalid)
+}
+
+func TestAssign(t *testing.T) {
+	emps := []models.Employee{
+		{ID: 4, ManagerID: intPtr(1)},
+		{ID: 2, ManagerID: intPtr(1)},
+		{ID: 3, ManagerID: intPtr(1)},
+		{ID: 1},
+	}
+	reviews := Assign(models.ReviewCycle{ID: 7, PeerCount: 1}, emps)
+
+	type pair struct {
+		subject, reviewer int
+		kind              models.ReviewKind
+	}
+	var got []pair
+	for _, r := range reviews {
+		assert.Equal(t, 7, r.CycleID)
+		got = append(got, pair{r.SubjectID, r.ReviewerID, r.Kind})
+	}
+	assert.ElementsMatch(t, []pair{
+		{1, 1, models.ReviewKindSelf},
+		{2, 2, models.ReviewKindSelf}, {2, 1, models.ReviewKindManager},
+		{3, 3, models.ReviewKindSelf}, {3, 1, models.ReviewKindManager},
+		{4, 4, models.ReviewKindSelf}, {4, 1, models.ReviewKindManager},
+		{2, 3, models.ReviewKindPeer}, {3, 4, models.ReviewKindPeer}, {4, 2, models.ReviewKindPeer},
+	}, got)
+}
+
+func TestCalibrate(t *testing.T) {
+	now := time.Now()
+	emps := []models.Employee{{ID: 2, FirstName: "Ada", LastName: "King"}}
+	reviews := []models.Review{
+		{SubjectID: 2, Kind: models.ReviewKindSelf, Rating: intPtr(5), SubmittedAt: &now},
+		{SubjectID: 2, Kind: models.ReviewKindManager, Rating: intPtr(4), SubmittedAt: &now},
+		{SubjectID: 2, Kind: models.ReviewKindPeer, Rating: intPtr(3), SubmittedAt: &now},
+		{SubjectID: 2, Kind: models.ReviewKindPeer, Rating: intPtr(4), SubmittedAt: &now},
+		{SubjectID: 2, Kind: models.ReviewKindPeer, Rating: intPtr(1)},
+	}
+	rows := Calibrate(emps, reviews, []models.ReviewCalibration{{EmployeeID: 2, Rating: 4, Note: "Strong half"}})
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Ada King", rows[0].Name)
+	assert.Equal(t, 5, *rows[0].SelfRating)
+	assert.Equal(t, 4, *rows[0].ManagerRating)
+	assert.Equal(t, 2, rows[0].PeerCount)
+	assert.InDelta(t, 3.5, *rows[0].PeerRating, 0.001)
+	assert.Equal(t, 4, *rows[0].FinalRating)
+}
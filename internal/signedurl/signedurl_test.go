@@ -0,0 +1,23 @@
+package signedurl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	now := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	params := signer.Sign("document/7", now.Add(15*time.Minute))
+
+	assert.NoError(t, signer.Verify("document/7", params, now))
+	assert.ErrorIs(t, signer.Verify("document/8", params, now), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify("document/7", params, now.Add(time.Hour)), ErrExpired)
+	assert.ErrorIs(t, NewSigner([]byte("other")).Verify("document/7", params, now), ErrInvalidSignature)
+
+	tampered := signer.Sign("document/7", now.Add(15*time.Minute))
+	tampered.Set("expires", "9999999999")
+	assert.ErrorIs(t, signer.Verify("document/7", tampered, now), ErrInvalidSignature)
+}
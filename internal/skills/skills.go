@@ -0,0 +1,88 @@
+// Package skills validates the skills taxonomy, proficiency levels and
+// certifications, and parses skill search queries.
+package skills
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid skill data")
+
+// ValidLevel reports whether level is on the proficiency scale.
+func ValidLevel(level int) bool {
+	return level >= models.MinProficiency && level <= models.MaxProficiency
+}
+
+// CheckParent rejects a parent that would make skill its own ancestor.
+// parentOf returns a skill's parent ID, or nil at the top of the taxonomy.
+func CheckParent(skillID int, parentID *int, parentOf func(id int) (*int, error)) error {
+	for seen := 0; parentID != nil; seen++ {
+		if *parentID == skillID {
+			return fmt.Errorf("%w: a skill cannot be nested under itself", ErrInvalid)
+		}
+		if seen > 1000 {
+			return fmt.Errorf("%w: skill hierarchy is too deep", ErrInvalid)
+		}
+		next, err := parentOf(*parentID)
+		if err != nil {
+			return err
+		}
+		parentID = next
+	}
+	return nil
+}
+
+// ValidateCertification trims the certification's fields and checks that
+// it has a name and issue date and does not expire before it was issued.
+func ValidateCertification(c *models.Certification) error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Issuer = strings.TrimSpace(c.Issuer)
+	c.CredentialID = strings.TrimSpace(c.CredentialID)
+	switch {
+	case c.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	case c.IssuedOn.IsZero():
+		return fmt.Errorf("%w: issuedOn is required", ErrInvalid)
+	case c.ExpiresOn != nil && c.ExpiresOn.Before(c.IssuedOn.Time):
+		return fmt.Errorf("%w: expiresOn must not be before issuedOn", ErrInvalid)
+	}
+	return nil
+}
+
+// ParseRequirements reads a comma-separated list of skill IDs, each
+// optionally followed by ":" and a minimum level, as in "3:4,7". A skill
+// without a level matches any proficiency.
+func ParseRequirements(query string) ([]models.SkillRequirement, error) {
+	var reqs []models.SkillRequirement
+	seen := map[int]bool{}
+	for _, term := range strings.Split(query, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		id, level, hasLevel := strings.Cut(term, ":")
+		req := models.SkillRequirement{MinLevel: models.MinProficiency}
+		var err error
+		if req.SkillID, err = strconv.Atoi(id); err != nil || req.SkillID <= 0 {
+			return nil, fmt.Errorf("%w: invalid skill ID %q", ErrInvalid, id)
+		}
+		if hasLevel {
+			if req.MinLevel, err = strconv.Atoi(level); err != nil || !ValidLevel(req.MinLevel) {
+				return nil, fmt.Errorf("%w: level must be between %d and %d", ErrInvalid, models.MinProficiency, models.MaxProficiency)
+			}
+		}
+		if seen[req.SkillID] {
+			return nil, fmt.Errorf("%w: skill %d listed twice", ErrInvalid, req.SkillID)
+		}
+		seen[req.SkillID] = true
+		reqs = append(reqs, req)
+	}
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("%w: at least one skill is required", ErrInvalid)
+	}
+	return reqs, nil
+}
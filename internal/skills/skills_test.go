@@ -0,0 +1,95 @@
+package skills
+
+import (
+	"employees/internal/models"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestValidLevel(t *testing.T) {
+	assert.False(t, ValidLevel(0))
+	assert.True(t, ValidLevel(1))
+	assert.True(t, ValidLevel(5))
+	assert.False(t, ValidLevel(6))
+}
+
+func TestCheckParent(t *testing.T) {
+	// 1 <- 2 <- 3
+	parents := map[int]*int{1: nil, 2: intPtr(1), 3: intPtr(2)}
+	parentOf := func(id int) (*int, error) {
+		parent, ok := parents[id]
+		if !ok {
+			return nil, errors.New("skill not found")
+		}
+		return parent, nil
+	}
+
+	assert.NoError(t, CheckParent(3, intPtr(2), parentOf))
+	assert.NoError(t, CheckParent(4, nil, parentOf))
+	assert.ErrorIs(t, CheckParent(1, intPtr(3), parentOf), ErrInvalid)
+	assert.ErrorIs(t, CheckParent(2, intPtr(2), parentOf), ErrInvalid)
+	assert.EqualError(t, CheckParent(4, intPtr(9), parentOf), "skill not found")
+}
+
+func TestValidateCertification(t *testing.T) {
+	issued := models.NewDate(2025, time.March, 1)
+	before := models.NewDate(2025, time.February, 1)
+	after := models.NewDate(2027, time.March, 1)
+
+	tests := []struct {
+		name    string
+		cert    models.Certification
+		wantErr bool
+	}{
+		{name: "Valid", cert: models.Certification{Name: " First aid ", IssuedOn: issued, ExpiresOn: &after}},
+		{name: "No Expiry", cert: models.Certification{Name: "First aid", IssuedOn: issued}},
+		{name: "Missing Name", cert: models.Certification{IssuedOn: issued}, wantErr: true},
+		{name: "Missing Issue Date", cert: models.Certification{Name: "First aid"}, wantErr: true},
+		{name: "Expires Before Issue", cert: models.Certification{Name: "First aid", IssuedOn: issued, ExpiresOn: &before}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCertification(&tt.cert)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "First aid", tt.cert.Name)
+		})
+	}
+}
+
+func TestParseRequirements(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    []models.SkillRequirement
+		wantErr bool
+	}{
+		{query: "3:4,7", want: []models.SkillRequirement{{SkillID: 3, MinLevel: 4}, {SkillID: 7, MinLevel: 1}}},
+		{query: " 3 , ", want: []models.SkillRequirement{{SkillID: 3, MinLevel: 1}}},
+		{query: "", wantErr: true},
+		{query: "go", wantErr: true},
+		{query: "3:9", wantErr: true},
+		{query: "3:2,3:4", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := ParseRequirements(tt.query)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package timesheet computes working weeks, overtime and hour summaries
+// from time entries.
+package timesheet
+
+import (
+	"employees/internal/models"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+var ErrInvalidTransition = errors.New("invalid timesheet status transition")
+
+// DefaultPolicy applies when no overtime policy has been configured: over
+// 8 hours a day or 40 a week at time and a half.
+var DefaultPolicy = models.OvertimePolicy{
+	ID:                     1,
+	DailyThresholdMinutes:  8 * 60,
+	WeeklyThresholdMinutes: 40 * 60,
+	OvertimeMultiplier:     1.5,
+}
+
+// WeekStart returns the Monday of the week d falls in.
+func WeekStart(d models.Date) models.Date {
+	offset := (int(d.Weekday()) + 6) % 7
+	return d.AddDays(-offset)
+}
+
+// Transition validates moving a timesheet from one status to another:
+// drafts and rejected weeks may be submitted, submitted weeks approved or
+// rejected.
+func Transition(from, to models.TimesheetStatus) error {
+	switch {
+	case (from == models.TimesheetStatusDraft || from == models.TimesheetStatusRejected) && to == models.TimesheetStatusSubmitted:
+	case from == models.TimesheetStatusSubmitted && (to == models.TimesheetStatusApproved || to == models.TimesheetStatusRejected):
+	default:
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
+
+// Locked reports whether entries in a week with this status are frozen.
+func Locked(status models.TimesheetStatus) bool {
+	return status == models.TimesheetStatusSubmitted || status == models.TimesheetStatusApproved
+}
+
+// Summarize totals closed entries under policy. Overtime is worked out per
+// employee per week, so From and To should cover whole weeks for weekly
+// thresholds to be exact.
+func Summarize(entries []models.TimeEntry, policy models.OvertimePolicy, from, to models.Date) models.TimeSummary {
+	sum := models.TimeSummary{From: from, To: to, ByProject: map[int]float64{}, ByEmployee: map[int]float64{}}
+
+	type weekKey struct {
+		employeeID int
+		week       models.Date
+	}
+	days := map[weekKey]map[models.Date]int{}
+	var total, regular, overtime, weekend int
+	for _, e := range entries {
+		if e.Minutes <= 0 {
+			continue
+		}
+		total += e.Minutes
+		if e.ProjectID != nil {
+			sum.ByProject[*e.ProjectID] += float64(e.Minutes)
+		}
+		sum.ByEmployee[e.EmployeeID] += float64(e.Minutes)
+
+		key := weekKey{e.EmployeeID, WeekStart(e.Date)}
+		if days[key] == nil {
+			days[key] = map[models.Date]int{}
+		}
+		days[key][e.Date] += e.Minutes
+	}
+
+	for _, week := range days {
+		dates := make([]models.Date, 0, len(week))
+		for d := range week {
+			dates = append(dates, d)
+		}
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j].Time) })
+
+		weekRegular := 0
+		for _, d := range dates {
+			minutes := week[d]
+			if policy.WeekendMultiplier > 0 && (d.Weekday() == time.Saturday || d.Weekday() == time.Sunday) {
+				weekend += minutes
+				continue
+			}
+			if policy.DailyThresholdMinutes > 0 && minutes > policy.DailyThresholdMinutes {
+				overtime += minutes - policy.DailyThresholdMinutes
+				minutes = policy.DailyThresholdMinutes
+			}
+			weekRegular += minutes
+		}
+		if policy.WeeklyThresholdMinutes > 0 && weekRegular > policy.WeeklyThresholdMinutes {
+			overtime += weekRegular - policy.WeeklyThresholdMinutes
+			weekRegular = policy.WeeklyThresholdMinutes
+		}
+		regular += weekRegular
+	}
+
+	multiplier := policy.OvertimeMultiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	sum.TotalHours = hours(total)
+	sum.RegularHours = hours(regular)
+	sum.OvertimeHours = hours(overtime)
+	sum.WeekendHours = hours(weekend)
+	sum.PayableHours = round(float64(regular)/60 + float64(overtime)/60*multiplier + float64(weekend)/60*policy.WeekendMultiplier)
+	for id, m := range sum.ByProject {
+		sum.ByProject[id] = round(m / 60)
+	}
+	for id, m := range sum.ByEmployee {
+		sum.ByEmployee[id] = round(m / 60)
+	}
+	return sum
+}
+
+func hours(minutes int) float64 {
+	return round(float64(minutes) / 60)
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,58 @@
+package timesheet
+
+import (
+	"employees/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := models.NewDate(2025, time.June, 2)
+	assert.Equal(t, monday, WeekStart(monday))
+	assert.Equal(t, monday, WeekStart(models.NewDate(2025, time.June, 8)))
+	assert.Equal(t, monday.AddDays(7), WeekStart(models.NewDate(2025, time.June, 9)))
+}
+
+func TestSummarize(t *testing.T) {
+	monday := models.NewDate(2025, time.June, 2)
+	project := 4
+	entry := func(day, minutes int) models.TimeEntry {
+		return models.TimeEntry{EmployeeID: 1, ProjectID: &project, Date: monday.AddDays(day), Minutes: minutes}
+	}
+
+	// Ten hours Monday to Thursday, six on Friday and four on Saturday.
+	entries := []models.TimeEntry{
+		entry(0, 600), entry(1, 600), entry(2, 600), entry(3, 600), entry(4, 360), entry(5, 240),
+		{EmployeeID: 1, Date: monday, ClockIn: &time.Time{}},
+	}
+
+	t.Run("Default Policy", func(t *testing.T) {
+		sum := Summarize(entries, DefaultPolicy, monday, monday.AddDays(6))
+		assert.Equal(t, 50.0, sum.TotalHours)
+		// 8 hours of daily overtime, then 42 regular hours exceed the week by 2.
+		assert.Equal(t, 40.0, sum.RegularHours)
+		assert.Equal(t, 10.0, sum.OvertimeHours)
+		assert.Equal(t, 55.0, sum.PayableHours)
+		assert.Equal(t, 50.0, sum.ByProject[project])
+	})
+
+	t.Run("Weekend Multiplier", func(t *testing.T) {
+		policy := DefaultPolicy
+		policy.WeekendMultiplier = 2
+		sum := Summarize(entries, policy, monday, monday.AddDays(6))
+		assert.Equal(t, 38.0, sum.RegularHours)
+		assert.Equal(t, 8.0, sum.OvertimeHours)
+		assert.Equal(t, 4.0, sum.WeekendHours)
+		assert.Equal(t, 58.0, sum.PayableHours)
+	})
+}
+
+func TestTransition(t *testing.T) {
+	assert.NoError(t, Transition(models.TimesheetStatusDraft, models.TimesheetStatusSubmitted))
+	assert.NoError(t, Transition(models.TimesheetStatusRejected, models.TimesheetStatusSubmitted))
+	assert.NoError(t, Transition(models.TimesheetStatusSubmitted, models.TimesheetStatusApproved))
+	assert.ErrorIs(t, Transition(models.TimesheetStatusApproved, models.TimesheetStatusSubmitted), ErrInvalidTransition)
+	assert.ErrorIs(t, Transition(models.TimesheetStatusDraft, models.TimesheetStatusApproved), ErrInvalidTransition)
+}
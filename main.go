@@ -89,6 +89,9 @@ func main() {
 	}()
 
 	s := api.NewServer(":8080", logger, db)
+	if err := s.SeedSuperadmin(os.Getenv("ADMIN_EMAIL"), os.Getenv("ADMIN_PASSWORD")); err != nil {
+		logger.Fatal("Failed to seed superadmin", zap.Error(err))
+	}
 
 	fmt.Println("Server listening on :8080")
 	logger.Fatal("Server error", zap.Error(s.Start()))